@@ -0,0 +1,58 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	icqante "github.com/Stride-Labs/stride/x/interchainquery/ante"
+	interchainquerykeeper "github.com/Stride-Labs/stride/x/interchainquery/keeper"
+)
+
+// HandlerOptions extends the SDK's AnteHandler options with the keepers used by Stride's decorators
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	InterchainqueryKeeper *interchainquerykeeper.Keeper
+}
+
+// NewAnteHandler returns the SDK's default AnteHandler, with a decorator that rejects duplicate interchain query
+// responses and refunds the fees of valid ones once fees are deducted and signatures verified
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+	if options.InterchainqueryKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "interchainquery keeper is required for ante builder")
+	}
+
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		icqante.NewQueryResponseDecorator(*options.InterchainqueryKeeper), // must be called after fees are deducted and signatures verified
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
@@ -104,6 +104,7 @@ import (
 	// monitoringpkeeper "github.com/tendermint/spn/x/monitoringp/keeper"
 
 	epochsmodule "github.com/Stride-Labs/stride/x/epochs"
+	epochsclient "github.com/Stride-Labs/stride/x/epochs/client"
 	epochsmodulekeeper "github.com/Stride-Labs/stride/x/epochs/keeper"
 	epochsmoduletypes "github.com/Stride-Labs/stride/x/epochs/types"
 
@@ -142,6 +143,9 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		upgradeclient.CancelProposalHandler,
 		ibcclientclient.UpdateClientProposalHandler,
 		ibcclientclient.UpgradeProposalHandler,
+		epochsclient.AddEpochProposalHandler,
+		epochsclient.RemoveEpochProposalHandler,
+		epochsclient.UpdateEpochDurationProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -185,16 +189,18 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:      nil,
-		distrtypes.ModuleName:           nil,
-		minttypes.ModuleName:            {authtypes.Minter},
-		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:             {authtypes.Burner},
-		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-		stakeibcmoduletypes.ModuleName:  {authtypes.Minter, authtypes.Burner, authtypes.Staking},
-		interchainquerytypes.ModuleName: nil,
-		icatypes.ModuleName:             nil,
+		authtypes.FeeCollectorName:                   nil,
+		distrtypes.ModuleName:                        nil,
+		minttypes.ModuleName:                         {authtypes.Minter},
+		minttypes.PoolIncentivesModuleAcctName:       nil,
+		minttypes.ParticipationRewardsModuleAcctName: nil,
+		stakingtypes.BondedPoolName:                  {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:               {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                          {authtypes.Burner},
+		ibctransfertypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
+		stakeibcmoduletypes.ModuleName:               {authtypes.Minter, authtypes.Burner, authtypes.Staking},
+		interchainquerytypes.ModuleName:              nil,
+		icatypes.ModuleName:                          nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -309,7 +315,7 @@ func NewStrideApp(
 		icacallbacksmoduletypes.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, interchainquerytypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &StrideApp{
@@ -348,13 +354,13 @@ func NewStrideApp(
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
-	app.MintKeeper = mintkeeper.NewKeeper(
-		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.EpochsKeeper, authtypes.FeeCollectorName,
-	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
 	)
+	app.MintKeeper = mintkeeper.NewKeeper(
+		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.EpochsKeeper, authtypes.FeeCollectorName,
+	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
 	)
@@ -381,10 +387,12 @@ func NewStrideApp(
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, mint.NewParamChangeProposalHandler(params.NewParamChangeProposalHandler(app.ParamsKeeper), app.MintKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		// NOTE: the epochs keeper is passed by reference, so that it will contain the dependencies set below
+		AddRoute(epochsmoduletypes.RouterKey, epochsmodule.NewEpochProposalHandler(&app.EpochsKeeper))
 
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -424,21 +432,6 @@ func NewStrideApp(
 	// )
 	// monitoringModule := monitoringp.NewAppModule(appCodec, app.MonitoringKeeper)
 
-	// Note: must be above app.StakeibcKeeper
-
-	scopedRecordsKeeper := app.CapabilityKeeper.ScopeToModule(recordsmoduletypes.ModuleName)
-	app.ScopedRecordsKeeper = scopedRecordsKeeper
-
-	app.RecordsKeeper = *recordsmodulekeeper.NewKeeper(
-		appCodec,
-		keys[recordsmoduletypes.StoreKey],
-		keys[recordsmoduletypes.MemStoreKey],
-		app.GetSubspace(recordsmoduletypes.ModuleName),
-		scopedRecordsKeeper,
-		app.AccountKeeper,
-	)
-	recordsModule := recordsmodule.NewAppModule(appCodec, app.RecordsKeeper, app.AccountKeeper, app.BankKeeper)
-
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
 		app.IBCKeeper.ChannelKeeper, // may be replaced with middleware such as ics29 fee
@@ -446,9 +439,11 @@ func NewStrideApp(
 		scopedICAControllerKeeper, app.MsgServiceRouter(),
 	)
 
-	app.InterchainqueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], app.IBCKeeper)
+	app.InterchainqueryKeeper = interchainquerykeeper.NewKeeper(appCodec, keys[interchainquerytypes.StoreKey], tkeys[interchainquerytypes.TStoreKey], app.GetSubspace(interchainquerytypes.ModuleName), app.BankKeeper, app.IBCKeeper)
 	interchainQueryModule := interchainquery.NewAppModule(appCodec, app.InterchainqueryKeeper)
 
+	epochsKeeper := epochsmodulekeeper.NewKeeper(appCodec, keys[epochsmoduletypes.StoreKey], app.GetSubspace(epochsmoduletypes.ModuleName))
+
 	scopedIcacallbacksKeeper := app.CapabilityKeeper.ScopeToModule(icacallbacksmoduletypes.ModuleName)
 	app.ScopedIcacallbacksKeeper = scopedIcacallbacksKeeper
 	app.IcacallbacksKeeper = *icacallbacksmodulekeeper.NewKeeper(
@@ -459,7 +454,25 @@ func NewStrideApp(
 		scopedIcacallbacksKeeper,
 		*app.IBCKeeper,
 		app.ICAControllerKeeper,
+		epochsKeeper,
+	)
+
+	// Note: must be above app.StakeibcKeeper
+	scopedRecordsKeeper := app.CapabilityKeeper.ScopeToModule(recordsmoduletypes.ModuleName)
+	app.ScopedRecordsKeeper = scopedRecordsKeeper
+
+	app.RecordsKeeper = *recordsmodulekeeper.NewKeeper(
+		appCodec,
+		keys[recordsmoduletypes.StoreKey],
+		keys[recordsmoduletypes.MemStoreKey],
+		app.GetSubspace(recordsmoduletypes.ModuleName),
+		scopedRecordsKeeper,
+		app.AccountKeeper,
+		app.TransferKeeper,
+		*app.IBCKeeper,
+		app.IcacallbacksKeeper,
 	)
+	recordsModule := recordsmodule.NewAppModule(appCodec, app.RecordsKeeper, app.AccountKeeper, app.BankKeeper)
 
 	scopedStakeibcKeeper := app.CapabilityKeeper.ScopeToModule(stakeibcmoduletypes.ModuleName)
 	app.ScopedStakeibcKeeper = scopedStakeibcKeeper
@@ -480,9 +493,11 @@ func NewStrideApp(
 		app.RecordsKeeper,
 		app.StakingKeeper,
 		app.IcacallbacksKeeper,
+		epochsKeeper,
 	)
 
-	stakeibcModule := stakeibcmodule.NewAppModule(appCodec, app.StakeibcKeeper, app.AccountKeeper, app.BankKeeper)
+	telemetryEnabled := cast.ToBool(appOpts.Get("telemetry.enabled"))
+	stakeibcModule := stakeibcmodule.NewAppModule(appCodec, app.StakeibcKeeper, app.AccountKeeper, app.BankKeeper, telemetryEnabled)
 	stakeibcIBCModule := stakeibcmodule.NewIBCModule(app.StakeibcKeeper)
 
 	// Register ICQ callbacks
@@ -492,10 +507,12 @@ func NewStrideApp(
 	}
 
 	
-	epochsKeeper := epochsmodulekeeper.NewKeeper(appCodec, keys[epochsmoduletypes.StoreKey])
+	// the epoch identifiers these modules run on can't be removed by governance
+	epochsKeeper.SetDependencies(app.StakeibcKeeper, app.MintKeeper)
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochsmoduletypes.NewMultiEpochHooks(
 			app.StakeibcKeeper.Hooks(),
+			app.IcacallbacksKeeper.Hooks(),
 		),
 	)
 	epochsModule := epochsmodule.NewAppModule(appCodec, app.EpochsKeeper)
@@ -506,6 +523,10 @@ func NewStrideApp(
 	if err != nil {
 		return nil
 	}
+	err = app.IcacallbacksKeeper.SetICACallbackHandler(recordsmoduletypes.ModuleName, app.RecordsKeeper.ICACallbackHandler())
+	if err != nil {
+		return nil
+	}
 
 	// this line is used by starport scaffolding # stargate/app/keeperDefinition
 
@@ -532,15 +553,18 @@ func NewStrideApp(
 
 	// Stack two contains
 	// - IBC
-	// - records
+	// - records (legacy deposit record transfers)
+	// - icacallbacks
 	// - transfer
 	// - base app
-	recordsStack := recordsmodule.NewIBCModule(app.RecordsKeeper, transferIBCModule)
+	var transferStack porttypes.IBCModule
+	transferStack = icacallbacksmodule.NewTransferMiddleware(app.IcacallbacksKeeper, transferIBCModule)
+	transferStack = recordsmodule.NewLegacyTransferMiddleware(app.RecordsKeeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.
-		AddRoute(ibctransfertypes.ModuleName, recordsStack).
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(icacontrollertypes.SubModuleName, icamiddlewareStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
 		// Note, authentication module packets are routed to the top level of the middleware stack
@@ -688,30 +712,14 @@ func NewStrideApp(
 	app.mm.RegisterServices(app.configurator)
 	app.setupUpgradeHandlers()
 
-	// create the simulation manager and define the order of the modules for deterministic simulations
-	// app.sm = module.NewSimulationManager(
-	// 	auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
-	// 	bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
-	// 	capability.NewAppModule(appCodec, *app.CapabilityKeeper),
-	// 	feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-	// 	gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-	// 	mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
-	// 	staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
-	// 	distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-	// 	slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-	// 	params.NewAppModule(app.ParamsKeeper),
-	// 	evidence.NewAppModule(app.EvidenceKeeper),
-	// 	ibc.NewAppModule(app.IBCKeeper),
-	// 	transferModule,
-	// 	// monitoringModule,
-	// 	stakeibcModule,
-	// 	epochsModule,
-	// 	interchainQueryModule,
-	// 	recordsModule,
-	// icacallbacksModule,
-	// this line is used by starport scaffolding # stargate/app/appModule
-	// )
-	// app.sm.RegisterStoreDecoders()
+	// create the simulation manager and define the order of the modules for deterministic simulations. Only the
+	// stride modules implement simulations; their invariants are asserted through the crisis routes registered above
+	app.sm = module.NewSimulationManager(
+		stakeibcModule,
+		recordsModule,
+		icacallbacksModule,
+	)
+	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -722,13 +730,16 @@ func NewStrideApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   app.AccountKeeper,
-			BankKeeper:      app.BankKeeper,
-			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-			FeegrantKeeper:  app.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			HandlerOptions: ante.HandlerOptions{
+				AccountKeeper:   app.AccountKeeper,
+				BankKeeper:      app.BankKeeper,
+				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+				FeegrantKeeper:  app.FeeGrantKeeper,
+				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			},
+			InterchainqueryKeeper: &app.InterchainqueryKeeper,
 		},
 	)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -17,6 +18,11 @@ type AppTestHelper struct {
 	Ctx         sdk.Context
 	QueryHelper *baseapp.QueryServiceTestHelper
 	TestAccs    []sdk.AccAddress
+
+	// used for tests that run through the IBC testing framework
+	Coordinator *ibctesting.Coordinator
+	StrideChain *ibctesting.TestChain
+	HostChain   *ibctesting.TestChain
 }
 
 func (s *AppTestHelper) Setup() {
@@ -30,6 +36,20 @@ func (s *AppTestHelper) Setup() {
 	s.TestAccs = CreateRandomAccounts(3)
 }
 
+// SetupIBCChains creates a stride chain and a host chain, both running the stride app, connected through the
+// IBC testing coordinator. s.App and s.Ctx are pointed at the stride chain
+func (s *AppTestHelper) SetupIBCChains() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	s.Coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.StrideChain = s.Coordinator.GetChain(ibctesting.GetChainID(1))
+	s.HostChain = s.Coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.App = s.StrideChain.App.(*app.StrideApp)
+	s.Ctx = s.StrideChain.GetContext()
+	s.TestAccs = CreateRandomAccounts(3)
+}
+
 func (s *AppTestHelper) FundModuleAccount(moduleName string, amount sdk.Coin) {
 	err := s.App.BankKeeper.MintCoins(s.Ctx, moduleName, sdk.NewCoins(amount))
 	s.Require().NoError(err)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -46,3 +47,22 @@ func InitTestApp(isCheckTx bool) *StrideApp {
 
 	return app
 }
+
+// SetupTestingApp initializes a StrideApp for the ibc-go testing framework
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(Bech32Prefix, Bech32Prefix+sdk.PrefixPublic)
+	db := dbm.NewMemDB()
+	app := NewStrideApp(
+		log.NewNopLogger(),
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		DefaultNodeHome,
+		5,
+		MakeEncodingConfig(),
+		simapp.EmptyAppOptions{},
+	)
+	return app, NewDefaultGenesisState()
+}
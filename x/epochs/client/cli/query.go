@@ -30,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
 		GetCmdSecondsRemaining(),
+		GetCmdEpochSchedule(),
+		GetCmdParams(),
 	)
 
 	return cmd
@@ -158,3 +160,79 @@ func GetCmdSecondsRemaining() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdParams provides the parameters of the module
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the module",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs params`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const FlagCount = "count"
+
+// GetCmdEpochSchedule provides the upcoming epochs of every identifier, or of the specified one
+func GetCmdEpochSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-schedule [identifier]",
+		Short: "Query the start times and estimated start heights of upcoming epochs",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs epoch-schedule day --count 3`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			count, err := cmd.Flags().GetUint64(FlagCount)
+			if err != nil {
+				return err
+			}
+			req := &types.QueryEpochScheduleRequest{Count: count}
+			if len(args) == 1 {
+				req.Identifier = args[0]
+			}
+
+			res, err := queryClient.EpochSchedule(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCount, 1, "number of upcoming epochs per identifier")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
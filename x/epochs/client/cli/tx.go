@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+const FlagStartTime = "start-time"
+
+// NewCmdSubmitAddEpochProposal implements a command handler for submitting an add epoch proposal
+func NewCmdSubmitAddEpochProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-epoch [identifier] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to add an epoch identifier",
+		Long: "Submit a proposal to add an epoch identifier along with an initial deposit.\n" +
+			"The first epoch starts at --start-time (RFC3339), or when the proposal passes if it's unset.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			startTime := time.Time{}
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if startTimeStr != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewAddEpochProposal(title, description, types.EpochInfo{
+					Identifier: args[0],
+					StartTime:  startTime,
+					Duration:   duration,
+				})
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "start time of the first epoch (RFC3339)")
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveEpochProposal implements a command handler for submitting a remove epoch proposal
+func NewCmdSubmitRemoveEpochProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-epoch [identifier]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove an epoch identifier",
+		Long: "Submit a proposal to remove an epoch identifier along with an initial deposit.\n" +
+			"Epoch identifiers that a module depends on can't be removed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewRemoveEpochProposal(title, description, args[0])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitUpdateEpochDurationProposal implements a command handler for submitting an update epoch duration proposal
+func NewCmdSubmitUpdateEpochDurationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-epoch-duration [identifier] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to change the duration of an epoch identifier",
+		Long: "Submit a proposal to change the duration of an epoch identifier along with an initial deposit.\n" +
+			"The current epoch keeps its duration, the new one applies from the next epoch.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewUpdateEpochDurationProposal(title, description, args[0], duration)
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+// submitProposal submits the proposal content built from the command's title and description flags
+func submitProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(newContent(title, description), deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/Stride-Labs/stride/x/epochs/client/cli"
+)
+
+var (
+	AddEpochProposalHandler            = govclient.NewProposalHandler(cli.NewCmdSubmitAddEpochProposal, emptyRestHandler)
+	RemoveEpochProposalHandler         = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveEpochProposal, emptyRestHandler)
+	UpdateEpochDurationProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateEpochDurationProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-epochs",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for epochs proposals")
+		},
+	}
+}
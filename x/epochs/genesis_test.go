@@ -12,6 +12,7 @@ import (
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
+		Params: types.NewParams(1_000_000),
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -19,6 +20,7 @@ func TestGenesis(t *testing.T) {
 	epochs.InitGenesis(ctx, *k, genesisState)
 	got := epochs.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState.Params, got.Params)
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
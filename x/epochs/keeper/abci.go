@@ -68,5 +68,10 @@ func startInitialEpoch(epochInfo types.EpochInfo) types.EpochInfo {
 func endEpoch(epochInfo types.EpochInfo) types.EpochInfo {
 	epochInfo.CurrentEpoch++
 	epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	// a duration changed by governance applies from the epoch that's starting
+	if epochInfo.NextDuration != 0 {
+		epochInfo.Duration = epochInfo.NextDuration
+		epochInfo.NextDuration = 0
+	}
 	return epochInfo
 }
@@ -75,3 +75,58 @@ func (k Keeper) EpochInfo(c context.Context, req *types.QueryEpochInfoRequest) (
 		Epoch: info,
 	}, nil
 }
+
+// Params returns the parameters of the module
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
+
+// EpochSchedule provides the next start times and estimated start heights of every epoch identifier, or of one
+func (k Keeper) EpochSchedule(c context.Context, req *types.QueryEpochScheduleRequest) (*types.QueryEpochScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	count := req.Count
+	if count == 0 {
+		count = 1
+	}
+	if count > types.MaxEpochScheduleCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count cannot exceed %d", types.MaxEpochScheduleCount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epochInfos := []types.EpochInfo{}
+	if req.Identifier == "" {
+		epochInfos = k.AllEpochInfos(ctx)
+	} else {
+		info, found := k.GetEpochInfo(ctx, req.Identifier)
+		if !found {
+			return nil, status.Error(codes.NotFound, "epoch info not found")
+		}
+		epochInfos = append(epochInfos, info)
+	}
+
+	averageBlockTime := k.AverageBlockTime(ctx)
+	schedules := []types.EpochSchedule{}
+	for _, info := range epochInfos {
+		schedule, err := getEpochSchedule(ctx, info, count, averageBlockTime)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return &types.QueryEpochScheduleResponse{
+		Schedules:        schedules,
+		AverageBlockTime: averageBlockTime,
+	}, nil
+}
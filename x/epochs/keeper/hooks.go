@@ -1,16 +1,86 @@
 package keeper
 
 import (
-	"github.com/Stride-Labs/stride/x/epochs/types"
+	"fmt"
+	"reflect"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
 )
 
 // AfterEpochEnd executes the indicated hook after epochs ends
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo types.EpochInfo) {
-	k.hooks.AfterEpochEnd(ctx, epochInfo)
+	for _, hook := range k.hooks {
+		k.callHook(ctx, hook, "AfterEpochEnd", hook.AfterEpochEnd, epochInfo)
+	}
 }
 
 // BeforeEpochStart executes the indicated hook before the epochs
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) {
-	k.hooks.BeforeEpochStart(ctx, epochInfo)
+	for _, hook := range k.hooks {
+		k.callHook(ctx, hook, "BeforeEpochStart", hook.BeforeEpochStart, epochInfo)
+	}
+}
+
+// callHook calls a hook in a cached context, capped at the hook gas limit. Its writes and events are only kept if it
+// succeeds; if it returns an error or panics, the failure is logged and emitted instead, and the other hooks still run
+func (k Keeper) callHook(
+	ctx sdk.Context,
+	hook types.EpochHooks,
+	method string,
+	fn func(sdk.Context, types.EpochInfo) error,
+	epochInfo types.EpochInfo,
+) {
+	gasLimit := k.GetParams(ctx).HookGasLimit
+	gasMeter := sdk.NewInfiniteGasMeter()
+	if gasLimit != 0 {
+		gasMeter = sdk.NewGasMeter(gasLimit)
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	if err := applyHook(cacheCtx, fn, epochInfo); err != nil {
+		name := hookName(hook)
+		k.Logger(ctx).Error(fmt.Sprintf("Epoch hook %s %s failed for epoch %s %d: %s",
+			name, method, epochInfo.Identifier, epochInfo.CurrentEpoch, err.Error()))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochHookFailed,
+				sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+				sdk.NewAttribute(types.AttributeHook, name),
+				sdk.NewAttribute(types.AttributeHookMethod, method),
+				sdk.NewAttribute(types.AttributeError, err.Error()),
+			),
+		)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// applyHook calls a hook, recovering a panic into an error
+func applyHook(ctx sdk.Context, fn func(sdk.Context, types.EpochInfo) error, epochInfo types.EpochInfo) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in %s, limit %d", outOfGas.Descriptor, ctx.GasMeter().Limit())
+				return
+			}
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx, epochInfo)
+}
+
+// hookName returns the package qualified type name of a hook, e.g. github.com/Stride-Labs/stride/x/mint/keeper.Hooks
+func hookName(hook types.EpochHooks) string {
+	t := reflect.TypeOf(hook)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return fmt.Sprintf("%s.%s", t.PkgPath(), t.Name())
 }
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/epochs/keeper"
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// testHooks records the epoch it's called with under its own identifier, then runs fn
+type testHooks struct {
+	k          *keeper.Keeper
+	identifier string
+	fn         func(ctx sdk.Context) error
+}
+
+func (h testHooks) BeforeEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) error {
+	epochInfo.Identifier = h.identifier
+	h.k.SetEpochInfo(ctx, epochInfo)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(h.identifier))
+	return h.fn(ctx)
+}
+
+func (h testHooks) AfterEpochEnd(ctx sdk.Context, epochInfo types.EpochInfo) error {
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestHookIsolation() {
+	suite.SetupTest()
+	k := keeper.NewKeeper(suite.App.AppCodec(), suite.App.GetKey(types.StoreKey), suite.App.GetSubspace(types.ModuleName))
+	k.SetHooks(types.NewMultiEpochHooks(
+		testHooks{k: k, identifier: "failed", fn: func(sdk.Context) error { return errors.New("hook failed") }},
+		testHooks{k: k, identifier: "panicked", fn: func(sdk.Context) error { panic("hook panicked") }},
+		testHooks{k: k, identifier: "out-of-gas", fn: func(ctx sdk.Context) error {
+			ctx.GasMeter().ConsumeGas(100_000, "expensive hook")
+			return nil
+		}},
+		testHooks{k: k, identifier: "succeeded", fn: func(sdk.Context) error { return nil }},
+	))
+	k.SetParams(suite.Ctx, types.NewParams(50_000))
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	k.BeforeEpochStart(ctx, types.EpochInfo{Identifier: types.DAY_EPOCH, CurrentEpoch: 2, Duration: time.Hour})
+
+	// only the hook that succeeded keeps its writes and events
+	for _, identifier := range []string{"failed", "panicked", "out-of-gas"} {
+		_, found := k.GetEpochInfo(ctx, identifier)
+		suite.Require().False(found, identifier)
+	}
+	_, found := k.GetEpochInfo(ctx, "succeeded")
+	suite.Require().True(found)
+
+	failures := map[string]string{}
+	succeeded := false
+	for _, event := range ctx.EventManager().Events() {
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		switch event.Type {
+		case types.EventTypeEpochHookFailed:
+			suite.Require().Equal(types.DAY_EPOCH, attributes[types.AttributeEpochIdentifier])
+			suite.Require().Equal("2", attributes[types.AttributeEpochNumber])
+			suite.Require().Equal("BeforeEpochStart", attributes[types.AttributeHookMethod])
+			suite.Require().Contains(attributes[types.AttributeHook], "testHooks")
+			failures[attributes[types.AttributeError]] = attributes[types.AttributeHook]
+		case "succeeded":
+			succeeded = true
+		case "failed", "panicked", "out-of-gas":
+			suite.Fail("event of a failed hook emitted", event.Type)
+		}
+	}
+	suite.Require().True(succeeded, "events of the hook that succeeded emitted")
+	suite.Require().Len(failures, 3)
+	suite.Require().Contains(failures, "hook failed")
+	suite.Require().Contains(failures, "panic: hook panicked")
+	suite.Require().Contains(failures, "out of gas in expensive hook, limit 50000: out of gas")
+}
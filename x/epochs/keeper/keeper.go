@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/Stride-Labs/stride/x/epochs/types"
@@ -12,16 +13,25 @@ import (
 
 // Keeper of this module maintains collections of epochs and hooks.
 type Keeper struct {
-	cdc      codec.Codec
-	storeKey sdk.StoreKey
-	hooks    types.EpochHooks
+	cdc        codec.Codec
+	storeKey   sdk.StoreKey
+	paramstore paramtypes.Subspace
+	hooks      types.MultiEpochHooks
+	// modules whose epoch identifiers can't be removed
+	dependencies []types.EpochDependency
 }
 
 // NewKeeper returns a new instance of epochs Keeper
-func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, ps paramtypes.Subspace) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramstore: ps,
 	}
 }
 
@@ -31,7 +41,23 @@ func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 		panic("cannot set epochs hooks twice")
 	}
 
-	k.hooks = eh
+	// each hook is called in isolation, so it's kept as a list
+	if multiHooks, ok := eh.(types.MultiEpochHooks); ok {
+		k.hooks = multiHooks
+	} else {
+		k.hooks = types.NewMultiEpochHooks(eh)
+	}
+
+	return k
+}
+
+// SetDependencies sets the modules that depend on epoch identifiers
+func (k *Keeper) SetDependencies(dependencies ...types.EpochDependency) *Keeper {
+	if k.dependencies != nil {
+		panic("cannot set epochs dependencies twice")
+	}
+
+	k.dependencies = dependencies
 
 	return k
 }
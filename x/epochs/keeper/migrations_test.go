@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Stride-Labs/stride/x/epochs/keeper"
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.SetupTest()
+	paramSpace := suite.App.GetSubspace(types.ModuleName)
+
+	// remove the params, as they were before the migration
+	paramsStore := suite.Ctx.KVStore(suite.App.GetKey(paramstypes.StoreKey))
+	paramsStore.Delete(append([]byte(types.ModuleName+"/"), types.KeyHookGasLimit...))
+	suite.Require().False(paramSpace.Has(suite.Ctx, types.KeyHookGasLimit))
+
+	err := keeper.NewMigrator(suite.App.EpochsKeeper).Migrate1to2(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(paramSpace.Has(suite.Ctx, types.KeyHookGasLimit))
+	suite.Require().Equal(types.DefaultParams(), suite.App.EpochsKeeper.GetParams(suite.Ctx))
+}
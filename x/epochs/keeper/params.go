@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// GetParams get all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	// params are read from the begin blocker, so a chain upgraded from before the module had params mustn't panic:
+	// until they are set, hooks aren't limited
+	k.paramstore.GetIfExists(ctx, types.KeyHookGasLimit, &params.HookGasLimit)
+	return params
+}
+
+// SetParams set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// AddEpoch adds an epoch identifier, whose first epoch starts at its start time, or the block time if it's unset
+func (k Keeper) AddEpoch(ctx sdk.Context, identifier string, startTime time.Time, duration time.Duration) error {
+	if err := types.ValidateEpochIdentifierString(identifier); err != nil {
+		return err
+	}
+	if _, found := k.GetEpochInfo(ctx, identifier); found {
+		return sdkerrors.Wrapf(types.ErrEpochAlreadyExists, "epoch %s", identifier)
+	}
+	if duration <= 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidDuration, "duration of epoch %s must be positive, got %s", identifier, duration)
+	}
+	if startTime.Equal(time.Time{}) {
+		startTime = ctx.BlockTime()
+	}
+
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              identifier,
+		StartTime:               startTime,
+		Duration:                duration,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+	})
+	k.Logger(ctx).Info(fmt.Sprintf("Added epoch %s with duration %s, starting at %s", identifier, duration, startTime))
+	return nil
+}
+
+// RemoveEpoch removes an epoch identifier, unless a module depends on it
+func (k Keeper) RemoveEpoch(ctx sdk.Context, identifier string) error {
+	if _, found := k.GetEpochInfo(ctx, identifier); !found {
+		return sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch %s", identifier)
+	}
+	for _, dependency := range k.dependencies {
+		for _, inUse := range dependency.EpochIdentifiersInUse(ctx) {
+			if inUse == identifier {
+				return sdkerrors.Wrapf(types.ErrEpochInUse, "epoch %s can't be removed", identifier)
+			}
+		}
+	}
+
+	k.DeleteEpochInfo(ctx, identifier)
+	k.Logger(ctx).Info(fmt.Sprintf("Removed epoch %s", identifier))
+	return nil
+}
+
+// UpdateEpochDuration changes the duration of an epoch identifier. The current epoch keeps its duration, so the
+// change takes effect from the next epoch, and epochs aren't renumbered. An epoch that hasn't started is changed
+// right away
+func (k Keeper) UpdateEpochDuration(ctx sdk.Context, identifier string, duration time.Duration) error {
+	epoch, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch %s", identifier)
+	}
+	if duration <= 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidDuration, "duration of epoch %s must be positive, got %s", identifier, duration)
+	}
+
+	if epoch.EpochCountingStarted {
+		epoch.NextDuration = duration
+	} else {
+		epoch.Duration = duration
+	}
+	k.SetEpochInfo(ctx, epoch)
+	k.Logger(ctx).Info(fmt.Sprintf("Updated duration of epoch %s to %s", identifier, duration))
+	return nil
+}
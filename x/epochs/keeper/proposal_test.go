@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/Stride-Labs/stride/x/epochs"
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestEpochProposals() {
+	suite.SetupTest()
+	handler := epochs.NewEpochProposalHandler(&suite.App.EpochsKeeper)
+	now := suite.Ctx.BlockTime()
+
+	// add an epoch, starting now
+	err := handler(suite.Ctx, types.NewAddEpochProposal("title", "description", types.EpochInfo{Identifier: "hour", Duration: time.Hour}))
+	suite.Require().NoError(err)
+	err = handler(suite.Ctx, types.NewAddEpochProposal("title", "description", types.EpochInfo{Identifier: "hour", Duration: time.Hour}))
+	suite.Require().ErrorIs(err, types.ErrEpochAlreadyExists)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(2).WithBlockTime(now.Add(time.Second))
+	suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+	epochInfo, found := suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "hour")
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+	suite.Require().Equal(now, epochInfo.CurrentEpochStartTime)
+
+	// the current epoch keeps its duration
+	err = handler(suite.Ctx, types.NewUpdateEpochDurationProposal("title", "description", "hour", 2*time.Hour))
+	suite.Require().NoError(err)
+	epochInfo, _ = suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "hour")
+	suite.Require().Equal(time.Hour, epochInfo.Duration)
+	suite.Require().Equal(2*time.Hour, epochInfo.NextDuration)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(3).WithBlockTime(now.Add(time.Hour + time.Second))
+	suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+	epochInfo, _ = suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "hour")
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch)
+	suite.Require().Equal(now.Add(time.Hour), epochInfo.CurrentEpochStartTime)
+	suite.Require().Equal(2*time.Hour, epochInfo.Duration)
+	suite.Require().Zero(epochInfo.NextDuration)
+
+	// the next epoch lasts the new duration
+	suite.Ctx = suite.Ctx.WithBlockHeight(4).WithBlockTime(now.Add(2*time.Hour + time.Second))
+	suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+	epochInfo, _ = suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "hour")
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(5).WithBlockTime(now.Add(3*time.Hour + time.Second))
+	suite.App.EpochsKeeper.BeginBlocker(suite.Ctx)
+	epochInfo, _ = suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "hour")
+	suite.Require().Equal(int64(3), epochInfo.CurrentEpoch)
+	suite.Require().Equal(now.Add(3*time.Hour), epochInfo.CurrentEpochStartTime)
+
+	// remove it
+	err = handler(suite.Ctx, types.NewRemoveEpochProposal("title", "description", "hour"))
+	suite.Require().NoError(err)
+	_, found = suite.App.EpochsKeeper.GetEpochInfo(suite.Ctx, "hour")
+	suite.Require().False(found)
+	err = handler(suite.Ctx, types.NewRemoveEpochProposal("title", "description", "hour"))
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound)
+}
+
+func (suite *KeeperTestSuite) TestRemoveEpochInUse() {
+	suite.SetupTest()
+	handler := epochs.NewEpochProposalHandler(&suite.App.EpochsKeeper)
+
+	// stakeibc's epochs
+	for _, identifier := range []string{types.STRIDE_EPOCH, types.DAY_EPOCH} {
+		err := handler(suite.Ctx, types.NewRemoveEpochProposal("title", "description", identifier))
+		suite.Require().ErrorIs(err, types.ErrEpochInUse, identifier)
+	}
+
+	// mint's epoch follows its params
+	err := handler(suite.Ctx, types.NewRemoveEpochProposal("title", "description", "week"))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.EpochsKeeper.AddEpoch(suite.Ctx, "week", time.Time{}, 7*24*time.Hour))
+	params := suite.App.MintKeeper.GetParams(suite.Ctx)
+	params.EpochIdentifier = "week"
+	suite.App.MintKeeper.SetParams(suite.Ctx, params)
+	err = handler(suite.Ctx, types.NewRemoveEpochProposal("title", "description", "week"))
+	suite.Require().ErrorIs(err, types.ErrEpochInUse)
+}
+
+func (suite *KeeperTestSuite) TestEpochProposalValidateBasic() {
+	suite.Require().NoError(types.NewAddEpochProposal("title", "description", types.EpochInfo{Identifier: "hour", Duration: time.Hour}).ValidateBasic())
+	suite.Require().ErrorIs(types.NewAddEpochProposal("title", "description", types.EpochInfo{Identifier: "hour"}).ValidateBasic(), types.ErrInvalidDuration)
+	suite.Require().Error(types.NewRemoveEpochProposal("title", "description", " ").ValidateBasic())
+	suite.Require().ErrorIs(types.NewUpdateEpochDurationProposal("title", "description", "hour", -time.Hour).ValidateBasic(), types.ErrInvalidDuration)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// AverageBlockTime estimates the block time from the started epoch that has run for the most blocks, as the time
+// since it started over the blocks since. It falls back to DefaultAverageBlockTime if no epoch has run for a block
+func (k Keeper) AverageBlockTime(ctx sdk.Context) time.Duration {
+	blocks := int64(0)
+	elapsed := time.Duration(0)
+	k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+		epochBlocks := ctx.BlockHeight() - epochInfo.CurrentEpochStartHeight
+		if epochInfo.EpochCountingStarted && epochBlocks > blocks {
+			blocks = epochBlocks
+			elapsed = ctx.BlockTime().Sub(epochInfo.CurrentEpochStartTime)
+		}
+		return false
+	})
+	if blocks == 0 || elapsed <= 0 {
+		return types.DefaultAverageBlockTime
+	}
+	return elapsed / time.Duration(blocks)
+}
+
+// EstimateEpochStart returns when an upcoming epoch of an epoch identifier starts
+func (k Keeper) EstimateEpochStart(ctx sdk.Context, identifier string, epochNumber int64) (types.UpcomingEpoch, error) {
+	epochInfo, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return types.UpcomingEpoch{}, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch %s", identifier)
+	}
+	return estimateEpochStart(ctx, epochInfo, epochNumber, k.AverageBlockTime(ctx))
+}
+
+// GetEpochSchedule returns the next epochs of an epoch identifier
+func (k Keeper) GetEpochSchedule(ctx sdk.Context, identifier string, count uint64) (types.EpochSchedule, error) {
+	epochInfo, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return types.EpochSchedule{}, sdkerrors.Wrapf(types.ErrEpochNotFound, "epoch %s", identifier)
+	}
+	return getEpochSchedule(ctx, epochInfo, count, k.AverageBlockTime(ctx))
+}
+
+func getEpochSchedule(ctx sdk.Context, epochInfo types.EpochInfo, count uint64, averageBlockTime time.Duration) (types.EpochSchedule, error) {
+	schedule := types.EpochSchedule{
+		Identifier:     epochInfo.Identifier,
+		CurrentEpoch:   epochInfo.CurrentEpoch,
+		UpcomingEpochs: []types.UpcomingEpoch{},
+	}
+	for i := uint64(1); i <= count; i++ {
+		upcoming, err := estimateEpochStart(ctx, epochInfo, epochInfo.CurrentEpoch+int64(i), averageBlockTime)
+		if err != nil {
+			return types.EpochSchedule{}, err
+		}
+		schedule.UpcomingEpochs = append(schedule.UpcomingEpochs, upcoming)
+	}
+	return schedule, nil
+}
+
+// estimateEpochStart returns the start time of an upcoming epoch, following the BeginBlocker: the first epoch starts
+// at the epoch's start time, and each one after the previous one has lasted its duration. A duration change applies
+// from the epoch after the current one. The epoch starts in the first block past its start time, whose height is
+// estimated from the average block time
+func estimateEpochStart(ctx sdk.Context, epochInfo types.EpochInfo, epochNumber int64, averageBlockTime time.Duration) (types.UpcomingEpoch, error) {
+	if epochNumber <= epochInfo.CurrentEpoch {
+		return types.UpcomingEpoch{}, sdkerrors.Wrapf(types.ErrInvalidEpochNumber,
+			"epoch %d of %s has already started, current epoch is %d", epochNumber, epochInfo.Identifier, epochInfo.CurrentEpoch)
+	}
+
+	var start time.Time
+	var epochsAfter int64
+	duration := epochInfo.Duration
+	if epochInfo.EpochCountingStarted {
+		start = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+		epochsAfter = epochNumber - epochInfo.CurrentEpoch - 1
+		if epochInfo.NextDuration != 0 {
+			duration = epochInfo.NextDuration
+		}
+	} else {
+		start = epochInfo.StartTime
+		epochsAfter = epochNumber - 1
+	}
+	if duration <= 0 || epochsAfter > math.MaxInt64/int64(duration) {
+		return types.UpcomingEpoch{}, sdkerrors.Wrapf(types.ErrInvalidEpochNumber,
+			"start of epoch %d of %s is out of range", epochNumber, epochInfo.Identifier)
+	}
+	start = start.Add(time.Duration(epochsAfter) * duration)
+
+	// an epoch that's due starts in the next block
+	blocks := int64(1)
+	if untilStart := start.Sub(ctx.BlockTime()); untilStart > 0 {
+		blocks = int64((untilStart + averageBlockTime - 1) / averageBlockTime)
+	}
+
+	return types.UpcomingEpoch{
+		EpochNumber:          epochNumber,
+		StartTime:            start,
+		EstimatedStartHeight: ctx.BlockHeight() + blocks,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestEpochSchedule() {
+	suite.SetupTest()
+	k := suite.App.EpochsKeeper
+	now := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	ctx := suite.Ctx.WithBlockHeight(1_000).WithBlockTime(now)
+
+	// the day epoch started 100 blocks and 10 minutes ago, and its duration was halved by governance
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              types.DAY_EPOCH,
+		StartTime:               now.Add(-3 * 24 * time.Hour),
+		Duration:                24 * time.Hour,
+		NextDuration:            12 * time.Hour,
+		CurrentEpoch:            3,
+		CurrentEpochStartTime:   now.Add(-10 * time.Minute),
+		CurrentEpochStartHeight: 900,
+		EpochCountingStarted:    true,
+	})
+	suite.Require().Equal(6*time.Second, k.AverageBlockTime(ctx))
+
+	res, err := k.EpochSchedule(sdk.WrapSDKContext(ctx), &types.QueryEpochScheduleRequest{Identifier: types.DAY_EPOCH, Count: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(6*time.Second, res.AverageBlockTime)
+	suite.Require().Len(res.Schedules, 1)
+	schedule := res.Schedules[0]
+	suite.Require().Equal(int64(3), schedule.CurrentEpoch)
+	suite.Require().Equal([]types.UpcomingEpoch{
+		{EpochNumber: 4, StartTime: now.Add(24*time.Hour - 10*time.Minute), EstimatedStartHeight: 1_000 + 14_300},
+		{EpochNumber: 5, StartTime: now.Add(36*time.Hour - 10*time.Minute), EstimatedStartHeight: 1_000 + 21_500},
+		{EpochNumber: 6, StartTime: now.Add(48*time.Hour - 10*time.Minute), EstimatedStartHeight: 1_000 + 28_700},
+	}, schedule.UpcomingEpochs)
+
+	// every epoch, including one that hasn't started and whose first epoch is due, so it starts in the next block
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              "week",
+		StartTime:               now.Add(-time.Minute),
+		Duration:                7 * 24 * time.Hour,
+		CurrentEpochStartHeight: 990,
+	})
+	res, err = k.EpochSchedule(sdk.WrapSDKContext(ctx), &types.QueryEpochScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedules, 3)
+	for _, schedule := range res.Schedules {
+		suite.Require().Len(schedule.UpcomingEpochs, 1, schedule.Identifier)
+	}
+	suite.Require().Equal("week", res.Schedules[2].Identifier)
+	suite.Require().Equal(types.UpcomingEpoch{EpochNumber: 1, StartTime: now.Add(-time.Minute), EstimatedStartHeight: 1_001}, res.Schedules[2].UpcomingEpochs[0])
+
+	_, err = k.EpochSchedule(sdk.WrapSDKContext(ctx), &types.QueryEpochScheduleRequest{Count: types.MaxEpochScheduleCount + 1})
+	suite.Require().ErrorContains(err, "count cannot exceed")
+	_, err = k.EstimateEpochStart(ctx, types.DAY_EPOCH, 3)
+	suite.Require().ErrorIs(err, types.ErrInvalidEpochNumber)
+	_, err = k.EstimateEpochStart(ctx, "unknown", 1)
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound)
+}
@@ -0,0 +1,26 @@
+package epochs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Stride-Labs/stride/x/epochs/keeper"
+	"github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+// NewEpochProposalHandler returns a handler for the epochs module's governance proposals
+func NewEpochProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddEpochProposal:
+			return k.AddEpoch(ctx, c.Identifier, c.StartTime, c.Duration)
+		case *types.RemoveEpochProposal:
+			return k.RemoveEpoch(ctx, c.Identifier)
+		case *types.UpdateEpochDurationProposal:
+			return k.UpdateEpochDuration(ctx, c.Identifier, c.Duration)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
@@ -34,7 +34,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			EpochCountingStarted:    false,
 		},
 	}
-	epochGenesis := types.NewGenesisState(epochs)
+	epochGenesis := types.NewGenesisState(types.DefaultParams(), epochs)
 
 	bz, err := json.MarshalIndent(&epochGenesis, "", " ")
 	if err != nil {
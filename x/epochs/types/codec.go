@@ -3,6 +3,22 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&AddEpochProposal{}, "stride/AddEpochProposal", nil)
+	cdc.RegisterConcrete(&RemoveEpochProposal{}, "stride/RemoveEpochProposal", nil)
+	cdc.RegisterConcrete(&UpdateEpochDurationProposal{}, "stride/UpdateEpochDurationProposal", nil)
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&AddEpochProposal{},
+		&RemoveEpochProposal{},
+		&UpdateEpochDurationProposal{},
+	)
+}
+
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochDependency is implemented by modules whose logic runs on epoch identifiers, which can't be removed while
+// they are depended on
+type EpochDependency interface {
+	// EpochIdentifiersInUse returns the epoch identifiers the module depends on
+	EpochIdentifiersInUse(ctx sdk.Context) []string
+}
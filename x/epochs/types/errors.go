@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epochs module sentinel errors
+var (
+	ErrEpochNotFound      = sdkerrors.Register(ModuleName, 2, "epoch not found")
+	ErrEpochAlreadyExists = sdkerrors.Register(ModuleName, 3, "epoch already exists")
+	ErrInvalidDuration    = sdkerrors.Register(ModuleName, 4, "invalid epoch duration")
+	ErrEpochInUse         = sdkerrors.Register(ModuleName, 5, "epoch is in use")
+	ErrInvalidEpochNumber = sdkerrors.Register(ModuleName, 6, "invalid epoch number")
+)
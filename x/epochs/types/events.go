@@ -1,9 +1,14 @@
 package types
 
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd        = "epoch_end"
+	EventTypeEpochStart      = "epoch_start"
+	EventTypeEpochHookFailed = "epoch_hook_failed"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "identifier"
+	AttributeHook            = "hook"
+	AttributeHookMethod      = "method"
+	AttributeError           = "error"
 )
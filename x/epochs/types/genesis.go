@@ -5,8 +5,8 @@ import (
 	"time"
 )
 
-func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+func NewGenesisState(params Params, epochs []EpochInfo) *GenesisState {
+	return &GenesisState{Params: params, Epochs: epochs}
 }
 
 var STRIDE_EPOCH = "stride_epoch"
@@ -43,12 +43,15 @@ func DefaultGenesis() *GenesisState {
 			EpochCountingStarted:    false,
 		},
 	}
-	return NewGenesisState(epochs)
+	return NewGenesisState(DefaultParams(), epochs)
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 	epochIdentifiers := map[string]bool{}
 	for _, epoch := range gs.Epochs {
 		if epoch.Identifier == "" {
@@ -60,6 +63,9 @@ func (gs GenesisState) Validate() error {
 		if epoch.Duration == 0 {
 			return errors.New("epoch duration should NOT be 0")
 		}
+		if epoch.NextDuration < 0 {
+			return errors.New("epoch next duration should NOT be negative")
+		}
 		epochIdentifiers[epoch.Identifier] = true
 	}
 	return nil
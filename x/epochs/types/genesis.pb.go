@@ -36,6 +36,9 @@ type EpochInfo struct {
 	CurrentEpochStartTime   time.Time     `protobuf:"bytes,5,opt,name=current_epoch_start_time,json=currentEpochStartTime,proto3,stdtime" json:"current_epoch_start_time" yaml:"current_epoch_start_time"`
 	EpochCountingStarted    bool          `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	CurrentEpochStartHeight int64         `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// duration the epochs after the current one last, set by governance until
+	// the current epoch ends (zero if unchanged)
+	NextDuration time.Duration `protobuf:"bytes,8,opt,name=next_duration,json=nextDuration,proto3,stdduration" json:"next_duration,omitempty" yaml:"next_duration"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -120,9 +123,17 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetNextDuration() time.Duration {
+	if m != nil {
+		return m.NextDuration
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Params Params      `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -165,6 +176,13 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "Stridelabs.stride.epochs.EpochInfo")
 	proto.RegisterType((*GenesisState)(nil), "Stridelabs.stride.epochs.GenesisState")
@@ -173,37 +191,40 @@ func init() {
 func init() { proto.RegisterFile("epochs/genesis.proto", fileDescriptor_b167152c9528ab6c) }
 
 var fileDescriptor_b167152c9528ab6c = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0x91, 0x10, 0x9a, 0x6b, 0x11, 0xe2, 0x54, 0xe0, 0x88, 0x84, 0x6d, 0xb9, 0x8b, 0x25,
-	0xca, 0x59, 0x14, 0x26, 0x98, 0x08, 0xbf, 0x25, 0x16, 0x1c, 0x06, 0xc4, 0x12, 0xd9, 0xc9, 0xc5,
-	0x3e, 0x29, 0xf6, 0x59, 0xbe, 0xcf, 0x12, 0xd9, 0x98, 0x99, 0x3a, 0xf2, 0x27, 0x75, 0xec, 0xc8,
-	0x64, 0x50, 0xb2, 0x31, 0xf6, 0x2f, 0x40, 0xbe, 0xb3, 0x43, 0xa0, 0x54, 0xdd, 0xce, 0xf7, 0xde,
-	0xf7, 0xde, 0x7d, 0x4f, 0xcf, 0x78, 0x9f, 0xe7, 0x72, 0x9a, 0x28, 0x3f, 0xe6, 0x19, 0x57, 0x42,
-	0xb1, 0xbc, 0x90, 0x20, 0x09, 0x1d, 0x43, 0x21, 0x66, 0x7c, 0x11, 0x46, 0x8a, 0x29, 0x7d, 0x64,
-	0x86, 0x37, 0xdc, 0x8f, 0x65, 0x2c, 0x35, 0xc9, 0xaf, 0x4f, 0x86, 0x3f, 0xb4, 0x62, 0x29, 0xe3,
-	0x05, 0xf7, 0xf5, 0x57, 0x54, 0xce, 0xfd, 0x59, 0x59, 0x84, 0x20, 0x64, 0xd6, 0xe0, 0xf6, 0xbf,
-	0x38, 0x88, 0x94, 0x2b, 0x08, 0xd3, 0xdc, 0x10, 0xdc, 0xaf, 0x3d, 0x3c, 0x78, 0x59, 0x3b, 0xbc,
-	0xcd, 0xe6, 0x92, 0x58, 0x18, 0x8b, 0x19, 0xcf, 0x40, 0xcc, 0x05, 0x2f, 0x28, 0x72, 0x90, 0x37,
-	0x08, 0xb6, 0x6e, 0xc8, 0x47, 0x8c, 0x15, 0x84, 0x05, 0x4c, 0x6a, 0x19, 0x7a, 0xc5, 0x41, 0xde,
-	0xee, 0xd1, 0x90, 0x19, 0x0f, 0xd6, 0x7a, 0xb0, 0x0f, 0xad, 0xc7, 0xe8, 0xde, 0x49, 0x65, 0x77,
-	0xce, 0x2a, 0xfb, 0xe6, 0x32, 0x4c, 0x17, 0x4f, 0xdc, 0x3f, 0xb3, 0xee, 0xf1, 0x0f, 0x1b, 0x05,
-	0x03, 0x7d, 0x51, 0xd3, 0x49, 0x82, 0x77, 0xda, 0xa7, 0xd3, 0xae, 0xd6, 0xbd, 0x7b, 0x4e, 0xf7,
-	0x45, 0x43, 0x18, 0x3d, 0xac, 0x65, 0x7f, 0x55, 0x36, 0x69, 0x47, 0x0e, 0x65, 0x2a, 0x80, 0xa7,
-	0x39, 0x2c, 0xcf, 0x2a, 0xfb, 0x86, 0x31, 0x6b, 0x31, 0xf7, 0x5b, 0x6d, 0xb5, 0x51, 0x27, 0x07,
-	0xf8, 0xfa, 0xb4, 0x2c, 0x0a, 0x9e, 0xc1, 0x44, 0x47, 0x4b, 0x7b, 0x0e, 0xf2, 0xba, 0xc1, 0x5e,
-	0x73, 0xa9, 0xc3, 0x20, 0x5f, 0x10, 0xa6, 0x7f, 0xb1, 0x26, 0x5b, 0x7b, 0x5f, 0xbd, 0x74, 0xef,
-	0xfb, 0xcd, 0xde, 0xb6, 0x79, 0xca, 0x45, 0x4a, 0x26, 0x85, 0x5b, 0xdb, 0xce, 0xe3, 0x4d, 0x22,
-	0x8f, 0xf1, 0x6d, 0xc3, 0x9f, 0xca, 0x32, 0x03, 0x91, 0xc5, 0x66, 0x90, 0xcf, 0x68, 0xdf, 0x41,
-	0xde, 0x4e, 0x60, 0x0a, 0xf4, 0xbc, 0x01, 0xc7, 0x06, 0x23, 0x4f, 0xf1, 0xf0, 0x7f, 0x6e, 0x09,
-	0x17, 0x71, 0x02, 0xf4, 0x9a, 0x5e, 0xf5, 0xce, 0x39, 0xc3, 0x37, 0x1a, 0x76, 0xdf, 0xe3, 0xbd,
-	0xd7, 0xa6, 0x8e, 0x63, 0x08, 0x81, 0x93, 0x67, 0xb8, 0x6f, 0xda, 0x47, 0x91, 0xd3, 0xf5, 0x76,
-	0x8f, 0x0e, 0xd8, 0x45, 0xf5, 0x64, 0x9b, 0x0e, 0x8d, 0x7a, 0xf5, 0xee, 0x41, 0x33, 0x38, 0x7a,
-	0x75, 0xb2, 0xb2, 0xd0, 0xe9, 0xca, 0x42, 0x3f, 0x57, 0x16, 0x3a, 0x5e, 0x5b, 0x9d, 0xd3, 0xb5,
-	0xd5, 0xf9, 0xbe, 0xb6, 0x3a, 0x9f, 0x0e, 0x63, 0x01, 0x49, 0x19, 0xb1, 0xa9, 0x4c, 0x7d, 0x23,
-	0xfb, 0xe0, 0x5d, 0x18, 0x29, 0xdf, 0xe8, 0xfa, 0x9f, 0xfd, 0xe6, 0x07, 0x81, 0x65, 0xce, 0x55,
-	0xd4, 0xd7, 0x29, 0x3f, 0xfa, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x00, 0x08, 0x00, 0xc6, 0x37, 0x03,
-	0x00, 0x00,
+	// 524 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbd, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x91, 0x36, 0x24, 0xd7, 0x54, 0x88, 0x23, 0x50, 0x13, 0x09, 0xdb, 0x72, 0x17, 0x4b,
+	0x14, 0x5b, 0x14, 0x26, 0x2a, 0x21, 0x11, 0xbe, 0x25, 0x06, 0xe4, 0x30, 0x20, 0x96, 0xc8, 0x4e,
+	0x2e, 0xf6, 0x49, 0xb1, 0xcf, 0xb2, 0xcf, 0x52, 0x23, 0x16, 0x36, 0xd6, 0xb2, 0xf1, 0x27, 0x75,
+	0xec, 0xc8, 0x14, 0x50, 0xb2, 0x31, 0xf6, 0x2f, 0x40, 0xbe, 0x77, 0x0e, 0x09, 0x25, 0xca, 0x66,
+	0xbf, 0xf7, 0xfb, 0x78, 0x5f, 0x87, 0x3b, 0x34, 0xe5, 0xc3, 0x28, 0x77, 0x43, 0x9a, 0xd0, 0x9c,
+	0xe5, 0x4e, 0x9a, 0x71, 0xc1, 0x89, 0xd6, 0x17, 0x19, 0x1b, 0xd1, 0x89, 0x1f, 0xe4, 0x4e, 0x2e,
+	0x3f, 0x1d, 0xc0, 0x75, 0x3b, 0x21, 0x0f, 0xb9, 0x04, 0xb9, 0xe5, 0x17, 0xe0, 0xbb, 0x7a, 0xc8,
+	0x79, 0x38, 0xa1, 0xae, 0xfc, 0x0b, 0x8a, 0xb1, 0x3b, 0x2a, 0x32, 0x5f, 0x30, 0x9e, 0xa8, 0xbc,
+	0xf1, 0x6f, 0x5e, 0xb0, 0x98, 0xe6, 0xc2, 0x8f, 0x53, 0x05, 0xb8, 0xa5, 0xca, 0x48, 0xfd, 0xcc,
+	0x8f, 0x55, 0x15, 0xd6, 0xd7, 0x5d, 0xdc, 0x7a, 0x59, 0xc6, 0xdf, 0x26, 0x63, 0x4e, 0x74, 0x8c,
+	0xd9, 0x88, 0x26, 0x82, 0x8d, 0x19, 0xcd, 0x34, 0x64, 0x22, 0xbb, 0xe5, 0xad, 0x44, 0xc8, 0x47,
+	0x8c, 0x73, 0xe1, 0x67, 0x62, 0x50, 0x6a, 0x6b, 0xd7, 0x4c, 0x64, 0xef, 0x1d, 0x77, 0x1d, 0x30,
+	0x76, 0x2a, 0x63, 0xe7, 0x43, 0x65, 0xdc, 0xbb, 0x77, 0x3e, 0x33, 0x6a, 0x97, 0x33, 0xe3, 0xe6,
+	0xd4, 0x8f, 0x27, 0x4f, 0xac, 0xbf, 0x5c, 0xeb, 0xec, 0xa7, 0x81, 0xbc, 0x96, 0x0c, 0x94, 0x70,
+	0x12, 0xe1, 0x66, 0xd5, 0x8f, 0x56, 0x97, 0xba, 0x77, 0xaf, 0xe8, 0xbe, 0x50, 0x80, 0xde, 0xc3,
+	0x52, 0xf6, 0xf7, 0xcc, 0x20, 0x15, 0xe5, 0x88, 0xc7, 0x4c, 0xd0, 0x38, 0x15, 0xd3, 0xcb, 0x99,
+	0x71, 0x03, 0xcc, 0xaa, 0x9c, 0xf5, 0xbd, 0xb4, 0x5a, 0xaa, 0x93, 0x43, 0xbc, 0x3f, 0x2c, 0xb2,
+	0x8c, 0x26, 0x62, 0x20, 0x07, 0xa2, 0xed, 0x98, 0xc8, 0xae, 0x7b, 0x6d, 0x15, 0x94, 0xc3, 0x20,
+	0x5f, 0x10, 0xd6, 0xd6, 0x50, 0x83, 0x95, 0xbe, 0x77, 0xb7, 0xf6, 0x7d, 0x5f, 0xf5, 0x6d, 0x40,
+	0x29, 0x9b, 0x94, 0x60, 0x0a, 0xb7, 0x57, 0x9d, 0xfb, 0xcb, 0x89, 0x3c, 0xc6, 0x77, 0x00, 0x3f,
+	0xe4, 0x45, 0x22, 0x58, 0x12, 0x02, 0x91, 0x8e, 0xb4, 0x86, 0x89, 0xec, 0xa6, 0x07, 0x57, 0xf5,
+	0x5c, 0x25, 0xfb, 0x90, 0x23, 0x27, 0xb8, 0xfb, 0x3f, 0xb7, 0x88, 0xb2, 0x30, 0x12, 0xda, 0x75,
+	0xd9, 0xea, 0xc1, 0x15, 0xc3, 0x37, 0x32, 0x4d, 0x3e, 0xe3, 0xfd, 0x84, 0x9e, 0x8a, 0xc1, 0x72,
+	0x13, 0xcd, 0x6d, 0x9b, 0x38, 0x51, 0x9b, 0x38, 0x58, 0xe3, 0xad, 0xad, 0xa3, 0x03, 0x33, 0x58,
+	0x03, 0xc0, 0x4e, 0xda, 0x65, 0xac, 0x92, 0xb2, 0xbe, 0x21, 0xdc, 0x7e, 0x0d, 0x2f, 0xa4, 0x2f,
+	0x7c, 0x41, 0xc9, 0x33, 0xdc, 0x80, 0x8b, 0xd5, 0x90, 0x59, 0xb7, 0xf7, 0x8e, 0x0f, 0x9d, 0x4d,
+	0x2f, 0xc6, 0x59, 0x5e, 0x70, 0x6f, 0xa7, 0x2c, 0xc8, 0x53, 0x44, 0xf2, 0x14, 0x37, 0xe0, 0xda,
+	0xd5, 0xad, 0x9a, 0x9b, 0x25, 0xde, 0x4b, 0x5c, 0xc5, 0x07, 0x56, 0xef, 0xd5, 0xf9, 0x5c, 0x47,
+	0x17, 0x73, 0x1d, 0xfd, 0x9a, 0xeb, 0xe8, 0x6c, 0xa1, 0xd7, 0x2e, 0x16, 0x7a, 0xed, 0xc7, 0x42,
+	0xaf, 0x7d, 0x3a, 0x0a, 0x99, 0x88, 0x8a, 0xc0, 0x19, 0xf2, 0xd8, 0x05, 0xcd, 0x07, 0xef, 0xfc,
+	0x20, 0x77, 0x41, 0xd4, 0x3d, 0x75, 0xd5, 0x63, 0x13, 0xd3, 0x94, 0xe6, 0x41, 0x43, 0x4e, 0xee,
+	0xd1, 0x9f, 0x01, 0x00, 0x47, 0x00, 0x9b, 0xa7, 0x0a, 0x04, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -226,6 +247,14 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NextDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -241,12 +270,12 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentEpochStartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if m.CurrentEpoch != 0 {
@@ -254,21 +283,21 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Identifier) > 0 {
 		i -= len(m.Identifier)
@@ -300,6 +329,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -353,6 +392,8 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextDuration)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -368,6 +409,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -595,6 +638,39 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NextDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -679,6 +755,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,14 +6,15 @@ import (
 
 type EpochHooks interface {
 	// the first block whose timestamp is after the duration is counted as the end of the epoch
-	AfterEpochEnd(ctx sdk.Context, epochInfo EpochInfo)
+	AfterEpochEnd(ctx sdk.Context, epochInfo EpochInfo) error
 	// new epoch is next block of epoch end block
-	BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo)
+	BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo) error
 }
 
 var _ EpochHooks = MultiEpochHooks{}
 
 // combine multiple gamm hooks, all hook functions are run in array sequence
+// NOTE: the epochs keeper calls each of them in isolation, while these stop at the first error
 type MultiEpochHooks []EpochHooks
 
 func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
@@ -21,15 +22,21 @@ func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
 }
 
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the number of epoch that is ending
-func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochInfo EpochInfo) {
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochInfo EpochInfo) error {
 	for i := range h {
-		h[i].AfterEpochEnd(ctx, epochInfo)
+		if err := h[i].AfterEpochEnd(ctx, epochInfo); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 // BeforeEpochStart is called when epoch is going to be started, epochNumber is the number of epoch that is starting
-func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo) {
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochInfo EpochInfo) error {
 	for i := range h {
-		h[i].BeforeEpochStart(ctx, epochInfo)
+		if err := h[i].BeforeEpochStart(ctx, epochInfo); err != nil {
+			return err
+		}
 	}
+	return nil
 }
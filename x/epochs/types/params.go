@@ -0,0 +1,60 @@
+package types
+
+import (
+	fmt "fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default init params
+var (
+	// hooks aren't limited by default, as stakeibc's scale with the number of host zones
+	DefaultHookGasLimit uint64 = 0
+
+	KeyHookGasLimit = []byte("HookGasLimit")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the epochs module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(hookGasLimit uint64) Params {
+	return Params{
+		HookGasLimit: hookGasLimit,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultHookGasLimit)
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateUint64),
+	}
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	return nil
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateUint64(p.HookGasLimit)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddEpoch            = "AddEpoch"
+	ProposalTypeRemoveEpoch         = "RemoveEpoch"
+	ProposalTypeUpdateEpochDuration = "UpdateEpochDuration"
+)
+
+var (
+	_ govtypes.Content = &AddEpochProposal{}
+	_ govtypes.Content = &RemoveEpochProposal{}
+	_ govtypes.Content = &UpdateEpochDurationProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddEpoch)
+	govtypes.RegisterProposalTypeCodec(&AddEpochProposal{}, "stride/AddEpochProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveEpoch)
+	govtypes.RegisterProposalTypeCodec(&RemoveEpochProposal{}, "stride/RemoveEpochProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateEpochDuration)
+	govtypes.RegisterProposalTypeCodec(&UpdateEpochDurationProposal{}, "stride/UpdateEpochDurationProposal")
+}
+
+// NewAddEpochProposal creates a proposal to add an epoch identifier
+func NewAddEpochProposal(title, description string, epoch EpochInfo) *AddEpochProposal {
+	return &AddEpochProposal{
+		Title:       title,
+		Description: description,
+		Identifier:  epoch.Identifier,
+		StartTime:   epoch.StartTime,
+		Duration:    epoch.Duration,
+	}
+}
+
+func (p *AddEpochProposal) GetTitle() string       { return p.Title }
+func (p *AddEpochProposal) GetDescription() string { return p.Description }
+func (p *AddEpochProposal) ProposalRoute() string  { return RouterKey }
+func (p *AddEpochProposal) ProposalType() string   { return ProposalTypeAddEpoch }
+
+func (p *AddEpochProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(p.Identifier); err != nil {
+		return err
+	}
+	if p.Duration <= 0 {
+		return ErrInvalidDuration
+	}
+	return nil
+}
+
+func (p AddEpochProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Epoch Proposal:
+  Title:       %s
+  Description: %s
+  Identifier:  %s
+  Start Time:  %s
+  Duration:    %s
+`, p.Title, p.Description, p.Identifier, p.StartTime, p.Duration))
+	return b.String()
+}
+
+// NewRemoveEpochProposal creates a proposal to remove an epoch identifier
+func NewRemoveEpochProposal(title, description, identifier string) *RemoveEpochProposal {
+	return &RemoveEpochProposal{
+		Title:       title,
+		Description: description,
+		Identifier:  identifier,
+	}
+}
+
+func (p *RemoveEpochProposal) GetTitle() string       { return p.Title }
+func (p *RemoveEpochProposal) GetDescription() string { return p.Description }
+func (p *RemoveEpochProposal) ProposalRoute() string  { return RouterKey }
+func (p *RemoveEpochProposal) ProposalType() string   { return ProposalTypeRemoveEpoch }
+
+func (p *RemoveEpochProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return ValidateEpochIdentifierString(p.Identifier)
+}
+
+func (p RemoveEpochProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Epoch Proposal:
+  Title:       %s
+  Description: %s
+  Identifier:  %s
+`, p.Title, p.Description, p.Identifier))
+	return b.String()
+}
+
+// NewUpdateEpochDurationProposal creates a proposal to change the duration of an epoch identifier
+func NewUpdateEpochDurationProposal(title, description, identifier string, duration time.Duration) *UpdateEpochDurationProposal {
+	return &UpdateEpochDurationProposal{
+		Title:       title,
+		Description: description,
+		Identifier:  identifier,
+		Duration:    duration,
+	}
+}
+
+func (p *UpdateEpochDurationProposal) GetTitle() string       { return p.Title }
+func (p *UpdateEpochDurationProposal) GetDescription() string { return p.Description }
+func (p *UpdateEpochDurationProposal) ProposalRoute() string  { return RouterKey }
+func (p *UpdateEpochDurationProposal) ProposalType() string   { return ProposalTypeUpdateEpochDuration }
+
+func (p *UpdateEpochDurationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := ValidateEpochIdentifierString(p.Identifier); err != nil {
+		return err
+	}
+	if p.Duration <= 0 {
+		return ErrInvalidDuration
+	}
+	return nil
+}
+
+func (p UpdateEpochDurationProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Epoch Duration Proposal:
+  Title:       %s
+  Description: %s
+  Identifier:  %s
+  Duration:    %s
+`, p.Title, p.Description, p.Identifier, p.Duration))
+	return b.String()
+}
@@ -10,19 +10,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -302,6 +306,196 @@ func (m *QueryEpochInfoResponse) GetEpoch() EpochInfo {
 	return EpochInfo{}
 }
 
+type QueryEpochScheduleRequest struct {
+	// epoch identifier, every epoch identifier if empty
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// upcoming epochs per identifier, 1 if unset
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryEpochScheduleRequest) Reset()         { *m = QueryEpochScheduleRequest{} }
+func (m *QueryEpochScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochScheduleRequest) ProtoMessage()    {}
+func (*QueryEpochScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e760c2f82b90e24, []int{6}
+}
+func (m *QueryEpochScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochScheduleRequest.Merge(m, src)
+}
+func (m *QueryEpochScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryEpochScheduleRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *QueryEpochScheduleRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type QueryEpochScheduleResponse struct {
+	Schedules []EpochSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// block time the start heights are estimated with
+	AverageBlockTime time.Duration `protobuf:"bytes,2,opt,name=average_block_time,json=averageBlockTime,proto3,stdduration" json:"average_block_time"`
+}
+
+func (m *QueryEpochScheduleResponse) Reset()         { *m = QueryEpochScheduleResponse{} }
+func (m *QueryEpochScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochScheduleResponse) ProtoMessage()    {}
+func (*QueryEpochScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e760c2f82b90e24, []int{7}
+}
+func (m *QueryEpochScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochScheduleResponse.Merge(m, src)
+}
+func (m *QueryEpochScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryEpochScheduleResponse) GetSchedules() []EpochSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QueryEpochScheduleResponse) GetAverageBlockTime() time.Duration {
+	if m != nil {
+		return m.AverageBlockTime
+	}
+	return 0
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e760c2f82b90e24, []int{8}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e760c2f82b90e24, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "Stridelabs.stride.epochs.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "Stridelabs.stride.epochs.QueryEpochsInfoResponse")
@@ -309,44 +503,62 @@ func init() {
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "Stridelabs.stride.epochs.QueryCurrentEpochResponse")
 	proto.RegisterType((*QueryEpochInfoRequest)(nil), "Stridelabs.stride.epochs.QueryEpochInfoRequest")
 	proto.RegisterType((*QueryEpochInfoResponse)(nil), "Stridelabs.stride.epochs.QueryEpochInfoResponse")
+	proto.RegisterType((*QueryEpochScheduleRequest)(nil), "Stridelabs.stride.epochs.QueryEpochScheduleRequest")
+	proto.RegisterType((*QueryEpochScheduleResponse)(nil), "Stridelabs.stride.epochs.QueryEpochScheduleResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "Stridelabs.stride.epochs.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "Stridelabs.stride.epochs.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("epochs/query.proto", fileDescriptor_2e760c2f82b90e24) }
 
 var fileDescriptor_2e760c2f82b90e24 = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x41,
-	0x18, 0xc6, 0x3b, 0x54, 0x48, 0x78, 0xc1, 0xcb, 0x04, 0xb5, 0x54, 0xb3, 0xe2, 0x62, 0x00, 0x0d,
-	0xce, 0xd0, 0x72, 0x30, 0xf1, 0xe2, 0xbf, 0x88, 0x31, 0xf1, 0xa0, 0xeb, 0x49, 0x2f, 0xb8, 0xbb,
-	0x0c, 0xcb, 0x24, 0x30, 0xb3, 0xec, 0x4c, 0x8d, 0x5c, 0xfd, 0x04, 0x26, 0x7a, 0xf4, 0x6a, 0xe2,
-	0x47, 0xe1, 0x48, 0xe2, 0xc5, 0x93, 0x31, 0xad, 0x07, 0x3f, 0x86, 0xd9, 0x77, 0x06, 0xba, 0x0d,
-	0x6d, 0x4a, 0x4f, 0xdd, 0xec, 0xbc, 0xcf, 0xf3, 0xfc, 0xe6, 0x79, 0x9b, 0x05, 0x2a, 0x72, 0x9d,
-	0xee, 0x19, 0x7e, 0xd8, 0x11, 0xc5, 0x11, 0xcb, 0x0b, 0x6d, 0x35, 0x6d, 0xbc, 0xb1, 0x85, 0xdc,
-	0x11, 0xfb, 0x71, 0x62, 0x98, 0xc1, 0x47, 0xe6, 0xa6, 0x9a, 0x0b, 0x99, 0xce, 0x34, 0x0e, 0xf1,
-	0xf2, 0xc9, 0xcd, 0x37, 0x6f, 0x64, 0x5a, 0x67, 0xfb, 0x82, 0xc7, 0xb9, 0xe4, 0xb1, 0x52, 0xda,
-	0xc6, 0x56, 0x6a, 0x65, 0xfc, 0xe9, 0xdd, 0x54, 0x9b, 0x03, 0x6d, 0x78, 0x12, 0x1b, 0xe1, 0x62,
-	0xf8, 0x87, 0x56, 0x22, 0x6c, 0xdc, 0xe2, 0x79, 0x9c, 0x49, 0x85, 0xc3, 0x7e, 0x76, 0xc1, 0xd3,
-	0x64, 0x42, 0x09, 0x23, 0xbd, 0x43, 0xf8, 0x1e, 0xae, 0xbe, 0x2e, 0x75, 0xcf, 0xf0, 0xf0, 0x85,
-	0xda, 0xd5, 0x91, 0x38, 0xec, 0x08, 0x63, 0xe9, 0x16, 0x40, 0xdf, 0xa3, 0x41, 0x96, 0xc8, 0xda,
-	0x5c, 0x7b, 0x85, 0xb9, 0x40, 0x56, 0x06, 0x32, 0x77, 0x2f, 0x1f, 0xc8, 0x5e, 0xc5, 0x99, 0xf0,
-	0xda, 0xa8, 0xa2, 0x0c, 0xbf, 0x13, 0xb8, 0x76, 0x2e, 0xc2, 0xe4, 0x5a, 0x19, 0x41, 0x1f, 0xc3,
-	0x8c, 0xa3, 0x6a, 0x90, 0xa5, 0xfa, 0xda, 0x5c, 0x7b, 0x99, 0x8d, 0xaa, 0x87, 0xa1, 0xba, 0x14,
-	0x3f, 0xb9, 0x74, 0xfc, 0xfb, 0x66, 0x2d, 0xf2, 0x42, 0xfa, 0x7c, 0x00, 0x73, 0x0a, 0x31, 0x57,
-	0xc7, 0x62, 0xba, 0xfc, 0x01, 0xce, 0x07, 0xd0, 0x40, 0xcc, 0xa7, 0x9d, 0xa2, 0x10, 0xca, 0x62,
-	0xde, 0x69, 0x17, 0x01, 0x80, 0xdc, 0x11, 0xca, 0xca, 0x5d, 0x29, 0x0a, 0xec, 0x62, 0x36, 0xaa,
-	0xbc, 0x09, 0x1f, 0xc1, 0xe2, 0x10, 0xad, 0xbf, 0xe4, 0x32, 0x5c, 0x4e, 0xdd, 0xfb, 0x6d, 0x64,
-	0x46, 0x7d, 0x3d, 0x9a, 0x4f, 0x2b, 0xc3, 0xe1, 0x7d, 0xb8, 0xd2, 0x2f, 0xa9, 0xba, 0x86, 0x71,
-	0xd1, 0x6f, 0xab, 0x0b, 0x1c, 0x28, 0xf7, 0x21, 0x4c, 0xf7, 0xf3, 0x26, 0xea, 0xd6, 0xe9, 0xda,
-	0xff, 0xea, 0x30, 0x8d, 0xde, 0xf4, 0x2b, 0x01, 0x38, 0x1b, 0x32, 0x74, 0x63, 0xb4, 0xd5, 0xf0,
-	0x3f, 0x53, 0xb3, 0x35, 0x81, 0xc2, 0xe1, 0x87, 0xb7, 0x3e, 0xfd, 0xfc, 0xfb, 0x65, 0xea, 0x3a,
-	0x5d, 0xe4, 0x7d, 0x29, 0x77, 0x52, 0xee, 0x77, 0xff, 0x83, 0xc0, 0x7c, 0xb5, 0x72, 0xda, 0x1e,
-	0x13, 0x33, 0x64, 0xb7, 0xcd, 0xcd, 0x89, 0x34, 0x1e, 0x8e, 0x23, 0xdc, 0x1d, 0xba, 0x3a, 0x12,
-	0x8e, 0x0f, 0xec, 0x9c, 0x7e, 0x23, 0x30, 0x7b, 0xd6, 0x20, 0xe5, 0x17, 0xa9, 0xa3, 0xda, 0xdf,
-	0xc6, 0xc5, 0x05, 0x9e, 0x70, 0x1d, 0x09, 0x57, 0xe8, 0xed, 0xd1, 0x84, 0xf8, 0xb3, 0x2d, 0xcb,
-	0xbd, 0x6f, 0x1d, 0x77, 0x03, 0x72, 0xd2, 0x0d, 0xc8, 0x9f, 0x6e, 0x40, 0x3e, 0xf7, 0x82, 0xda,
-	0x49, 0x2f, 0xa8, 0xfd, 0xea, 0x05, 0xb5, 0x77, 0xeb, 0x99, 0xb4, 0x7b, 0x9d, 0x84, 0xa5, 0xfa,
-	0xc0, 0x3b, 0xdd, 0x7b, 0x59, 0xb1, 0xfa, 0x78, 0x6a, 0x66, 0x8f, 0x72, 0x61, 0x92, 0x19, 0xfc,
-	0xaa, 0x6c, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xae, 0x4f, 0xfc, 0x2e, 0xfb, 0x04, 0x00, 0x00,
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0x33, 0xfd, 0x88, 0x6e, 0x4f, 0x5b, 0xe9, 0x6a, 0x9a, 0xf6, 0xa6, 0xb9, 0x57, 0x6e,
+	0xae, 0x8b, 0xda, 0x50, 0xb5, 0x9e, 0x36, 0x45, 0x42, 0x62, 0xc1, 0x47, 0x80, 0x22, 0x04, 0x8b,
+	0x36, 0x85, 0x05, 0x6c, 0x82, 0xe3, 0x4c, 0x5d, 0x8b, 0xc4, 0x93, 0x7a, 0xec, 0x8a, 0x6e, 0x79,
+	0x01, 0x90, 0x60, 0xc1, 0x82, 0x2d, 0x12, 0x48, 0x3c, 0x02, 0x0f, 0xd0, 0x65, 0x25, 0x36, 0xac,
+	0x00, 0xb5, 0x3c, 0x08, 0xca, 0xcc, 0x71, 0xe3, 0x94, 0x44, 0x49, 0x56, 0xb1, 0x8f, 0xcf, 0xff,
+	0x9c, 0xdf, 0x9c, 0x39, 0xff, 0x00, 0xe5, 0x4d, 0xe1, 0xec, 0x4b, 0x76, 0x10, 0xf1, 0xe0, 0xc8,
+	0x6a, 0x06, 0x22, 0x14, 0x34, 0xbb, 0x1b, 0x06, 0x5e, 0x8d, 0xd7, 0xed, 0xaa, 0xb4, 0xa4, 0x7a,
+	0xb4, 0x74, 0x56, 0x2e, 0xe3, 0x0a, 0x57, 0xa8, 0x24, 0xd6, 0x7a, 0xd2, 0xf9, 0xb9, 0xff, 0x5c,
+	0x21, 0xdc, 0x3a, 0x67, 0x76, 0xd3, 0x63, 0xb6, 0xef, 0x8b, 0xd0, 0x0e, 0x3d, 0xe1, 0x4b, 0xfc,
+	0xba, 0xe2, 0x08, 0xd9, 0x10, 0x92, 0x55, 0x6d, 0xc9, 0x75, 0x1b, 0x76, 0xb8, 0x51, 0xe5, 0xa1,
+	0xbd, 0xc1, 0x9a, 0xb6, 0xeb, 0xf9, 0x2a, 0x19, 0x73, 0x33, 0x48, 0xe3, 0x72, 0x9f, 0x4b, 0x2f,
+	0xae, 0x30, 0x83, 0xd1, 0xa6, 0x1d, 0xd8, 0x8d, 0x38, 0x38, 0x8b, 0x41, 0xe9, 0xec, 0xf3, 0x5a,
+	0x54, 0xe7, 0x18, 0x36, 0x90, 0x45, 0xbd, 0x55, 0xa3, 0x3d, 0x56, 0x8b, 0x82, 0x44, 0x07, 0xf3,
+	0x19, 0xcc, 0xed, 0xb4, 0x18, 0xee, 0x2a, 0xf5, 0x7d, 0x7f, 0x4f, 0x94, 0xf9, 0x41, 0xc4, 0x65,
+	0x48, 0xb7, 0x00, 0xda, 0x3c, 0x59, 0x92, 0x27, 0x85, 0xc9, 0xe2, 0x92, 0xa5, 0xe1, 0xad, 0x16,
+	0xbc, 0xa5, 0x67, 0x84, 0xf0, 0xd6, 0xb6, 0xed, 0x72, 0xd4, 0x96, 0x13, 0x4a, 0xf3, 0x03, 0x81,
+	0x7f, 0xfe, 0x68, 0x21, 0x9b, 0xc2, 0x97, 0x9c, 0xde, 0x82, 0xb4, 0xc6, 0xce, 0x92, 0xfc, 0x68,
+	0x61, 0xb2, 0xb8, 0x68, 0xf5, 0x1a, 0xb5, 0xa5, 0xd4, 0x2d, 0x71, 0x69, 0xec, 0xf8, 0xfb, 0x42,
+	0xaa, 0x8c, 0x42, 0x7a, 0xaf, 0x03, 0x73, 0x44, 0x61, 0x2e, 0xf7, 0xc5, 0xd4, 0xfd, 0x3b, 0x38,
+	0xaf, 0x41, 0x56, 0x61, 0xde, 0x8e, 0x82, 0x80, 0xfb, 0xa1, 0xea, 0x17, 0xcf, 0xc2, 0x00, 0xf0,
+	0x6a, 0xdc, 0x0f, 0xbd, 0x3d, 0x8f, 0x07, 0x6a, 0x16, 0x13, 0xe5, 0x44, 0xc4, 0xbc, 0x09, 0xf3,
+	0x5d, 0xb4, 0x78, 0xc8, 0x45, 0x98, 0x76, 0x74, 0xbc, 0xa2, 0x98, 0x95, 0x7e, 0xb4, 0x3c, 0xe5,
+	0x24, 0x92, 0xcd, 0xab, 0x30, 0xdb, 0x1e, 0x52, 0xf2, 0x1a, 0xfa, 0xb5, 0x7e, 0x02, 0x73, 0x17,
+	0x85, 0xd8, 0xf7, 0x06, 0x8c, 0xb7, 0xfb, 0x0d, 0x35, 0x5b, 0xad, 0x33, 0x77, 0xf0, 0x54, 0xea,
+	0xf3, 0x2e, 0xee, 0xd5, 0x80, 0x5c, 0x34, 0x03, 0xe3, 0x8e, 0x88, 0xfc, 0x50, 0x5d, 0xc9, 0x58,
+	0x59, 0xbf, 0x98, 0x5f, 0x08, 0xe4, 0xba, 0xd5, 0x44, 0xe4, 0x07, 0x30, 0x11, 0xef, 0x6f, 0xbc,
+	0x12, 0xcb, 0x7d, 0xb0, 0xe3, 0x1a, 0x88, 0xde, 0xd6, 0xd3, 0x1d, 0xa0, 0xf6, 0x21, 0x0f, 0x6c,
+	0x97, 0x57, 0xaa, 0x75, 0xe1, 0x3c, 0xaf, 0x84, 0x5e, 0x83, 0xe3, 0x86, 0xcc, 0x5b, 0xda, 0x17,
+	0x56, 0xec, 0x0b, 0xeb, 0x0e, 0xfa, 0xa2, 0xf4, 0x57, 0xab, 0xce, 0xbb, 0x1f, 0x0b, 0xa4, 0xfc,
+	0x37, 0xca, 0x4b, 0x2d, 0xf5, 0x23, 0xaf, 0xc1, 0xcd, 0x0c, 0x50, 0x45, 0xbf, 0xad, 0x9c, 0x87,
+	0xa3, 0x30, 0x1f, 0xc3, 0x4c, 0x47, 0x14, 0x0f, 0x73, 0x1d, 0xd2, 0xda, 0xa1, 0x78, 0x01, 0xf9,
+	0xde, 0x27, 0xd1, 0xca, 0x78, 0xb3, 0xb5, 0xaa, 0xf8, 0x29, 0x0d, 0xe3, 0xaa, 0x2e, 0x7d, 0x4b,
+	0x00, 0xce, 0xef, 0x48, 0xd2, 0xf5, 0xde, 0x85, 0xba, 0x7b, 0x39, 0xb7, 0x31, 0x84, 0x42, 0xd3,
+	0x9b, 0xff, 0xbf, 0xfc, 0xfa, 0xeb, 0xcd, 0xc8, 0xbf, 0x74, 0x9e, 0xb5, 0xa5, 0x4c, 0x4b, 0x19,
+	0x5a, 0xef, 0x23, 0x81, 0xa9, 0xe4, 0xc6, 0xd3, 0x62, 0x9f, 0x36, 0x5d, 0xac, 0x95, 0xdb, 0x1c,
+	0x4a, 0x83, 0x70, 0x4c, 0xc1, 0x5d, 0xa6, 0xcb, 0x3d, 0xe1, 0x58, 0x87, 0xe5, 0xe8, 0x7b, 0x02,
+	0x13, 0xe7, 0x13, 0xa4, 0x6c, 0x90, 0x71, 0x24, 0xe7, 0xb7, 0x3e, 0xb8, 0x00, 0x09, 0x57, 0x15,
+	0xe1, 0x12, 0xbd, 0xd4, 0x9b, 0x50, 0xfd, 0x54, 0xbc, 0x16, 0xd0, 0x67, 0x02, 0xd3, 0x1d, 0xdb,
+	0x4c, 0x37, 0x07, 0xe9, 0x78, 0xc1, 0x93, 0xb9, 0x2b, 0xc3, 0x89, 0x10, 0x75, 0x5d, 0xa1, 0xae,
+	0xd0, 0x42, 0x3f, 0xd4, 0xd8, 0x5a, 0xf4, 0x15, 0x81, 0xb4, 0x5e, 0x59, 0xba, 0xda, 0xa7, 0x65,
+	0x87, 0x53, 0x72, 0x6b, 0x03, 0x66, 0x23, 0x59, 0x41, 0x91, 0x99, 0x34, 0xdf, 0x9b, 0x4c, 0x7b,
+	0xa5, 0xb4, 0x75, 0x7c, 0x6a, 0x90, 0x93, 0x53, 0x83, 0xfc, 0x3c, 0x35, 0xc8, 0xeb, 0x33, 0x23,
+	0x75, 0x72, 0x66, 0xa4, 0xbe, 0x9d, 0x19, 0xa9, 0xa7, 0xab, 0xae, 0x17, 0xee, 0x47, 0x55, 0xcb,
+	0x11, 0x0d, 0xac, 0xb2, 0xf6, 0x30, 0x51, 0xe6, 0x45, 0x5c, 0x28, 0x3c, 0x6a, 0x72, 0x59, 0x4d,
+	0xab, 0xff, 0x83, 0xcd, 0xdf, 0x03, 0x00, 0x73, 0x7b, 0xb1, 0xa3, 0x07, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -367,6 +579,11 @@ type QueryClient interface {
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+	// EpochSchedule provides the next start times and estimated start heights
+	// of every epoch identifier, or of the one requested
+	EpochSchedule(ctx context.Context, in *QueryEpochScheduleRequest, opts ...grpc.CallOption) (*QueryEpochScheduleResponse, error)
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -404,6 +621,24 @@ func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest,
 	return out, nil
 }
 
+func (c *queryClient) EpochSchedule(ctx context.Context, in *QueryEpochScheduleRequest, opts ...grpc.CallOption) (*QueryEpochScheduleResponse, error) {
+	out := new(QueryEpochScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.epochs.Query/EpochSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.epochs.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
@@ -412,6 +647,11 @@ type QueryServer interface {
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+	// EpochSchedule provides the next start times and estimated start heights
+	// of every epoch identifier, or of the one requested
+	EpochSchedule(context.Context, *QueryEpochScheduleRequest) (*QueryEpochScheduleResponse, error)
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -427,6 +667,12 @@ func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCur
 func (*UnimplementedQueryServer) EpochInfo(ctx context.Context, req *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
 }
+func (*UnimplementedQueryServer) EpochSchedule(ctx context.Context, req *QueryEpochScheduleRequest) (*QueryEpochScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSchedule not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -486,6 +732,42 @@ func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.epochs.Query/EpochSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSchedule(ctx, req.(*QueryEpochScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.epochs.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Stridelabs.stride.epochs.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -502,6 +784,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochInfo",
 			Handler:    _Query_EpochInfo_Handler,
 		},
+		{
+			MethodName: "EpochSchedule",
+			Handler:    _Query_EpochSchedule_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "epochs/query.proto",
@@ -712,77 +1002,237 @@ func (m *QueryEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEpochScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEpochsInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryEpochScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochsInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEpochScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Epochs) > 0 {
-		for _, e := range m.Epochs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Identifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEpochScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEpochScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CurrentEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AverageBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	return n
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEpochsInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochsInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
+func (m *QueryEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -790,27 +1240,344 @@ func (m *QueryEpochInfoRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryEpochScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AverageBlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochInfo{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryEpochInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Epoch.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -833,17 +1600,17 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
 			}
-			var msglen int
+			m.CurrentEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -853,28 +1620,11 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CurrentEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -896,7 +1646,7 @@ func (m *QueryEpochsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -919,17 +1669,17 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -939,29 +1689,77 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Epochs = append(m.Epochs, EpochInfo{})
-			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -988,10 +1786,7 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1016,7 +1811,7 @@ func (m *QueryEpochsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1039,10 +1834,10 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1077,6 +1872,25 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Identifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1098,7 +1912,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1121,17 +1935,17 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
-			m.CurrentEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1141,11 +1955,59 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpoch |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, EpochSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AverageBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1167,7 +2029,7 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,44 +2052,12 @@ func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Identifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1249,7 +2079,7 @@ func (m *QueryEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1272,15 +2102,15 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1307,7 +2137,7 @@ func (m *QueryEpochInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
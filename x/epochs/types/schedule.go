@@ -0,0 +1,10 @@
+package types
+
+import "time"
+
+const (
+	// block time start heights are estimated with before any epoch has run for a block
+	DefaultAverageBlockTime = 6 * time.Second
+	// most upcoming epochs an epoch schedule query returns per identifier
+	MaxEpochScheduleCount = 100
+)
@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: epochs/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpcomingEpoch is when an epoch that hasn't started yet is expected to start
+type UpcomingEpoch struct {
+	EpochNumber int64     `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	StartTime   time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// height of the first block past start_time, the epoch's hooks run in,
+	// estimated from the average block time
+	EstimatedStartHeight int64 `protobuf:"varint,3,opt,name=estimated_start_height,json=estimatedStartHeight,proto3" json:"estimated_start_height,omitempty"`
+}
+
+func (m *UpcomingEpoch) Reset()         { *m = UpcomingEpoch{} }
+func (m *UpcomingEpoch) String() string { return proto.CompactTextString(m) }
+func (*UpcomingEpoch) ProtoMessage()    {}
+func (*UpcomingEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9afe10958de0b77, []int{0}
+}
+func (m *UpcomingEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpcomingEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpcomingEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpcomingEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpcomingEpoch.Merge(m, src)
+}
+func (m *UpcomingEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpcomingEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpcomingEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpcomingEpoch proto.InternalMessageInfo
+
+func (m *UpcomingEpoch) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *UpcomingEpoch) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *UpcomingEpoch) GetEstimatedStartHeight() int64 {
+	if m != nil {
+		return m.EstimatedStartHeight
+	}
+	return 0
+}
+
+// EpochSchedule lists the next epochs of an epoch identifier
+type EpochSchedule struct {
+	Identifier     string          `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	CurrentEpoch   int64           `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	UpcomingEpochs []UpcomingEpoch `protobuf:"bytes,3,rep,name=upcoming_epochs,json=upcomingEpochs,proto3" json:"upcoming_epochs"`
+}
+
+func (m *EpochSchedule) Reset()         { *m = EpochSchedule{} }
+func (m *EpochSchedule) String() string { return proto.CompactTextString(m) }
+func (*EpochSchedule) ProtoMessage()    {}
+func (*EpochSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9afe10958de0b77, []int{1}
+}
+func (m *EpochSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochSchedule.Merge(m, src)
+}
+func (m *EpochSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochSchedule proto.InternalMessageInfo
+
+func (m *EpochSchedule) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochSchedule) GetCurrentEpoch() int64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
+func (m *EpochSchedule) GetUpcomingEpochs() []UpcomingEpoch {
+	if m != nil {
+		return m.UpcomingEpochs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*UpcomingEpoch)(nil), "Stridelabs.stride.epochs.UpcomingEpoch")
+	proto.RegisterType((*EpochSchedule)(nil), "Stridelabs.stride.epochs.EpochSchedule")
+}
+
+func init() { proto.RegisterFile("epochs/schedule.proto", fileDescriptor_d9afe10958de0b77) }
+
+var fileDescriptor_d9afe10958de0b77 = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0x4e, 0xe3, 0x30,
+	0x18, 0x8d, 0x27, 0xa3, 0x91, 0xea, 0xb6, 0x33, 0x9a, 0xa8, 0x33, 0x8a, 0x2a, 0x4d, 0xd2, 0x09,
+	0x0b, 0xba, 0x00, 0x5b, 0x2a, 0xac, 0x58, 0x56, 0x02, 0xb1, 0x40, 0x2c, 0x52, 0x40, 0x88, 0x4d,
+	0x94, 0x1f, 0x37, 0xb1, 0x94, 0xc4, 0x51, 0xec, 0x48, 0xf4, 0x16, 0x3d, 0x08, 0xa7, 0x60, 0xd5,
+	0x65, 0x97, 0xac, 0x0a, 0x6a, 0x6f, 0xc0, 0x09, 0x50, 0xec, 0x14, 0xca, 0x82, 0xdd, 0xe7, 0xf7,
+	0x3d, 0x7f, 0xef, 0x7b, 0xcf, 0x86, 0x7f, 0x48, 0xc1, 0xc2, 0x84, 0x63, 0x1e, 0x26, 0x24, 0xaa,
+	0x52, 0x82, 0x8a, 0x92, 0x09, 0x66, 0x98, 0x13, 0x51, 0xd2, 0x88, 0xa4, 0x7e, 0xc0, 0x11, 0x97,
+	0x25, 0x52, 0xc4, 0x7e, 0x2f, 0x66, 0x31, 0x93, 0x24, 0x5c, 0x57, 0x8a, 0xdf, 0xb7, 0x63, 0xc6,
+	0xe2, 0x94, 0x60, 0x79, 0x0a, 0xaa, 0x29, 0x16, 0x34, 0x23, 0x5c, 0xf8, 0x59, 0xa1, 0x08, 0xce,
+	0x23, 0x80, 0xdd, 0xeb, 0x22, 0x64, 0x19, 0xcd, 0xe3, 0xd3, 0x7a, 0x92, 0xf1, 0x1f, 0x76, 0xe4,
+	0x48, 0x2f, 0xaf, 0xb2, 0x80, 0x94, 0x26, 0x18, 0x80, 0xa1, 0xee, 0xb6, 0x25, 0x76, 0x29, 0x21,
+	0xe3, 0x16, 0x42, 0x2e, 0xfc, 0x52, 0x78, 0xf5, 0x34, 0xf3, 0xdb, 0x00, 0x0c, 0xdb, 0xa3, 0x3e,
+	0x52, 0x52, 0x68, 0x2b, 0x85, 0xae, 0xb6, 0x52, 0xe3, 0x7f, 0x8b, 0x95, 0xad, 0xbd, 0xae, 0xec,
+	0xdf, 0x33, 0x3f, 0x4b, 0x4f, 0x9c, 0x8f, 0xbb, 0xce, 0xfc, 0xd9, 0x06, 0x6e, 0x4b, 0x02, 0x35,
+	0xdd, 0x38, 0x86, 0x7f, 0x09, 0x17, 0x34, 0xf3, 0x05, 0x89, 0x3c, 0xc5, 0x4b, 0x08, 0x8d, 0x13,
+	0x61, 0xea, 0x72, 0x8d, 0xde, 0x7b, 0x77, 0x52, 0x37, 0xcf, 0x65, 0xcf, 0x79, 0x00, 0xb0, 0x2b,
+	0x97, 0x9f, 0x34, 0x69, 0x19, 0x16, 0x84, 0x34, 0x22, 0xb9, 0xa0, 0x53, 0xda, 0x58, 0x68, 0xb9,
+	0x3b, 0x88, 0xb1, 0x07, 0xbb, 0x61, 0x55, 0x96, 0x24, 0x17, 0x9e, 0x34, 0x26, 0x4d, 0xe8, 0x6e,
+	0xa7, 0x01, 0x55, 0x12, 0x37, 0xf0, 0x57, 0xd5, 0x44, 0xa3, 0x58, 0xdc, 0xd4, 0x07, 0xfa, 0xb0,
+	0x3d, 0xda, 0x47, 0x5f, 0x3d, 0x03, 0xfa, 0x94, 0xe5, 0xf8, 0x7b, 0x6d, 0xdc, 0xfd, 0x59, 0xed,
+	0x82, 0x7c, 0x7c, 0xb6, 0x58, 0x5b, 0x60, 0xb9, 0xb6, 0xc0, 0xcb, 0xda, 0x02, 0xf3, 0x8d, 0xa5,
+	0x2d, 0x37, 0x96, 0xf6, 0xb4, 0xb1, 0xb4, 0xbb, 0x83, 0x98, 0x8a, 0xa4, 0x0a, 0x50, 0xc8, 0x32,
+	0xac, 0x24, 0x0e, 0x2f, 0xfc, 0x80, 0x63, 0xa5, 0x81, 0xef, 0x71, 0xf3, 0x2b, 0xc4, 0xac, 0x20,
+	0x3c, 0xf8, 0x21, 0xa3, 0x3e, 0x7a, 0x1b, 0x00, 0xc3, 0x7b, 0xdc, 0xe6, 0x2c, 0x02, 0x00, 0x00,
+}
+
+func (m *UpcomingEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpcomingEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpcomingEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedStartHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.EstimatedStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSchedule(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpcomingEpochs) > 0 {
+		for iNdEx := len(m.UpcomingEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpcomingEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpcomingEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovSchedule(uint64(m.EpochNumber))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovSchedule(uint64(l))
+	if m.EstimatedStartHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.EstimatedStartHeight))
+	}
+	return n
+}
+
+func (m *EpochSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovSchedule(uint64(m.CurrentEpoch))
+	}
+	if len(m.UpcomingEpochs) > 0 {
+		for _, e := range m.UpcomingEpochs {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpcomingEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpcomingEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpcomingEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedStartHeight", wireType)
+			}
+			m.EstimatedStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpcomingEpochs = append(m.UpcomingEpochs, UpcomingEpoch{})
+			if err := m.UpcomingEpochs[len(m.UpcomingEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
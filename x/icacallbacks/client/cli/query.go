@@ -27,6 +27,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListCallbackData())
 	cmd.AddCommand(CmdShowCallbackData())
+	cmd.AddCommand(CmdListFailedIcaTx())
+	cmd.AddCommand(CmdShowFailedIcaTx())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
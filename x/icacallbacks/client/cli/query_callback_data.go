@@ -9,6 +9,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagModule     = "module"
+	FlagCallbackId = "callback-id"
+	FlagChannel    = "channel"
+)
+
 func CmdListCallbackData() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-callback-data",
@@ -20,11 +26,26 @@ func CmdListCallbackData() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			module, err := cmd.Flags().GetString(FlagModule)
+			if err != nil {
+				return err
+			}
+			callbackId, err := cmd.Flags().GetString(FlagCallbackId)
+			if err != nil {
+				return err
+			}
+			channelId, err := cmd.Flags().GetString(FlagChannel)
+			if err != nil {
+				return err
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryAllCallbackDataRequest{
 				Pagination: pageReq,
+				Module:     module,
+				CallbackId: callbackId,
+				ChannelId:  channelId,
 			}
 
 			res, err := queryClient.CallbackDataAll(context.Background(), params)
@@ -36,6 +57,9 @@ func CmdListCallbackData() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagModule, "", "only list callback data handled by this module")
+	cmd.Flags().String(FlagCallbackId, "", "only list callback data with this callback id")
+	cmd.Flags().String(FlagChannel, "", "only list callback data of packets sent on this channel")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
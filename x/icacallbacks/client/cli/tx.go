@@ -20,6 +20,8 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdDropFailedIcaTx())
+	cmd.AddCommand(CmdRetryFailedIcaTx())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
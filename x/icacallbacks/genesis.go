@@ -14,6 +14,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.CallbackDataList {
 		k.SetCallbackData(ctx, elem)
 	}
+	// Set all the failedIcaTx
+	for _, elem := range genState.FailedIcaTxList {
+		k.SetFailedIcaTx(ctx, elem)
+	}
+	k.SetFailedIcaTxCount(ctx, genState.FailedIcaTxCount)
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -24,6 +29,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.CallbackDataList = k.GetAllCallbackData(ctx)
+	genesis.FailedIcaTxList = k.GetAllFailedIcaTx(ctx)
+	genesis.FailedIcaTxCount = k.GetFailedIcaTxCount(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -22,6 +22,16 @@ func TestGenesis(t *testing.T) {
 				CallbackKey: "1",
 			},
 		},
+		FailedIcaTxList: []types.FailedIcaTx{
+			{
+				Id:          0,
+				CallbackKey: "icacontroller-GAIA.DELEGATION.channel-0.1",
+			},
+			{
+				Id: 1,
+			},
+		},
+		FailedIcaTxCount: 2,
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -36,5 +46,7 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.PortId, got.PortId)
 
 	require.ElementsMatch(t, genesisState.CallbackDataList, got.CallbackDataList)
+	require.ElementsMatch(t, genesisState.FailedIcaTxList, got.FailedIcaTxList)
+	require.Equal(t, genesisState.FailedIcaTxCount, got.FailedIcaTxCount)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// DecodeCallbackArgs decodes the args of the callback data with the callback handler of the module that owns it.
+// Failures are reported in the result rather than returned, so that one undecodable entry doesn't fail a query
+func (k Keeper) DecodeCallbackArgs(ctx sdk.Context, callbackData types.CallbackData) types.DecodedCallbackArgs {
+	decoded := types.DecodedCallbackArgs{CallbackKey: callbackData.CallbackKey}
+
+	module, err := k.GetCallbackModule(ctx, callbackData)
+	if err != nil {
+		decoded.Error = err.Error()
+		return decoded
+	}
+	callbackHandler, err := k.GetICACallbackHandler(module)
+	if err != nil {
+		decoded.Error = err.Error()
+		return decoded
+	}
+	args, err := callbackHandler.DecodeCallbackArgs(callbackData.CallbackId, callbackData.CallbackArgs)
+	if err != nil {
+		decoded.Error = err.Error()
+		return decoded
+	}
+	json, err := codec.ProtoMarshalJSON(args, nil)
+	if err != nil {
+		decoded.Error = err.Error()
+		return decoded
+	}
+
+	decoded.Type = proto.MessageName(args)
+	decoded.Json = string(json)
+	return decoded
+}
@@ -6,13 +6,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// SetCallbackData set a specific callbackData in the store from its index
+// SetCallbackData set a specific callbackData in the store from its index, and indexes it by expiration time
 func (k Keeper) SetCallbackData(ctx sdk.Context, callbackData types.CallbackData) {
+	if previous, found := k.GetCallbackData(ctx, callbackData.CallbackKey); found {
+		k.removeCallbackDataExpiryIndex(ctx, previous)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackDataKeyPrefix))
 	b := k.cdc.MustMarshal(&callbackData)
 	store.Set(types.CallbackDataKey(
 		callbackData.CallbackKey,
 	), b)
+
+	if callbackData.ExpirationTimestamp != 0 {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackDataExpiryKeyPrefix))
+		expiryStore.Set(types.CallbackDataExpiryKey(callbackData.ExpirationTimestamp, callbackData.CallbackKey), []byte(callbackData.CallbackKey))
+	}
 }
 
 // GetCallbackData returns a callbackData from its index
@@ -40,12 +49,24 @@ func (k Keeper) RemoveCallbackData(
 	callbackKey string,
 
 ) {
+	if callbackData, found := k.GetCallbackData(ctx, callbackKey); found {
+		k.removeCallbackDataExpiryIndex(ctx, callbackData)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackDataKeyPrefix))
 	store.Delete(types.CallbackDataKey(
 		callbackKey,
 	))
 }
 
+func (k Keeper) removeCallbackDataExpiryIndex(ctx sdk.Context, callbackData types.CallbackData) {
+	if callbackData.ExpirationTimestamp == 0 {
+		return
+	}
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackDataExpiryKeyPrefix))
+	expiryStore.Delete(types.CallbackDataExpiryKey(callbackData.ExpirationTimestamp, callbackData.CallbackKey))
+}
+
 // GetAllCallbackData returns all callbackData
 func (k Keeper) GetAllCallbackData(ctx sdk.Context) (list []types.CallbackData) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackDataKeyPrefix))
@@ -61,3 +82,23 @@ func (k Keeper) GetAllCallbackData(ctx sdk.Context) (list []types.CallbackData)
 
 	return
 }
+
+// GetExpiredCallbackData returns up to limit callbackData whose expiration time is at or before the given time,
+// oldest first
+func (k Keeper) GetExpiredCallbackData(ctx sdk.Context, blockTime uint64, limit int) (list []types.CallbackData) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CallbackDataExpiryKeyPrefix))
+	// the index is ordered by expiration time, so everything before the next nanosecond has expired
+	iterator := expiryStore.Iterator(nil, sdk.Uint64ToBigEndian(blockTime+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+		callbackData, found := k.GetCallbackData(ctx, string(iterator.Value()))
+		if !found {
+			continue
+		}
+		list = append(list, callbackData)
+	}
+
+	return
+}
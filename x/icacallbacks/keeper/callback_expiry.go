@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/spf13/cast"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// the number of expired callback data removed per block, the rest are picked up in the following blocks
+const maxExpiredCallbackDataPerBlock = 100
+
+// GetCallbackExpiration returns the time (ns) at which the callback data of a packet sent now with the given
+// timeout timestamp expires. Packets with only a timeout height are measured from the current block time
+func (k Keeper) GetCallbackExpiration(ctx sdk.Context, timeoutTimestamp uint64) uint64 {
+	// the buffer is capped by validation, so it can't overflow once converted to nanoseconds
+	buffer := k.GetParams(ctx).CallbackExpiryBuffer * 1e9
+	if timeoutTimestamp == 0 {
+		timeoutTimestamp = cast.ToUint64(ctx.BlockTime().UnixNano())
+	}
+	if timeoutTimestamp > math.MaxUint64-buffer {
+		return math.MaxUint64
+	}
+	return timeoutTimestamp + buffer
+}
+
+// GetCallbackModule returns the module whose callback handler is called for the callback data: the module stored
+// in it, or if it wasn't set, the module bound to the packet's port
+func (k Keeper) GetCallbackModule(ctx sdk.Context, callbackData types.CallbackData) (string, error) {
+	if callbackData.Module != "" {
+		return callbackData.Module, nil
+	}
+	module, _, err := k.IBCKeeper.ChannelKeeper.LookupModuleByChannel(ctx, callbackData.PortId, callbackData.ChannelId)
+	if err != nil {
+		return "", err
+	}
+	return module, nil
+}
+
+// IsPacketSettled returns whether the packet of the callback data can no longer be acked or executed on the host: its
+// commitment is gone, or its channel is closed. Until then, the host may still have run the tx with the ack pending
+func (k Keeper) IsPacketSettled(ctx sdk.Context, callbackData types.CallbackData) bool {
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, callbackData.PortId, callbackData.ChannelId)
+	if !found || channel.State == channeltypes.CLOSED {
+		return true
+	}
+	commitment := k.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, callbackData.PortId, callbackData.ChannelId, callbackData.Sequence)
+	return len(commitment) == 0
+}
+
+// ExpireCallbackData removes the callback data that outlived its packet without an ack or timeout being received
+// (e.g. because the channel closed), giving the owning module a chance to clean up. Callback data whose packet could
+// still be acked is kept for another buffer, as releasing it would undo what the host did. It is called each EndBlock
+func (k Keeper) ExpireCallbackData(ctx sdk.Context) {
+	blockTime := cast.ToUint64(ctx.BlockTime().UnixNano())
+	for _, callbackData := range k.GetExpiredCallbackData(ctx, blockTime, maxExpiredCallbackDataPerBlock) {
+		if !k.IsPacketSettled(ctx, callbackData) {
+			k.Logger(ctx).Info(fmt.Sprintf("Callback data %s (%s) expired, but its packet is pending, keeping it", callbackData.CallbackKey, callbackData.CallbackId))
+			callbackData.ExpirationTimestamp = k.GetCallbackExpiration(ctx, 0)
+			k.SetCallbackData(ctx, callbackData)
+			continue
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("Callback data %s (%s) expired", callbackData.CallbackKey, callbackData.CallbackId))
+
+		module, err := k.GetCallbackModule(ctx, callbackData)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to find the module of expired callback data %s | %s", callbackData.CallbackKey, err.Error()))
+		} else {
+			k.callExpiryHandler(ctx, module, callbackData)
+		}
+
+		// a resubmitted tx whose packet was lost can no longer be tracked
+		if failedIcaTx, found := k.GetFailedIcaTxByCallbackKey(ctx, callbackData.CallbackKey); found {
+			k.RemoveFailedIcaTx(ctx, failedIcaTx.Id)
+		}
+		k.RemoveCallbackData(ctx, callbackData.CallbackKey)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCallbackDataExpired,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyCallbackKey, callbackData.CallbackKey),
+				sdk.NewAttribute(types.AttributeKeyCallbackId, callbackData.CallbackId),
+				sdk.NewAttribute(types.AttributeKeyCallbackModule, module),
+			),
+		)
+	}
+}
+
+// callExpiryHandler calls the module's expiry handler, if it has one, in a cached context so that a failed
+// handler doesn't leave partial state behind
+func (k Keeper) callExpiryHandler(ctx sdk.Context, module string, callbackData types.CallbackData) {
+	callbackHandler, err := k.GetICACallbackHandler(module)
+	if err != nil {
+		return
+	}
+	expiryHandler, ok := callbackHandler.(types.CallbackExpiryHandler)
+	if !ok {
+		return
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := expiryHandler.OnCallbackDataExpired(cacheCtx, callbackData); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Expiry handler of module %s failed for callback data %s | %s", module, callbackData.CallbackKey, err.Error()))
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// expiryHandler records the callback data it is notified of, and fails if err is set
+type expiryHandler struct {
+	expired *[]string
+	err     error
+}
+
+var _ types.CallbackExpiryHandler = expiryHandler{}
+
+func (h expiryHandler) AddICACallback(id string, fn interface{}) types.ICACallbackHandler { return h }
+func (h expiryHandler) RegisterICACallbacks() types.ICACallbackHandler                    { return h }
+func (h expiryHandler) HasICACallback(id string) bool                                     { return false }
+func (h expiryHandler) CallICACallback(ctx sdk.Context, id string, packet channeltypes.Packet, ackResult *types.AcknowledgementResult, args []byte) error {
+	return nil
+}
+func (h expiryHandler) ResubmitICATx(ctx sdk.Context, portId string, msgs []sdk.Msg, id string, args []byte) (uint64, error) {
+	return 0, nil
+}
+func (h expiryHandler) ReleaseICATx(ctx sdk.Context, id string, args []byte) error { return nil }
+func (h expiryHandler) DecodeCallbackArgs(id string, args []byte) (proto.Message, error) {
+	return nil, nil
+}
+
+func (h expiryHandler) OnCallbackDataExpired(ctx sdk.Context, callbackData types.CallbackData) error {
+	*h.expired = append(*h.expired, callbackData.CallbackKey)
+	return h.err
+}
+
+func TestGetCallbackExpiration(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	keeper.SetParams(ctx, types.NewParams(5, 1, 10, types.DefaultFailedTxRetentionEpochs))
+	blockTime := cast.ToUint64(ctx.BlockTime().UnixNano())
+
+	require.Equal(t, uint64(100+10e9), keeper.GetCallbackExpiration(ctx, 100), "expiration after the packet timeout")
+	require.Equal(t, blockTime+10e9, keeper.GetCallbackExpiration(ctx, 0), "expiration of a packet with only a timeout height")
+}
+
+func TestExpireCallbackData(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	now := cast.ToUint64(ctx.BlockTime().UnixNano())
+
+	var expired []string
+	require.NoError(t, keeper.SetICACallbackHandler("expiring", expiryHandler{expired: &expired}))
+	require.NoError(t, keeper.SetICACallbackHandler("failing", expiryHandler{expired: &expired, err: errors.New("release failed")}))
+
+	callbackData := []types.CallbackData{
+		{CallbackKey: "expired", Module: "expiring", ExpirationTimestamp: now - 1},
+		{CallbackKey: "expires-now", Module: "expiring", ExpirationTimestamp: now},
+		{CallbackKey: "handler-fails", Module: "failing", ExpirationTimestamp: now - 1},
+		{CallbackKey: "not-expired", Module: "expiring", ExpirationTimestamp: now + 1},
+		{CallbackKey: "never-expires", Module: "expiring"},
+	}
+	for _, data := range callbackData {
+		keeper.SetCallbackData(ctx, data)
+	}
+	// extending the expiration replaces the entry in the expiry index
+	keeper.SetCallbackData(ctx, types.CallbackData{CallbackKey: "extended", Module: "expiring", ExpirationTimestamp: now - 1})
+	keeper.SetCallbackData(ctx, types.CallbackData{CallbackKey: "extended", Module: "expiring", ExpirationTimestamp: now + 1})
+	// a resubmitted tx waiting on an expired packet is dropped with it
+	failedIcaTxId := keeper.AppendFailedIcaTx(ctx, types.FailedIcaTx{CallbackKey: "expired", Status: types.FailedIcaTx_IN_FLIGHT})
+
+	keeper.ExpireCallbackData(ctx)
+
+	require.ElementsMatch(t, []string{"expired", "expires-now", "handler-fails"}, expired, "expiry handler calls")
+	for _, callbackKey := range []string{"expired", "expires-now", "handler-fails"} {
+		_, found := keeper.GetCallbackData(ctx, callbackKey)
+		require.False(t, found, "%s removed", callbackKey)
+	}
+	for _, callbackKey := range []string{"not-expired", "never-expires", "extended"} {
+		_, found := keeper.GetCallbackData(ctx, callbackKey)
+		require.True(t, found, "%s kept", callbackKey)
+	}
+	_, found := keeper.GetFailedIcaTx(ctx, failedIcaTxId)
+	require.False(t, found, "failed tx removed")
+
+	numEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCallbackDataExpired {
+			numEvents++
+		}
+	}
+	require.Equal(t, 3, numEvents, "number of expiry events")
+
+	// the rest expire once the block time passes them
+	expired = []string{}
+	keeper.ExpireCallbackData(ctx.WithBlockTime(ctx.BlockTime().Add(time.Second)))
+	require.ElementsMatch(t, []string{"not-expired", "extended"}, expired, "expiry handler calls in a later block")
+	require.Len(t, keeper.GetAllCallbackData(ctx), 1, "only the callback data without an expiration is left")
+}
+
+func TestExpireCallbackDataPendingPacket(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	keeper.SetParams(ctx, types.NewParams(5, 1, 10, types.DefaultFailedTxRetentionEpochs))
+	now := cast.ToUint64(ctx.BlockTime().UnixNano())
+
+	var expired []string
+	require.NoError(t, keeper.SetICACallbackHandler("expiring", expiryHandler{expired: &expired}))
+
+	// the host may have received the packet, with its ack yet to be relayed
+	channel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty("icahost", "channel-1"), []string{"connection-0"}, "")
+	keeper.IBCKeeper.ChannelKeeper.SetChannel(ctx, "icacontroller-GAIA.DELEGATION", "channel-0", channel)
+	keeper.IBCKeeper.ChannelKeeper.SetPacketCommitment(ctx, "icacontroller-GAIA.DELEGATION", "channel-0", 1, []byte("commitment"))
+	pending := types.CallbackData{CallbackKey: "pending", PortId: "icacontroller-GAIA.DELEGATION", ChannelId: "channel-0",
+		Sequence: 1, Module: "expiring", ExpirationTimestamp: now - 1}
+	keeper.SetCallbackData(ctx, pending)
+
+	keeper.ExpireCallbackData(ctx)
+	require.Empty(t, expired, "pending packet not released")
+	callbackData, found := keeper.GetCallbackData(ctx, "pending")
+	require.True(t, found, "callback data kept")
+	require.Equal(t, now+10e9, callbackData.ExpirationTimestamp, "expiration extended")
+
+	// once the channel closes, the packet can't be executed anymore
+	channel.State = channeltypes.CLOSED
+	keeper.IBCKeeper.ChannelKeeper.SetChannel(ctx, "icacontroller-GAIA.DELEGATION", "channel-0", channel)
+	keeper.ExpireCallbackData(ctx.WithBlockTime(ctx.BlockTime().Add(10 * time.Second)))
+	require.Equal(t, []string{"pending"}, expired, "closed channel released")
+	_, found = keeper.GetCallbackData(ctx, "pending")
+	require.False(t, found, "callback data removed")
+}
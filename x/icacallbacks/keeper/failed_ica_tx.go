@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// GetFailedIcaTxCount get the total number of failedIcaTx
+func (k Keeper) GetFailedIcaTxCount(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.FailedIcaTxCountKey)
+	bz := store.Get(byteKey)
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetFailedIcaTxCount set the total number of failedIcaTx
+func (k Keeper) SetFailedIcaTxCount(ctx sdk.Context, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
+	byteKey := types.KeyPrefix(types.FailedIcaTxCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+// AppendFailedIcaTx appends a failedIcaTx in the store with a new id and update the count
+func (k Keeper) AppendFailedIcaTx(ctx sdk.Context, failedIcaTx types.FailedIcaTx) uint64 {
+	count := k.GetFailedIcaTxCount(ctx)
+
+	failedIcaTx.Id = count
+	k.SetFailedIcaTx(ctx, failedIcaTx)
+
+	k.SetFailedIcaTxCount(ctx, count+1)
+
+	return count
+}
+
+// SetFailedIcaTx set a specific failedIcaTx in the store and indexes it by the callback key of its latest packet
+func (k Keeper) SetFailedIcaTx(ctx sdk.Context, failedIcaTx types.FailedIcaTx) {
+	if previous, found := k.GetFailedIcaTx(ctx, failedIcaTx.Id); found && previous.CallbackKey != failedIcaTx.CallbackKey {
+		k.removeFailedIcaTxPacketIndex(ctx, previous.CallbackKey)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedIcaTxKey))
+	b := k.cdc.MustMarshal(&failedIcaTx)
+	store.Set(GetFailedIcaTxIDBytes(failedIcaTx.Id), b)
+
+	if failedIcaTx.CallbackKey != "" {
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedIcaTxPacketKey))
+		indexStore.Set([]byte(failedIcaTx.CallbackKey), GetFailedIcaTxIDBytes(failedIcaTx.Id))
+	}
+}
+
+// GetFailedIcaTx returns a failedIcaTx from its id
+func (k Keeper) GetFailedIcaTx(ctx sdk.Context, id uint64) (val types.FailedIcaTx, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedIcaTxKey))
+	b := store.Get(GetFailedIcaTxIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetFailedIcaTxByCallbackKey returns the failedIcaTx that was last sent in the packet with the given callback key
+func (k Keeper) GetFailedIcaTxByCallbackKey(ctx sdk.Context, callbackKey string) (val types.FailedIcaTx, found bool) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedIcaTxPacketKey))
+	bz := indexStore.Get([]byte(callbackKey))
+	if bz == nil {
+		return val, false
+	}
+	return k.GetFailedIcaTx(ctx, binary.BigEndian.Uint64(bz))
+}
+
+// RemoveFailedIcaTx removes a failedIcaTx from the store
+func (k Keeper) RemoveFailedIcaTx(ctx sdk.Context, id uint64) {
+	if failedIcaTx, found := k.GetFailedIcaTx(ctx, id); found {
+		k.removeFailedIcaTxPacketIndex(ctx, failedIcaTx.CallbackKey)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedIcaTxKey))
+	store.Delete(GetFailedIcaTxIDBytes(id))
+}
+
+func (k Keeper) removeFailedIcaTxPacketIndex(ctx sdk.Context, callbackKey string) {
+	if callbackKey == "" {
+		return
+	}
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedIcaTxPacketKey))
+	indexStore.Delete([]byte(callbackKey))
+}
+
+// GetAllFailedIcaTx returns all failedIcaTx
+func (k Keeper) GetAllFailedIcaTx(ctx sdk.Context) (list []types.FailedIcaTx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FailedIcaTxKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FailedIcaTx
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetFailedIcaTxIDBytes returns the byte representation of the ID
+func GetFailedIcaTxIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
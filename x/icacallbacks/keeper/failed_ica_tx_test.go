@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/testutil/nullify"
+	"github.com/Stride-Labs/stride/x/icacallbacks/keeper"
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+func createNFailedIcaTx(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.FailedIcaTx {
+	items := make([]types.FailedIcaTx, n)
+	for i := range items {
+		items[i].CallbackKey = fmt.Sprintf("icacontroller-GAIA.DELEGATION.channel-0.%d", i)
+		items[i].Id = keeper.AppendFailedIcaTx(ctx, items[i])
+	}
+	return items
+}
+
+func TestFailedIcaTxGet(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	items := createNFailedIcaTx(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetFailedIcaTx(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+
+		got, found = keeper.GetFailedIcaTxByCallbackKey(ctx, item.CallbackKey)
+		require.True(t, found)
+		require.Equal(t, item.Id, got.Id)
+	}
+}
+
+func TestFailedIcaTxRemove(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	items := createNFailedIcaTx(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveFailedIcaTx(ctx, item.Id)
+		_, found := keeper.GetFailedIcaTx(ctx, item.Id)
+		require.False(t, found)
+		_, found = keeper.GetFailedIcaTxByCallbackKey(ctx, item.CallbackKey)
+		require.False(t, found)
+	}
+}
+
+func TestFailedIcaTxGetAll(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	items := createNFailedIcaTx(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllFailedIcaTx(ctx)),
+	)
+}
+
+func TestFailedIcaTxCount(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	items := createNFailedIcaTx(keeper, ctx, 10)
+	count := uint64(len(items))
+	require.Equal(t, count, keeper.GetFailedIcaTxCount(ctx))
+}
+
+func TestFailedIcaTxReindexedOnResubmission(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	item := createNFailedIcaTx(keeper, ctx, 1)[0]
+
+	oldKey := item.CallbackKey
+	item.CallbackKey = "icacontroller-GAIA.DELEGATION.channel-0.100"
+	keeper.SetFailedIcaTx(ctx, item)
+
+	_, found := keeper.GetFailedIcaTxByCallbackKey(ctx, oldKey)
+	require.False(t, found, "old packet no longer indexed")
+	got, found := keeper.GetFailedIcaTxByCallbackKey(ctx, item.CallbackKey)
+	require.True(t, found, "new packet indexed")
+	require.Equal(t, item.Id, got.Id)
+}
+
+func TestScheduleRetry(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	keeper.SetParams(ctx, types.NewParams(3, 2, types.DefaultCallbackExpiryBuffer, types.DefaultFailedTxRetentionEpochs))
+
+	for _, tc := range []struct {
+		desc           string
+		attempts       uint64
+		retryable      bool
+		status         types.FailedIcaTx_Status
+		nextRetryEpoch uint64
+		failedEpoch    uint64
+	}{
+		{desc: "not retryable", attempts: 0, retryable: false, status: types.FailedIcaTx_FAILED, failedEpoch: 10},
+		{desc: "first failure", attempts: 0, retryable: true, status: types.FailedIcaTx_RETRYABLE, nextRetryEpoch: 12},
+		{desc: "backoff doubles", attempts: 1, retryable: true, status: types.FailedIcaTx_RETRYABLE, nextRetryEpoch: 14},
+		{desc: "backoff doubles again", attempts: 2, retryable: true, status: types.FailedIcaTx_RETRYABLE, nextRetryEpoch: 18},
+		{desc: "exhausted", attempts: 3, retryable: true, status: types.FailedIcaTx_EXHAUSTED, failedEpoch: 10},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			failedIcaTx := types.FailedIcaTx{Attempts: tc.attempts}
+			keeper.ScheduleRetry(ctx, &failedIcaTx, tc.retryable, 10)
+			require.Equal(t, tc.status, failedIcaTx.Status, "status")
+			require.Equal(t, tc.nextRetryEpoch, failedIcaTx.NextRetryEpoch, "next retry epoch")
+			require.Equal(t, tc.failedEpoch, failedIcaTx.FailedEpoch, "failed epoch")
+		})
+	}
+}
+
+func TestRetryFailedIcaTxsWithoutHandler(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	keeper.SetParams(ctx, types.NewParams(2, 1, types.DefaultCallbackExpiryBuffer, types.DefaultFailedTxRetentionEpochs))
+
+	due := types.FailedIcaTx{Module: "unregistered", Status: types.FailedIcaTx_RETRYABLE, NextRetryEpoch: 5}
+	due.Id = keeper.AppendFailedIcaTx(ctx, due)
+	notDue := types.FailedIcaTx{Module: "unregistered", Status: types.FailedIcaTx_RETRYABLE, NextRetryEpoch: 6}
+	notDue.Id = keeper.AppendFailedIcaTx(ctx, notDue)
+
+	// the resubmission fails, which counts as an attempt and pushes back the next retry
+	keeper.RetryFailedIcaTxs(ctx, 5)
+	got, found := keeper.GetFailedIcaTx(ctx, due.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(1), got.Attempts, "attempts")
+	require.Equal(t, types.FailedIcaTx_RETRYABLE, got.Status, "status")
+	require.Equal(t, uint64(7), got.NextRetryEpoch, "next retry epoch")
+	require.NotEmpty(t, got.Error, "error recorded")
+
+	got, found = keeper.GetFailedIcaTx(ctx, notDue.Id)
+	require.True(t, found)
+	require.Equal(t, uint64(0), got.Attempts, "tx that is not due is untouched")
+
+	// once the attempts run out the tx is no longer retried
+	keeper.RetryFailedIcaTxs(ctx, 7)
+	got, _ = keeper.GetFailedIcaTx(ctx, due.Id)
+	require.Equal(t, uint64(2), got.Attempts, "attempts")
+	require.Equal(t, types.FailedIcaTx_EXHAUSTED, got.Status, "status")
+}
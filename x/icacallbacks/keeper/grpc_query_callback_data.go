@@ -22,21 +22,31 @@ func (k Keeper) CallbackDataAll(c context.Context, req *types.QueryAllCallbackDa
 	store := ctx.KVStore(k.storeKey)
 	callbackDataStore := prefix.NewStore(store, types.KeyPrefix(types.CallbackDataKeyPrefix))
 
-	pageRes, err := query.Paginate(callbackDataStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(callbackDataStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var callbackData types.CallbackData
 		if err := k.cdc.Unmarshal(value, &callbackData); err != nil {
-			return err
+			return false, err
+		}
+		if !k.callbackDataMatches(ctx, callbackData, req) {
+			return false, nil
 		}
 
-		callbackDatas = append(callbackDatas, callbackData)
-		return nil
+		if accumulate {
+			callbackDatas = append(callbackDatas, callbackData)
+		}
+		return true, nil
 	})
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAllCallbackDataResponse{CallbackData: callbackDatas, Pagination: pageRes}, nil
+	decodedCallbackArgs := make([]types.DecodedCallbackArgs, len(callbackDatas))
+	for i, callbackData := range callbackDatas {
+		decodedCallbackArgs[i] = k.DecodeCallbackArgs(ctx, callbackData)
+	}
+
+	return &types.QueryAllCallbackDataResponse{CallbackData: callbackDatas, Pagination: pageRes, DecodedCallbackArgs: decodedCallbackArgs}, nil
 }
 
 func (k Keeper) CallbackData(c context.Context, req *types.QueryGetCallbackDataRequest) (*types.QueryGetCallbackDataResponse, error) {
@@ -53,5 +63,22 @@ func (k Keeper) CallbackData(c context.Context, req *types.QueryGetCallbackDataR
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryGetCallbackDataResponse{CallbackData: val}, nil
+	return &types.QueryGetCallbackDataResponse{CallbackData: val, DecodedCallbackArgs: k.DecodeCallbackArgs(ctx, val)}, nil
+}
+
+// callbackDataMatches returns true if the callback data matches each filter set in the request
+func (k Keeper) callbackDataMatches(ctx sdk.Context, callbackData types.CallbackData, req *types.QueryAllCallbackDataRequest) bool {
+	if req.CallbackId != "" && callbackData.CallbackId != req.CallbackId {
+		return false
+	}
+	if req.ChannelId != "" && callbackData.ChannelId != req.ChannelId {
+		return false
+	}
+	if req.Module != "" {
+		module, err := k.GetCallbackModule(ctx, callbackData)
+		if err != nil || module != req.Module {
+			return false
+		}
+	}
+	return true
 }
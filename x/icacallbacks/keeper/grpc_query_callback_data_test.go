@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,6 +14,8 @@ import (
 	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
 	"github.com/Stride-Labs/stride/testutil/nullify"
 	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
 )
 
 // Prevent strconv unused error
@@ -61,9 +64,10 @@ func TestCallbackDataQuerySingle(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t,
-					nullify.Fill(tc.response),
-					nullify.Fill(response),
+					nullify.Fill(tc.response.CallbackData),
+					nullify.Fill(response.CallbackData),
 				)
+				require.Equal(t, tc.response.CallbackData.CallbackKey, response.DecodedCallbackArgs.CallbackKey)
 			}
 		})
 	}
@@ -124,3 +128,92 @@ func TestCallbackDataQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestCallbackDataQueryFiltered(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := []types.CallbackData{
+		{CallbackKey: "0", Module: "stakeibc", CallbackId: "delegate", ChannelId: "channel-0"},
+		{CallbackKey: "1", Module: "stakeibc", CallbackId: "undelegate", ChannelId: "channel-1"},
+		{CallbackKey: "2", Module: "records", CallbackId: "transfer", ChannelId: "channel-0"},
+	}
+	for _, msg := range msgs {
+		keeper.SetCallbackData(ctx, msg)
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryAllCallbackDataRequest
+		expected []types.CallbackData
+	}{
+		{
+			desc:     "Module",
+			request:  &types.QueryAllCallbackDataRequest{Module: "stakeibc"},
+			expected: msgs[:2],
+		},
+		{
+			desc:     "CallbackId",
+			request:  &types.QueryAllCallbackDataRequest{CallbackId: "transfer"},
+			expected: msgs[2:],
+		},
+		{
+			desc:     "Channel",
+			request:  &types.QueryAllCallbackDataRequest{ChannelId: "channel-0"},
+			expected: []types.CallbackData{msgs[0], msgs[2]},
+		},
+		{
+			desc:     "AllFilters",
+			request:  &types.QueryAllCallbackDataRequest{Module: "stakeibc", CallbackId: "delegate", ChannelId: "channel-0"},
+			expected: msgs[:1],
+		},
+		{
+			desc:     "NoMatch",
+			request:  &types.QueryAllCallbackDataRequest{Module: "records", ChannelId: "channel-1"},
+			expected: nil,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tc.request.Pagination = &query.PageRequest{CountTotal: true}
+			resp, err := keeper.CallbackDataAll(wctx, tc.request)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expected, resp.CallbackData)
+			require.Equal(t, len(tc.expected), int(resp.Pagination.Total), "total")
+		})
+	}
+}
+
+func TestCallbackDataQueryDecodedArgs(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	args, err := proto.Marshal(&stakeibctypes.DelegateCallback{HostZoneId: "GAIA", DepositRecordId: 7})
+	require.NoError(t, err)
+	msgs := []types.CallbackData{
+		{CallbackKey: "0", Module: stakeibctypes.ModuleName, CallbackId: stakeibckeeper.DELEGATE, CallbackArgs: args},
+		{CallbackKey: "1", Module: stakeibctypes.ModuleName, CallbackId: "unknown", CallbackArgs: args},
+		{CallbackKey: "2", Module: "unknown", CallbackId: stakeibckeeper.DELEGATE, CallbackArgs: args},
+	}
+	for _, msg := range msgs {
+		keeper.SetCallbackData(ctx, msg)
+	}
+
+	response, err := keeper.CallbackData(wctx, &types.QueryGetCallbackDataRequest{CallbackKey: "0"})
+	require.NoError(t, err)
+	require.Equal(t, types.DecodedCallbackArgs{
+		CallbackKey: "0",
+		Type:        "Stridelabs.stride.stakeibc.DelegateCallback",
+		Json:        `{"hostZoneId":"GAIA","depositRecordId":"7","splitDelegations":[]}`,
+	}, response.DecodedCallbackArgs)
+
+	// args that can't be decoded are reported without failing the query
+	responseAll, err := keeper.CallbackDataAll(wctx, &types.QueryAllCallbackDataRequest{})
+	require.NoError(t, err)
+	require.Len(t, responseAll.DecodedCallbackArgs, 3)
+	for i, decoded := range responseAll.DecodedCallbackArgs {
+		require.Equal(t, responseAll.CallbackData[i].CallbackKey, decoded.CallbackKey, "decoded args in the same order")
+	}
+	require.Empty(t, responseAll.DecodedCallbackArgs[0].Error)
+	require.Contains(t, responseAll.DecodedCallbackArgs[1].Error, "stakeibc has no callback unknown")
+	require.Contains(t, responseAll.DecodedCallbackArgs[2].Error, "no callback handler found for unknown")
+	require.Empty(t, responseAll.DecodedCallbackArgs[2].Json)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+func (k Keeper) FailedIcaTxAll(c context.Context, req *types.QueryAllFailedIcaTxRequest) (*types.QueryAllFailedIcaTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var failedIcaTxs []types.FailedIcaTx
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	failedIcaTxStore := prefix.NewStore(store, types.KeyPrefix(types.FailedIcaTxKey))
+
+	pageRes, err := query.Paginate(failedIcaTxStore, req.Pagination, func(key []byte, value []byte) error {
+		var failedIcaTx types.FailedIcaTx
+		if err := k.cdc.Unmarshal(value, &failedIcaTx); err != nil {
+			return err
+		}
+
+		failedIcaTxs = append(failedIcaTxs, failedIcaTx)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFailedIcaTxResponse{FailedIcaTx: failedIcaTxs, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FailedIcaTx(c context.Context, req *types.QueryGetFailedIcaTxRequest) (*types.QueryGetFailedIcaTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	failedIcaTx, found := k.GetFailedIcaTx(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetFailedIcaTxResponse{FailedIcaTx: failedIcaTx}, nil
+}
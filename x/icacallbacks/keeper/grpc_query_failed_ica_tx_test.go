@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/testutil/nullify"
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestFailedIcaTxQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNFailedIcaTx(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetFailedIcaTxRequest
+		response *types.QueryGetFailedIcaTxResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetFailedIcaTxRequest{
+				Id: msgs[0].Id,
+			},
+			response: &types.QueryGetFailedIcaTxResponse{FailedIcaTx: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetFailedIcaTxRequest{
+				Id: msgs[1].Id,
+			},
+			response: &types.QueryGetFailedIcaTxResponse{FailedIcaTx: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetFailedIcaTxRequest{
+				Id: uint64(len(msgs)),
+			},
+			err: sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.FailedIcaTx(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestFailedIcaTxQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.IcacallbacksKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNFailedIcaTx(keeper, ctx, 5)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllFailedIcaTxRequest {
+		return &types.QueryAllFailedIcaTxRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.FailedIcaTxAll(wctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FailedIcaTx), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FailedIcaTx),
+			)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := keeper.FailedIcaTxAll(wctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.FailedIcaTx), step)
+			require.Subset(t,
+				nullify.Fill(msgs),
+				nullify.Fill(resp.FailedIcaTx),
+			)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.FailedIcaTxAll(wctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.ElementsMatch(t,
+			nullify.Fill(msgs),
+			nullify.Fill(resp.FailedIcaTx),
+		)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.FailedIcaTxAll(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+)
+
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	if epochInfo.Identifier != epochstypes.STRIDE_EPOCH {
+		return nil
+	}
+	epochNumber, err := cast.ToUint64E(epochInfo.CurrentEpoch)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not convert epoch number to uint64")
+	}
+	k.RetryFailedIcaTxs(ctx, epochNumber)
+	k.PruneFailedIcaTxs(ctx, epochNumber)
+	return nil
+}
+
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	return nil
+}
+
+// Hooks wrapper struct for icacallbacks keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// epochs hooks
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	return h.k.BeforeEpochStart(ctx, epochInfo)
+}
+
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	return h.k.AfterEpochEnd(ctx, epochInfo)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// RegisterInvariants registers all icacallbacks invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "callback-data-key", CallbackDataKeyInvariant(k))
+}
+
+// CallbackDataKeyInvariant checks that each callback data is stored under the packet it was registered for,
+// otherwise the ack or timeout of that packet would never find it
+func CallbackDataKeyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, callbackData := range k.GetAllCallbackData(ctx) {
+			packetId := types.PacketID(callbackData.PortId, callbackData.ChannelId, callbackData.Sequence)
+			if callbackData.CallbackKey != packetId {
+				broken = true
+				msg += fmt.Sprintf("\tcallback data %s belongs to packet %s\n", callbackData.CallbackKey, packetId)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "callback-data-key",
+			fmt.Sprintf("found callback data stored under the wrong key\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/x/icacallbacks/keeper"
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+func TestCallbackDataKeyInvariant(t *testing.T) {
+	k, ctx := keepertest.IcacallbacksKeeper(t)
+	k.SetCallbackData(ctx, types.CallbackData{
+		CallbackKey: types.PacketID("icacontroller-GAIA.DELEGATION", "channel-1", 4),
+		PortId:      "icacontroller-GAIA.DELEGATION",
+		ChannelId:   "channel-1",
+		Sequence:    4,
+	})
+
+	msg, broken := keeper.CallbackDataKeyInvariant(*k)(ctx)
+	require.False(t, broken, msg)
+
+	k.SetCallbackData(ctx, types.CallbackData{
+		CallbackKey: types.PacketID("icacontroller-GAIA.DELEGATION", "channel-1", 5),
+		PortId:      "icacontroller-GAIA.DELEGATION",
+		ChannelId:   "channel-1",
+		Sequence:    6,
+	})
+	_, broken = keeper.CallbackDataKeyInvariant(*k)(ctx)
+	require.True(t, broken)
+}
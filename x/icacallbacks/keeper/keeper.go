@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -19,6 +21,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 )
 
 type (
@@ -31,6 +34,7 @@ type (
 		icacallbacks        map[string]types.ICACallbackHandler
 		IBCKeeper           ibckeeper.Keeper
 		ICAControllerKeeper icacontrollerkeeper.Keeper
+		epochsKeeper        types.EpochsKeeper
 	}
 )
 
@@ -42,6 +46,7 @@ func NewKeeper(
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 	ibcKeeper ibckeeper.Keeper,
 	icacontrollerkeeper icacontrollerkeeper.Keeper,
+	epochsKeeper types.EpochsKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -57,6 +62,7 @@ func NewKeeper(
 		icacallbacks:        make(map[string]types.ICACallbackHandler),
 		IBCKeeper:           ibcKeeper,
 		ICAControllerKeeper: icacontrollerkeeper,
+		epochsKeeper:        epochsKeeper,
 	}
 }
 
@@ -87,14 +93,9 @@ func (k *Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capabilit
 	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
 }
 
-func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, modulePacket channeltypes.Packet, txMsgData *sdk.TxMsgData) error {
-	// get the relevant module from the channel and port
+func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, modulePacket channeltypes.Packet, ackResult *types.AcknowledgementResult) error {
 	portID := modulePacket.GetSourcePort()
 	channelID := modulePacket.GetSourceChannel()
-	module, _, err := k.IBCKeeper.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
-	if err != nil {
-		return err
-	}
 	// fetch the callback data
 	callbackDataKey := types.PacketID(portID, channelID, modulePacket.Sequence)
 	callbackData, found := k.GetCallbackData(ctx, callbackDataKey)
@@ -106,6 +107,12 @@ func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, modulePacket channelt
 		k.Logger(ctx).Info(fmt.Sprintf("callback data found for portID: %s, channelID: %s, sequence: %d", portID, channelID, modulePacket.Sequence))
 	}
 
+	// get the relevant module from the callback data, or if it wasn't set, from the channel and port
+	module, err := k.GetCallbackModule(ctx, callbackData)
+	if err != nil {
+		return err
+	}
+
 	// fetch the callback function
 	callbackHandler, err := k.GetICACallbackHandler(module)
 	if err != nil {
@@ -116,13 +123,29 @@ func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, modulePacket channelt
 
 	// call the callback
 	if callbackHandler.HasICACallback(callbackData.CallbackId) {
-		// if acknowledgement is empty, then it is a timeout
-		err := callbackHandler.CallICACallback(ctx, callbackData.CallbackId, modulePacket, txMsgData, callbackData.CallbackArgs)
-		if err != nil {
+		err := callbackHandler.CallICACallback(ctx, callbackData.CallbackId, modulePacket, ackResult, callbackData.CallbackArgs)
+		retryable := errors.Is(err, types.ErrRetryableTx)
+		if err != nil && !retryable {
 			errMsg := fmt.Sprintf("Error occured while calling ICACallback (%s) | err: %s", callbackData.CallbackId, err.Error())
 			k.Logger(ctx).Error(errMsg)
 			return sdkerrors.Wrapf(types.ErrCallbackFailed, errMsg)
 		}
+
+		isIcaPacket := strings.HasPrefix(portID, icatypes.PortPrefix)
+		if ackResult.Status != types.AckResponseStatus_SUCCESS && isIcaPacket {
+			// failed txs are kept so that they can be resubmitted, the callback decides whether the retrier picks them up
+			failureMsg := ackResult.Error
+			if ackResult.Status == types.AckResponseStatus_TIMEOUT {
+				failureMsg = "packet timed out"
+			}
+			if err := k.RecordFailedIcaTx(ctx, module, callbackData, modulePacket, failureMsg, retryable); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to record failed ICA tx %s | err: %s", callbackDataKey, err.Error()))
+			}
+		} else if failedIcaTx, found := k.GetFailedIcaTxByCallbackKey(ctx, callbackDataKey); found {
+			// a resubmission from the retry queue succeeded
+			k.Logger(ctx).Info(fmt.Sprintf("Failed ICA tx %d (%s) succeeded after %d attempts", failedIcaTx.Id, failedIcaTx.CallbackId, failedIcaTx.Attempts))
+			k.RemoveFailedIcaTx(ctx, failedIcaTx.Id)
+		}
 	} else {
 		k.Logger(ctx).Error(fmt.Sprintf("Callback %v has no associated callback", callbackData))
 	}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/Stride-Labs/stride/x/icacallbacks/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the icacallbacks params and the expiration of the callback data stored before consensus version 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateParams(ctx, m.keeper.paramstore); err != nil {
+		return err
+	}
+	v2.MigrateCallbackDataExpiration(ctx, m.keeper)
+	return nil
+}
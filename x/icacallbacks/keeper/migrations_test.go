@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	strideapp "github.com/Stride-Labs/stride/app"
+	"github.com/Stride-Labs/stride/x/icacallbacks/keeper"
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := strideapp.InitTestApp(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "stride-1", Time: time.Now().UTC()})
+	k := app.IcacallbacksKeeper
+
+	// remove the params, as they were before the migration
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	for _, key := range [][]byte{types.KeyMaxRetryAttempts, types.KeyRetryBackoffEpochs, types.KeyCallbackExpiryBuffer, types.KeyFailedTxRetentionEpochs} {
+		paramsStore.Delete(append([]byte(types.ModuleName+"/"), key...))
+	}
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	// callback data stored before the migration has no expiration
+	k.SetCallbackData(ctx, types.CallbackData{CallbackKey: "legacy"})
+	k.SetCallbackData(ctx, types.CallbackData{CallbackKey: "expiring", ExpirationTimestamp: 1})
+
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	expectedExpiration := cast.ToUint64(ctx.BlockTime().UnixNano()) + types.DefaultCallbackExpiryBuffer*1e9
+	legacy, found := k.GetCallbackData(ctx, "legacy")
+	require.True(t, found)
+	require.Equal(t, expectedExpiration, legacy.ExpirationTimestamp)
+	expiring, found := k.GetCallbackData(ctx, "expiring")
+	require.True(t, found)
+	require.Equal(t, uint64(1), expiring.ExpirationTimestamp)
+
+	// the legacy callback data is now indexed, so it's swept once it expires
+	expired := k.GetExpiredCallbackData(ctx, expectedExpiration, 10)
+	require.Len(t, expired, 2)
+	require.Equal(t, "legacy", expired[1].CallbackKey)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// DropFailedIcaTx removes a tx from the retry queue. The owning module is asked to release the work so that its
+// regular flows can pick it up again
+func (k msgServer) DropFailedIcaTx(goCtx context.Context, msg *types.MsgDropFailedIcaTx) (*types.MsgDropFailedIcaTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	failedIcaTx, found := k.GetFailedIcaTx(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrFailedIcaTxNotFound, "id %d", msg.Id)
+	}
+	if k.IsFailedIcaTxInFlight(ctx, failedIcaTx) {
+		return nil, sdkerrors.Wrapf(types.ErrFailedIcaTxInFlight, "packet %s", failedIcaTx.CallbackKey)
+	}
+
+	callbackHandler, err := k.GetICACallbackHandler(failedIcaTx.Module)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrCallbackHandlerNotFound, err.Error())
+	}
+	if err := callbackHandler.ReleaseICATx(ctx, failedIcaTx.CallbackId, failedIcaTx.CallbackArgs); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to release failed ICA tx %d (%s) | %s", failedIcaTx.Id, failedIcaTx.CallbackId, err.Error()))
+		return nil, err
+	}
+	k.RemoveFailedIcaTx(ctx, failedIcaTx.Id)
+	k.Logger(ctx).Info(fmt.Sprintf("Dropped failed ICA tx %d (%s)", failedIcaTx.Id, failedIcaTx.CallbackId))
+
+	return &types.MsgDropFailedIcaTxResponse{}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// RetryFailedIcaTx resubmits a tx from the retry queue right away, including txs that were not marked retryable or
+// that have used up their attempts
+func (k msgServer) RetryFailedIcaTx(goCtx context.Context, msg *types.MsgRetryFailedIcaTx) (*types.MsgRetryFailedIcaTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	failedIcaTx, found := k.GetFailedIcaTx(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrFailedIcaTxNotFound, "id %d", msg.Id)
+	}
+	if k.IsFailedIcaTxInFlight(ctx, failedIcaTx) {
+		return nil, sdkerrors.Wrapf(types.ErrFailedIcaTxInFlight, "packet %s", failedIcaTx.CallbackKey)
+	}
+
+	if err := k.ResubmitFailedIcaTx(ctx, failedIcaTx); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to resubmit failed ICA tx %d (%s) | %s", failedIcaTx.Id, failedIcaTx.CallbackId, err.Error()))
+		return nil, err
+	}
+
+	return &types.MsgRetryFailedIcaTxResponse{}, nil
+}
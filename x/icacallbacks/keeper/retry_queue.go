@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/spf13/cast"
+
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// the backoff stops doubling after this many attempts
+const maxBackoffShift = 16
+
+// RecordFailedIcaTx stores a tx that timed out or errored on the host. If the packet was itself a resubmission from the
+// retry queue, the existing entry is updated instead of creating a new one.
+func (k Keeper) RecordFailedIcaTx(
+	ctx sdk.Context,
+	module string,
+	callbackData types.CallbackData,
+	packet channeltypes.Packet,
+	errMsg string,
+	retryable bool,
+) error {
+	failedIcaTx, found := k.GetFailedIcaTxByCallbackKey(ctx, callbackData.CallbackKey)
+	if !found {
+		msgs, err := k.GetPacketMsgs(packet)
+		if err != nil {
+			return err
+		}
+		connectionId := ""
+		if channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, packet.SourcePort, packet.SourceChannel); found && len(channel.ConnectionHops) > 0 {
+			connectionId = channel.ConnectionHops[0]
+		}
+		failedIcaTx = types.FailedIcaTx{
+			Module:       module,
+			CallbackId:   callbackData.CallbackId,
+			CallbackArgs: callbackData.CallbackArgs,
+			ConnectionId: connectionId,
+			PortId:       callbackData.PortId,
+			Msgs:         msgs,
+			CallbackKey:  callbackData.CallbackKey,
+		}
+	}
+	failedIcaTx.Error = errMsg
+	k.ScheduleRetry(ctx, &failedIcaTx, retryable, k.GetCurrentStrideEpoch(ctx))
+
+	if found {
+		k.SetFailedIcaTx(ctx, failedIcaTx)
+	} else {
+		failedIcaTx.Id = k.AppendFailedIcaTx(ctx, failedIcaTx)
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("Recorded failed ICA tx %d (%s), status: %s, attempts: %d",
+		failedIcaTx.Id, failedIcaTx.CallbackId, failedIcaTx.Status.String(), failedIcaTx.Attempts))
+	return nil
+}
+
+// ScheduleRetry sets the status of a failed tx, and if it can still be retried, the epoch of its next attempt
+// with an exponential backoff on the number of attempts so far
+func (k Keeper) ScheduleRetry(ctx sdk.Context, failedIcaTx *types.FailedIcaTx, retryable bool, epochNumber uint64) {
+	params := k.GetParams(ctx)
+	switch {
+	case !retryable:
+		failedIcaTx.Status = types.FailedIcaTx_FAILED
+		failedIcaTx.FailedEpoch = epochNumber
+	case failedIcaTx.Attempts >= params.MaxRetryAttempts:
+		failedIcaTx.Status = types.FailedIcaTx_EXHAUSTED
+		failedIcaTx.FailedEpoch = epochNumber
+	default:
+		shift := failedIcaTx.Attempts
+		if shift > maxBackoffShift {
+			shift = maxBackoffShift
+		}
+		failedIcaTx.Status = types.FailedIcaTx_RETRYABLE
+		failedIcaTx.NextRetryEpoch = epochNumber + params.RetryBackoffEpochs<<shift
+	}
+}
+
+// RetryFailedIcaTxs resubmits each retryable tx whose backoff has elapsed. It is called at the start of each stride epoch.
+// Resubmissions held off because the ICA's channel isn't open don't count as attempts, they're tried again next epoch
+func (k Keeper) RetryFailedIcaTxs(ctx sdk.Context, epochNumber uint64) {
+	for _, failedIcaTx := range k.GetAllFailedIcaTx(ctx) {
+		if failedIcaTx.Status != types.FailedIcaTx_RETRYABLE || failedIcaTx.NextRetryEpoch > epochNumber {
+			continue
+		}
+		err := k.ResubmitFailedIcaTx(ctx, failedIcaTx)
+		if errors.Is(err, types.ErrIcaChannelUnavailable) {
+			k.Logger(ctx).Info(fmt.Sprintf("Holding off failed ICA tx %d (%s) | %s", failedIcaTx.Id, failedIcaTx.CallbackId, err.Error()))
+			continue
+		}
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to resubmit failed ICA tx %d (%s) | %s", failedIcaTx.Id, failedIcaTx.CallbackId, err.Error()))
+			failedIcaTx.Attempts++
+			failedIcaTx.Error = err.Error()
+			k.ScheduleRetry(ctx, &failedIcaTx, true, epochNumber)
+			k.SetFailedIcaTx(ctx, failedIcaTx)
+		}
+	}
+}
+
+// PruneFailedIcaTxs removes the failed and exhausted txs that were kept past the retention without an admin forcing or
+// dropping them. As with a drop, the owning module is asked to release the work. It is called at the start of each
+// stride epoch
+func (k Keeper) PruneFailedIcaTxs(ctx sdk.Context, epochNumber uint64) {
+	retentionEpochs := k.GetParams(ctx).FailedTxRetentionEpochs
+	for _, failedIcaTx := range k.GetAllFailedIcaTx(ctx) {
+		if failedIcaTx.Status != types.FailedIcaTx_FAILED && failedIcaTx.Status != types.FailedIcaTx_EXHAUSTED {
+			continue
+		}
+		if failedIcaTx.FailedEpoch+retentionEpochs > epochNumber {
+			continue
+		}
+		callbackHandler, err := k.GetICACallbackHandler(failedIcaTx.Module)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to prune failed ICA tx %d (%s) | %s", failedIcaTx.Id, failedIcaTx.CallbackId, err.Error()))
+			continue
+		}
+		// release in a cached context so that a failed release doesn't leave partial state behind
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := callbackHandler.ReleaseICATx(cacheCtx, failedIcaTx.CallbackId, failedIcaTx.CallbackArgs); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to release pruned ICA tx %d (%s) | %s", failedIcaTx.Id, failedIcaTx.CallbackId, err.Error()))
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		k.RemoveFailedIcaTx(ctx, failedIcaTx.Id)
+		k.Logger(ctx).Info(fmt.Sprintf("Pruned failed ICA tx %d (%s), status: %s", failedIcaTx.Id, failedIcaTx.CallbackId, failedIcaTx.Status.String()))
+	}
+}
+
+// ResubmitFailedIcaTx sends a failed tx again through the module that owns its ICA, and tracks the new packet
+func (k Keeper) ResubmitFailedIcaTx(ctx sdk.Context, failedIcaTx types.FailedIcaTx) error {
+	callbackHandler, err := k.GetICACallbackHandler(failedIcaTx.Module)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrCallbackHandlerNotFound, err.Error())
+	}
+	if !k.IsIcaChannelOpen(ctx, failedIcaTx.ConnectionId, failedIcaTx.PortId) {
+		return sdkerrors.Wrapf(types.ErrIcaChannelUnavailable, "port %s", failedIcaTx.PortId)
+	}
+	msgs := make([]sdk.Msg, len(failedIcaTx.Msgs))
+	for i, msgAny := range failedIcaTx.Msgs {
+		if err := k.cdc.UnpackAny(msgAny, &msgs[i]); err != nil {
+			return err
+		}
+	}
+
+	// submit in a cached context so that a failed send doesn't leave partial state behind
+	cacheCtx, writeCache := ctx.CacheContext()
+	sequence, err := callbackHandler.ResubmitICATx(cacheCtx, failedIcaTx.PortId, msgs, failedIcaTx.CallbackId, failedIcaTx.CallbackArgs)
+	if err != nil {
+		return err
+	}
+	channelId, found := k.ICAControllerKeeper.GetActiveChannelID(cacheCtx, failedIcaTx.ConnectionId, failedIcaTx.PortId)
+	if !found {
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", failedIcaTx.PortId)
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	failedIcaTx.Attempts++
+	failedIcaTx.Status = types.FailedIcaTx_IN_FLIGHT
+	failedIcaTx.CallbackKey = types.PacketID(failedIcaTx.PortId, channelId, sequence)
+	k.SetFailedIcaTx(ctx, failedIcaTx)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Resubmitted failed ICA tx %d (%s), attempt %d, packet %s",
+		failedIcaTx.Id, failedIcaTx.CallbackId, failedIcaTx.Attempts, failedIcaTx.CallbackKey))
+	return nil
+}
+
+// IsFailedIcaTxInFlight returns true if the tx has been resubmitted and its packet is still waiting on an ack
+func (k Keeper) IsFailedIcaTxInFlight(ctx sdk.Context, failedIcaTx types.FailedIcaTx) bool {
+	if failedIcaTx.Status != types.FailedIcaTx_IN_FLIGHT {
+		return false
+	}
+	_, found := k.GetCallbackData(ctx, failedIcaTx.CallbackKey)
+	return found
+}
+
+// IsIcaChannelOpen returns true if the active channel of the ICA on the port is open, it isn't while being restored
+func (k Keeper) IsIcaChannelOpen(ctx sdk.Context, connectionId string, portId string) bool {
+	channelId, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, connectionId, portId)
+	if !found {
+		return false
+	}
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portId, channelId)
+	return found && channel.State == channeltypes.OPEN
+}
+
+// GetPacketMsgs returns the msgs of an ICA packet, still packed as Any
+func (k Keeper) GetPacketMsgs(packet channeltypes.Packet) ([]*codectypes.Any, error) {
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICA packet data: %s", err.Error())
+	}
+	var cosmosTx icatypes.CosmosTx
+	if err := k.cdc.Unmarshal(packetData.Data, &cosmosTx); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICA tx: %s", err.Error())
+	}
+	return cosmosTx.Messages, nil
+}
+
+// GetCurrentStrideEpoch returns the number of the current stride epoch, which the retry backoff is measured in
+func (k Keeper) GetCurrentStrideEpoch(ctx sdk.Context) uint64 {
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		return 0
+	}
+	epochNumber, err := cast.ToUint64E(epochInfo.CurrentEpoch)
+	if err != nil {
+		return 0
+	}
+	return epochNumber
+}
@@ -0,0 +1,36 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+// CallbackDataKeeper is the part of the icacallbacks keeper the migration needs
+type CallbackDataKeeper interface {
+	GetAllCallbackData(ctx sdk.Context) []types.CallbackData
+	SetCallbackData(ctx sdk.Context, callbackData types.CallbackData)
+	GetCallbackExpiration(ctx sdk.Context, timeoutTimestamp uint64) uint64
+}
+
+// MigrateParams sets the icacallbacks params, which chains that started before v2 don't have, to their defaults
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	params := types.DefaultParams()
+	paramSpace.SetParamSet(ctx, &params)
+
+	paramSpace.GetParamSet(ctx, &params)
+	return params.Validate()
+}
+
+// MigrateCallbackDataExpiration gives the callback data stored before v2, which has no expiration, one buffer from the
+// upgrade so it's swept if its ack or timeout never comes
+func MigrateCallbackDataExpiration(ctx sdk.Context, k CallbackDataKeeper) {
+	for _, callbackData := range k.GetAllCallbackData(ctx) {
+		if callbackData.ExpirationTimestamp != 0 {
+			continue
+		}
+		callbackData.ExpirationTimestamp = k.GetCallbackExpiration(ctx, 0)
+		k.SetCallbackData(ctx, callbackData)
+	}
+}
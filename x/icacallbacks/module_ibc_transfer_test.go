@@ -0,0 +1,140 @@
+package icacallbacks_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/app/apptesting"
+	cmdcfg "github.com/Stride-Labs/stride/cmd/strided/config"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+type TransferMiddlewareTestSuite struct {
+	apptesting.AppTestHelper
+	path *ibctesting.Path
+}
+
+func TestTransferMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferMiddlewareTestSuite))
+}
+
+// SetupTest opens a transfer channel between stride and the host chain, and funds the stakeibc module account
+func (s *TransferMiddlewareTestSuite) SetupTest() {
+	cmdcfg.SetBech32Prefixes(sdk.GetConfig())
+	s.SetupIBCChains()
+
+	s.path = ibctesting.NewPath(s.StrideChain, s.HostChain)
+	s.path.EndpointA.ChannelConfig.PortID = ibctransfertypes.PortID
+	s.path.EndpointA.ChannelConfig.Version = ibctransfertypes.Version
+	s.path.EndpointB.ChannelConfig.PortID = ibctransfertypes.PortID
+	s.path.EndpointB.ChannelConfig.Version = ibctransfertypes.Version
+	s.Coordinator.Setup(s.path)
+
+	ctx := s.StrideChain.GetContext()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("ustrd", 1000))
+	s.Require().NoError(s.App.BankKeeper.MintCoins(ctx, stakeibctypes.ModuleName, coins))
+}
+
+// transferDepositRecord sends a deposit record's tokens to the host and returns the packet that was sent
+func (s *TransferMiddlewareTestSuite) transferDepositRecord(timeoutHeight clienttypes.Height) (recordstypes.DepositRecord, channeltypes.Packet) {
+	ctx := s.StrideChain.GetContext()
+	depositRecord := recordstypes.DepositRecord{
+		Id:         1,
+		Amount:     100,
+		Denom:      "ustrd",
+		HostZoneId: s.HostChain.ChainID,
+		Status:     recordstypes.DepositRecord_TRANSFER,
+	}
+	s.App.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+
+	sender := s.App.AccountKeeper.GetModuleAddress(stakeibctypes.ModuleName).String()
+	receiver := s.HostChain.SenderAccount.GetAddress().String()
+	coin := sdk.NewInt64Coin("ustrd", depositRecord.Amount)
+	msg := ibctransfertypes.NewMsgTransfer(ibctransfertypes.PortID, s.path.EndpointA.ChannelID, coin, sender, receiver, timeoutHeight, 0)
+
+	err := s.App.RecordsKeeper.Transfer(ctx, msg, depositRecord.Id)
+	s.Require().NoError(err)
+
+	callbackKey := icacallbackstypes.PacketID(ibctransfertypes.PortID, s.path.EndpointA.ChannelID, 1)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(ctx, callbackKey)
+	s.Require().True(found, "callback data registered")
+	s.Require().Equal(recordstypes.ModuleName, callbackData.Module, "callback module")
+
+	s.Coordinator.CommitBlock(s.StrideChain)
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+
+	data := ibctransfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), sender, receiver)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, ibctransfertypes.PortID, s.path.EndpointA.ChannelID,
+		ibctransfertypes.PortID, s.path.EndpointB.ChannelID, timeoutHeight, 0)
+	return depositRecord, packet
+}
+
+func (s *TransferMiddlewareTestSuite) TestTransferAckCallsCallback() {
+	depositRecord, packet := s.transferDepositRecord(clienttypes.NewHeight(0, 1000))
+
+	err := s.path.RelayPacket(packet)
+	s.Require().NoError(err)
+
+	ctx := s.StrideChain.GetContext()
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(ctx, depositRecord.Id)
+	s.Require().True(found)
+	s.Require().Equal(recordstypes.DepositRecord_STAKE, depositRecord.Status, "deposit record status")
+
+	_, found = s.App.IcacallbacksKeeper.GetCallbackData(ctx, icacallbackstypes.PacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	s.Require().False(found, "callback data removed")
+}
+
+func (s *TransferMiddlewareTestSuite) TestTransferTimeoutCallsCallback() {
+	timeoutHeight := clienttypes.NewHeight(0, uint64(s.HostChain.GetContext().BlockHeight())+1)
+	depositRecord, packet := s.transferDepositRecord(timeoutHeight)
+
+	// let the host chain pass the timeout height, then time out the packet on stride
+	s.Coordinator.CommitNBlocks(s.HostChain, 2)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	err := s.path.EndpointA.TimeoutPacket(packet)
+	s.Require().NoError(err)
+
+	ctx := s.StrideChain.GetContext()
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(ctx, depositRecord.Id)
+	s.Require().True(found)
+	s.Require().Equal(recordstypes.DepositRecord_TRANSFER, depositRecord.Status, "deposit record is sent again next epoch")
+
+	_, found = s.App.IcacallbacksKeeper.GetCallbackData(ctx, icacallbackstypes.PacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	s.Require().False(found, "callback data removed")
+
+	// the transfer module refunded the tokens
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(stakeibctypes.ModuleName)
+	balance := s.App.BankKeeper.GetBalance(ctx, moduleAddress, "ustrd")
+	s.Require().Equal(int64(1000), balance.Amount.Int64(), "module account balance")
+}
+
+func (s *TransferMiddlewareTestSuite) TestLegacyTransferAckMatchesByAmount() {
+	depositRecord, packet := s.transferDepositRecord(clienttypes.NewHeight(0, 1000))
+
+	// transfers sent before the upgrade have no callback registered
+	ctx := s.StrideChain.GetContext()
+	s.App.IcacallbacksKeeper.RemoveCallbackData(ctx, icacallbackstypes.PacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+	otherRecord := recordstypes.DepositRecord{Id: 2, Amount: 200, Denom: "ustrd", HostZoneId: s.HostChain.ChainID, Status: recordstypes.DepositRecord_TRANSFER}
+	s.App.RecordsKeeper.SetDepositRecord(ctx, otherRecord)
+	s.Coordinator.CommitBlock(s.StrideChain)
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+
+	err := s.path.RelayPacket(packet)
+	s.Require().NoError(err)
+
+	ctx = s.StrideChain.GetContext()
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(ctx, depositRecord.Id)
+	s.Require().True(found)
+	s.Require().Equal(recordstypes.DepositRecord_STAKE, depositRecord.Status, "deposit record matched by amount")
+	otherRecord, found = s.App.RecordsKeeper.GetDepositRecord(ctx, otherRecord.Id)
+	s.Require().True(found)
+	s.Require().Equal(recordstypes.DepositRecord_TRANSFER, otherRecord.Status, "other deposit record status")
+}
@@ -29,6 +29,11 @@ type CallbackData struct {
 	Sequence     uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	CallbackId   string `protobuf:"bytes,5,opt,name=callbackId,proto3" json:"callbackId,omitempty"`
 	CallbackArgs []byte `protobuf:"bytes,6,opt,name=callbackArgs,proto3" json:"callbackArgs,omitempty"`
+	// module whose callback handler is called, if empty the module bound to the packet's port is used
+	Module string `protobuf:"bytes,7,opt,name=module,proto3" json:"module,omitempty"`
+	// unix time (ns) after which the callback data is garbage collected if no ack or timeout was received for the
+	// packet, 0 if it never expires
+	ExpirationTimestamp uint64 `protobuf:"varint,8,opt,name=expirationTimestamp,proto3" json:"expirationTimestamp,omitempty"`
 }
 
 func (m *CallbackData) Reset()         { *m = CallbackData{} }
@@ -106,6 +111,20 @@ func (m *CallbackData) GetCallbackArgs() []byte {
 	return nil
 }
 
+func (m *CallbackData) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *CallbackData) GetExpirationTimestamp() uint64 {
+	if m != nil {
+		return m.ExpirationTimestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CallbackData)(nil), "stridelabs.stride.icacallbacks.CallbackData")
 }
@@ -113,23 +132,26 @@ func init() {
 func init() { proto.RegisterFile("icacallbacks/callback_data.proto", fileDescriptor_dd97f937277b6303) }
 
 var fileDescriptor_dd97f937277b6303 = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x33, 0x5a, 0x83, 0xbd, 0x66, 0x35, 0x0b, 0x19, 0x44, 0x86, 0xd0, 0x55, 0x36, 0x66,
-	0x40, 0x9f, 0xc0, 0x9f, 0x4d, 0xb0, 0xab, 0xb8, 0x73, 0x23, 0x77, 0x7e, 0x68, 0x83, 0x31, 0x89,
-	0x99, 0x29, 0xd8, 0xb7, 0xf0, 0xa9, 0xc4, 0x65, 0x97, 0x2e, 0x25, 0x79, 0x11, 0x61, 0xda, 0xd8,
-	0x74, 0x77, 0xcf, 0x77, 0x38, 0x5c, 0xf8, 0x20, 0x2e, 0x14, 0x2a, 0x2c, 0x4b, 0x89, 0xea, 0xd5,
-	0x8a, 0xe1, 0x7a, 0xd1, 0xe8, 0x30, 0x6d, 0xda, 0xda, 0xd5, 0x94, 0x5b, 0xd7, 0x16, 0xda, 0x94,
-	0x28, 0x6d, 0xba, 0x3d, 0xd3, 0xf1, 0x66, 0xf6, 0x45, 0x20, 0xba, 0xdf, 0xa5, 0x07, 0x74, 0x48,
-	0x63, 0x38, 0x1b, 0xda, 0x47, 0xb3, 0x66, 0x24, 0x26, 0xc9, 0x34, 0x1f, 0x23, 0x7a, 0x0e, 0x61,
-	0x53, 0xb7, 0x2e, 0xd3, 0xec, 0xc8, 0x97, 0xbb, 0x44, 0x2f, 0x61, 0xaa, 0x96, 0x58, 0x55, 0xa6,
-	0xcc, 0x34, 0x3b, 0xf6, 0xd5, 0x1e, 0xd0, 0x0b, 0x38, 0xb5, 0xe6, 0x7d, 0x65, 0x2a, 0x65, 0xd8,
-	0x24, 0x26, 0xc9, 0x24, 0xff, 0xcf, 0x94, 0x03, 0x0c, 0x0f, 0x32, 0xcd, 0x4e, 0xfc, 0x74, 0x44,
-	0xe8, 0x0c, 0xa2, 0x21, 0xdd, 0xb6, 0x0b, 0xcb, 0xc2, 0x98, 0x24, 0x51, 0x7e, 0xc0, 0xee, 0xe6,
-	0xdf, 0x1d, 0x27, 0x9b, 0x8e, 0x93, 0xdf, 0x8e, 0x93, 0xcf, 0x9e, 0x07, 0x9b, 0x9e, 0x07, 0x3f,
-	0x3d, 0x0f, 0x9e, 0xaf, 0x17, 0x85, 0x5b, 0xae, 0x64, 0xaa, 0xea, 0x37, 0xf1, 0xe4, 0x15, 0x5c,
-	0xcd, 0x51, 0x5a, 0xb1, 0xd5, 0x21, 0x3e, 0xc4, 0x81, 0x44, 0xb7, 0x6e, 0x8c, 0x95, 0xa1, 0xb7,
-	0x77, 0xf3, 0x17, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xb1, 0xe3, 0x50, 0x61, 0x01, 0x00, 0x00,
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xeb, 0x7e, 0xfd, 0x4a, 0x6b, 0x3a, 0x19, 0x09, 0x59, 0x08, 0x59, 0x51, 0xa7, 0x2c,
+	0x24, 0x08, 0x9e, 0x80, 0x3f, 0x4b, 0x44, 0xa7, 0xc0, 0xc4, 0x82, 0x6e, 0x6c, 0xab, 0xb5, 0x48,
+	0xe2, 0x10, 0x3b, 0x52, 0xfb, 0x16, 0x7d, 0x2c, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0x84, 0xd3,
+	0x40, 0x2a, 0xb1, 0xdd, 0xdf, 0x39, 0xba, 0xba, 0x47, 0xf7, 0x60, 0x4f, 0x71, 0xe0, 0x90, 0xa6,
+	0x09, 0xf0, 0x57, 0x13, 0x76, 0xd3, 0x8b, 0x00, 0x0b, 0x41, 0x51, 0x6a, 0xab, 0x09, 0x33, 0xb6,
+	0x54, 0x42, 0xa6, 0x90, 0x98, 0xa0, 0x1d, 0x83, 0xfe, 0xce, 0x7c, 0x3b, 0xc4, 0xb3, 0xbb, 0x3d,
+	0xdd, 0x83, 0x05, 0xe2, 0xe1, 0xe3, 0xce, 0x7d, 0x90, 0x1b, 0x8a, 0x3c, 0xe4, 0x4f, 0xe3, 0xbe,
+	0x44, 0x4e, 0xf1, 0xb8, 0xd0, 0xa5, 0x8d, 0x04, 0x1d, 0x3a, 0x73, 0x4f, 0xe4, 0x1c, 0x4f, 0xf9,
+	0x0a, 0xf2, 0x5c, 0xa6, 0x91, 0xa0, 0xff, 0x9c, 0xf5, 0x2b, 0x90, 0x33, 0x3c, 0x31, 0xf2, 0xad,
+	0x92, 0x39, 0x97, 0x74, 0xe4, 0x21, 0x7f, 0x14, 0xff, 0x30, 0x61, 0x18, 0x77, 0x07, 0x22, 0x41,
+	0xff, 0xbb, 0xd5, 0x9e, 0x42, 0xe6, 0x78, 0xd6, 0xd1, 0x4d, 0xb9, 0x34, 0x74, 0xec, 0x21, 0x7f,
+	0x16, 0x1f, 0x68, 0xdf, 0xa9, 0x32, 0x2d, 0xaa, 0x54, 0xd2, 0xa3, 0x36, 0x55, 0x4b, 0xe4, 0x12,
+	0x9f, 0xc8, 0x75, 0xa1, 0x4a, 0xb0, 0x4a, 0xe7, 0x4f, 0x2a, 0x93, 0xc6, 0x42, 0x56, 0xd0, 0x89,
+	0x8b, 0xf0, 0x97, 0x75, 0xbb, 0x78, 0xaf, 0x19, 0xda, 0xd5, 0x0c, 0x7d, 0xd6, 0x0c, 0x6d, 0x1b,
+	0x36, 0xd8, 0x35, 0x6c, 0xf0, 0xd1, 0xb0, 0xc1, 0xf3, 0xd5, 0x52, 0xd9, 0x55, 0x95, 0x04, 0x5c,
+	0x67, 0xe1, 0xa3, 0x7b, 0xe6, 0xc5, 0x02, 0x12, 0x13, 0xb6, 0x8f, 0x0d, 0xd7, 0xe1, 0x41, 0x1d,
+	0x76, 0x53, 0x48, 0x93, 0x8c, 0x5d, 0x0f, 0xd7, 0x5f, 0x03, 0x00, 0x40, 0x3f, 0x8e, 0x75, 0xab,
+	0x01, 0x00, 0x00,
 }
 
 func (m *CallbackData) Marshal() (dAtA []byte, err error) {
@@ -152,6 +174,18 @@ func (m *CallbackData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintCallbackData(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintCallbackData(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.CallbackArgs) > 0 {
 		i -= len(m.CallbackArgs)
 		copy(dAtA[i:], m.CallbackArgs)
@@ -235,6 +269,13 @@ func (m *CallbackData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCallbackData(uint64(l))
 	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovCallbackData(uint64(l))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovCallbackData(uint64(m.ExpirationTimestamp))
+	}
 	return n
 }
 
@@ -454,6 +495,57 @@ func (m *CallbackData) Unmarshal(dAtA []byte) error {
 				m.CallbackArgs = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbackData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbackData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCallbackData(dAtA[iNdEx:])
@@ -1,14 +1,102 @@
 package types
 
 import (
+	"fmt"
+
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// ICACallbackHandler is registered by each module that sends ICA txs with callbacks. A callback that is handed a
+// failed tx (timeout or ack error) can return an error wrapping ErrRetryableTx to have the tx queued for resubmission.
 type ICACallbackHandler interface {
 	AddICACallback(id string, fn interface{}) ICACallbackHandler
 	RegisterICACallbacks() ICACallbackHandler
-	CallICACallback(ctx sdk.Context, id string, packet channeltypes.Packet, txMsgData *sdk.TxMsgData, args []byte) error
+	CallICACallback(ctx sdk.Context, id string, packet channeltypes.Packet, ackResult *AcknowledgementResult, args []byte) error
 	HasICACallback(id string) bool
+	// ResubmitICATx sends the msgs of a failed tx again on the ICA bound to portId, registering the callback for the
+	// new packet, and returns the new packet's sequence
+	ResubmitICATx(ctx sdk.Context, portId string, msgs []sdk.Msg, id string, args []byte) (uint64, error)
+	// ReleaseICATx hands the work of a failed tx back to the module when it is dropped from the retry queue
+	ReleaseICATx(ctx sdk.Context, id string, args []byte) error
+	// DecodeCallbackArgs unmarshals the args of a callback into their proto type, so that they can be shown in queries
+	DecodeCallbackArgs(id string, args []byte) (proto.Message, error)
+}
+
+// CallbackExpiryHandler can optionally be implemented by an ICACallbackHandler. It is called when callback data
+// expires without the packet's ack or timeout having been received, so that the module can release the records
+// that were waiting on it
+type CallbackExpiryHandler interface {
+	OnCallbackDataExpired(ctx sdk.Context, callbackData CallbackData) error
+}
+
+// AckResponseStatus is the outcome of an ICA packet
+type AckResponseStatus int
+
+const (
+	AckResponseStatus_SUCCESS AckResponseStatus = iota
+	AckResponseStatus_TIMEOUT
+	AckResponseStatus_FAILURE
+)
+
+func (s AckResponseStatus) String() string {
+	switch s {
+	case AckResponseStatus_SUCCESS:
+		return "SUCCESS"
+	case AckResponseStatus_TIMEOUT:
+		return "TIMEOUT"
+	case AckResponseStatus_FAILURE:
+		return "FAILURE"
+	}
+	return fmt.Sprintf("AckResponseStatus(%d)", int(s))
+}
+
+// AcknowledgementResult is passed to ICA callbacks in place of the raw acknowledgement.
+// MsgResponses holds one response per msg in the tx (in order) if the tx succeeded, and Error holds the
+// error returned by the host if it failed
+type AcknowledgementResult struct {
+	Status       AckResponseStatus
+	MsgResponses []*sdk.MsgData
+	Error        string
+}
+
+// NewTimeoutAcknowledgementResult returns the result passed to callbacks when a packet times out
+func NewTimeoutAcknowledgementResult() *AcknowledgementResult {
+	return &AcknowledgementResult{Status: AckResponseStatus_TIMEOUT}
+}
+
+// UnpackAcknowledgementResult decodes the acknowledgement of an ICA packet, unmarshalling the tx msg data of a
+// successful tx into its per-msg responses
+func UnpackAcknowledgementResult(ack channeltypes.Acknowledgement) (*AcknowledgementResult, error) {
+	switch response := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		if len(response.Result) == 0 {
+			return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "acknowledgement result cannot be empty")
+		}
+		var txMsgData sdk.TxMsgData
+		if err := proto.Unmarshal(response.Result, &txMsgData); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 tx message data: %s", err.Error())
+		}
+		return &AcknowledgementResult{Status: AckResponseStatus_SUCCESS, MsgResponses: txMsgData.Data}, nil
+	case *channeltypes.Acknowledgement_Error:
+		return &AcknowledgementResult{Status: AckResponseStatus_FAILURE, Error: response.Error}, nil
+	default:
+		return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "unsupported acknowledgement response field type %T", response)
+	}
+}
+
+// UnpackTransferAcknowledgementResult decodes the acknowledgement of an ICS-20 transfer packet, which has no
+// msg responses
+func UnpackTransferAcknowledgementResult(ack channeltypes.Acknowledgement) (*AcknowledgementResult, error) {
+	switch response := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		return &AcknowledgementResult{Status: AckResponseStatus_SUCCESS}, nil
+	case *channeltypes.Acknowledgement_Error:
+		return &AcknowledgementResult{Status: AckResponseStatus_FAILURE, Error: response.Error}, nil
+	default:
+		return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "unsupported acknowledgement response field type %T", response)
+	}
 }
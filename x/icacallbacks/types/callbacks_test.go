@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+func TestUnpackAcknowledgementResult(t *testing.T) {
+	msgResponses := []*sdk.MsgData{
+		{MsgType: "/cosmos.staking.v1beta1.MsgDelegate", Data: []byte{1}},
+		{MsgType: "/cosmos.staking.v1beta1.MsgDelegate", Data: []byte{2}},
+	}
+	txMsgData, err := proto.Marshal(&sdk.TxMsgData{Data: msgResponses})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		ack      channeltypes.Acknowledgement
+		expected *types.AcknowledgementResult
+		err      error
+	}{
+		{
+			desc:     "success",
+			ack:      channeltypes.NewResultAcknowledgement(txMsgData),
+			expected: &types.AcknowledgementResult{Status: types.AckResponseStatus_SUCCESS, MsgResponses: msgResponses},
+		},
+		{
+			desc:     "error",
+			ack:      channeltypes.NewErrorAcknowledgement("insufficient funds"),
+			expected: &types.AcknowledgementResult{Status: types.AckResponseStatus_FAILURE, Error: "insufficient funds"},
+		},
+		{
+			desc: "empty result",
+			ack:  channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Result{}},
+			err:  channeltypes.ErrInvalidAcknowledgement,
+		},
+		{
+			desc: "invalid tx msg data",
+			ack:  channeltypes.NewResultAcknowledgement([]byte{0xff}),
+			err:  sdkerrors.ErrUnknownRequest,
+		},
+		{
+			desc: "no response",
+			ack:  channeltypes.Acknowledgement{},
+			err:  channeltypes.ErrInvalidAcknowledgement,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := types.UnpackAcknowledgementResult(tc.ack)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.Status, result.Status, "status")
+			require.Equal(t, tc.expected.Error, result.Error, "error")
+			require.Len(t, result.MsgResponses, len(tc.expected.MsgResponses), "number of msg responses")
+			for i, msgResponse := range tc.expected.MsgResponses {
+				require.Equal(t, msgResponse.MsgType, result.MsgResponses[i].MsgType, "msg type")
+				require.Equal(t, msgResponse.Data, result.MsgResponses[i].Data, "msg response")
+			}
+		})
+	}
+
+	require.Equal(t, types.AckResponseStatus_TIMEOUT, types.NewTimeoutAcknowledgementResult().Status, "timeout status")
+}
+
+func TestUnpackTransferAcknowledgementResult(t *testing.T) {
+	result, err := types.UnpackTransferAcknowledgementResult(channeltypes.NewResultAcknowledgement([]byte{1}))
+	require.NoError(t, err)
+	require.Equal(t, types.AckResponseStatus_SUCCESS, result.Status, "success status")
+	require.Empty(t, result.MsgResponses, "transfer acks have no msg responses")
+
+	result, err = types.UnpackTransferAcknowledgementResult(channeltypes.NewErrorAcknowledgement("insufficient funds"))
+	require.NoError(t, err)
+	require.Equal(t, types.AckResponseStatus_FAILURE, result.Status, "failure status")
+	require.Equal(t, "insufficient funds", result.Error, "error")
+
+	_, err = types.UnpackTransferAcknowledgementResult(channeltypes.Acknowledgement{})
+	require.ErrorIs(t, err, channeltypes.ErrInvalidAcknowledgement)
+}
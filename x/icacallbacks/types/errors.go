@@ -14,4 +14,8 @@ var (
 	ErrCallbackHandlerNotFound = sdkerrors.Register(ModuleName, 1502, "icacallback handler not found")
 	ErrCallbackIdNotFound      = sdkerrors.Register(ModuleName, 1503, "icacallback ID not found")
 	ErrCallbackFailed          = sdkerrors.Register(ModuleName, 1504, "icacallback failed")
+	ErrRetryableTx             = sdkerrors.Register(ModuleName, 1505, "ica tx failed and can be retried")
+	ErrFailedIcaTxNotFound     = sdkerrors.Register(ModuleName, 1506, "failed ica tx not found")
+	ErrFailedIcaTxInFlight     = sdkerrors.Register(ModuleName, 1507, "failed ica tx has a resubmission in flight")
+	ErrIcaChannelUnavailable   = sdkerrors.Register(ModuleName, 1508, "ica channel is not open")
 )
@@ -2,10 +2,15 @@ package types
 
 // IBC events
 const (
-	EventTypeTimeout = "timeout"
+	EventTypeTimeout             = "timeout"
+	EventTypeCallbackDataExpired = "callback_data_expired"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAck        = "acknowledgement"
 	AttributeKeyAckError   = "error"
+
+	AttributeKeyCallbackKey    = "callback_key"
+	AttributeKeyCallbackId     = "callback_id"
+	AttributeKeyCallbackModule = "callback_module"
 )
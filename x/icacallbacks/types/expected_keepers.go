@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -16,3 +18,8 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// EpochsKeeper defines the expected interface needed to schedule retries on the stride epoch
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
@@ -0,0 +1,920 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: icacallbacks/failed_ica_tx.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type FailedIcaTx_Status int32
+
+const (
+	// recorded for visibility, only resubmitted when forced by an admin
+	FailedIcaTx_FAILED FailedIcaTx_Status = 0
+	// waiting for the retrier
+	FailedIcaTx_RETRYABLE FailedIcaTx_Status = 1
+	// resubmitted, waiting on the acknowledgement of the new packet
+	FailedIcaTx_IN_FLIGHT FailedIcaTx_Status = 2
+	// max retry attempts reached, only resubmitted when forced by an admin
+	FailedIcaTx_EXHAUSTED FailedIcaTx_Status = 3
+)
+
+var FailedIcaTx_Status_name = map[int32]string{
+	0: "FAILED",
+	1: "RETRYABLE",
+	2: "IN_FLIGHT",
+	3: "EXHAUSTED",
+}
+
+var FailedIcaTx_Status_value = map[string]int32{
+	"FAILED":    0,
+	"RETRYABLE": 1,
+	"IN_FLIGHT": 2,
+	"EXHAUSTED": 3,
+}
+
+func (x FailedIcaTx_Status) String() string {
+	return proto.EnumName(FailedIcaTx_Status_name, int32(x))
+}
+
+func (FailedIcaTx_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5cabe84069830753, []int{0, 0}
+}
+
+// FailedIcaTx is an ICA tx that timed out or was rejected by the host, kept so that it can be resubmitted
+type FailedIcaTx struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// module that registered the callback and owns the ICA
+	Module       string             `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	CallbackId   string             `protobuf:"bytes,3,opt,name=callbackId,proto3" json:"callbackId,omitempty"`
+	CallbackArgs []byte             `protobuf:"bytes,4,opt,name=callbackArgs,proto3" json:"callbackArgs,omitempty"`
+	ConnectionId string             `protobuf:"bytes,5,opt,name=connectionId,proto3" json:"connectionId,omitempty"`
+	PortId       string             `protobuf:"bytes,6,opt,name=portId,proto3" json:"portId,omitempty"`
+	Msgs         []*types.Any       `protobuf:"bytes,7,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Error        string             `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts     uint64             `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Status       FailedIcaTx_Status `protobuf:"varint,10,opt,name=status,proto3,enum=stridelabs.stride.icacallbacks.FailedIcaTx_Status" json:"status,omitempty"`
+	// stride epoch at which the retrier will next resubmit the tx
+	NextRetryEpoch uint64 `protobuf:"varint,11,opt,name=nextRetryEpoch,proto3" json:"nextRetryEpoch,omitempty"`
+	// callback key of the packet the tx was last sent in
+	CallbackKey string `protobuf:"bytes,12,opt,name=callbackKey,proto3" json:"callbackKey,omitempty"`
+	// stride epoch at which the tx was marked failed or exhausted, it's pruned once the retention has passed
+	FailedEpoch uint64 `protobuf:"varint,13,opt,name=failedEpoch,proto3" json:"failedEpoch,omitempty"`
+}
+
+func (m *FailedIcaTx) Reset()         { *m = FailedIcaTx{} }
+func (m *FailedIcaTx) String() string { return proto.CompactTextString(m) }
+func (*FailedIcaTx) ProtoMessage()    {}
+func (*FailedIcaTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cabe84069830753, []int{0}
+}
+func (m *FailedIcaTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedIcaTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedIcaTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedIcaTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedIcaTx.Merge(m, src)
+}
+func (m *FailedIcaTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedIcaTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedIcaTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedIcaTx proto.InternalMessageInfo
+
+func (m *FailedIcaTx) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FailedIcaTx) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *FailedIcaTx) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *FailedIcaTx) GetCallbackArgs() []byte {
+	if m != nil {
+		return m.CallbackArgs
+	}
+	return nil
+}
+
+func (m *FailedIcaTx) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *FailedIcaTx) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *FailedIcaTx) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *FailedIcaTx) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedIcaTx) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *FailedIcaTx) GetStatus() FailedIcaTx_Status {
+	if m != nil {
+		return m.Status
+	}
+	return FailedIcaTx_FAILED
+}
+
+func (m *FailedIcaTx) GetNextRetryEpoch() uint64 {
+	if m != nil {
+		return m.NextRetryEpoch
+	}
+	return 0
+}
+
+func (m *FailedIcaTx) GetCallbackKey() string {
+	if m != nil {
+		return m.CallbackKey
+	}
+	return ""
+}
+
+func (m *FailedIcaTx) GetFailedEpoch() uint64 {
+	if m != nil {
+		return m.FailedEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("stridelabs.stride.icacallbacks.FailedIcaTx_Status", FailedIcaTx_Status_name, FailedIcaTx_Status_value)
+	proto.RegisterType((*FailedIcaTx)(nil), "stridelabs.stride.icacallbacks.FailedIcaTx")
+}
+
+func init() { proto.RegisterFile("icacallbacks/failed_ica_tx.proto", fileDescriptor_5cabe84069830753) }
+
+var fileDescriptor_5cabe84069830753 = []byte{
+	// 450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x49, 0x6a, 0x9a, 0x49, 0x1a, 0x45, 0xab, 0x0a, 0x2d, 0x3d, 0x58, 0x56, 0x0e,
+	0xc8, 0x17, 0xd6, 0x52, 0x78, 0x02, 0x57, 0x75, 0xa8, 0x21, 0xe2, 0xe0, 0x04, 0x09, 0xb8, 0x44,
+	0x6b, 0x7b, 0xeb, 0x5a, 0x38, 0x5e, 0xcb, 0xbb, 0x91, 0xe2, 0x77, 0xe0, 0xc0, 0x63, 0x71, 0xec,
+	0x91, 0x23, 0x4a, 0x5e, 0x04, 0x65, 0xb7, 0x41, 0x0e, 0x07, 0x6e, 0xfe, 0x7f, 0xcf, 0x7c, 0xe3,
+	0xf9, 0xc7, 0xe0, 0xe4, 0x09, 0x4b, 0x58, 0x51, 0xc4, 0x2c, 0xf9, 0x26, 0xbd, 0x07, 0x96, 0x17,
+	0x3c, 0x5d, 0xe7, 0x09, 0x5b, 0xab, 0x1d, 0xad, 0x6a, 0xa1, 0x04, 0xb6, 0xa5, 0xaa, 0xf3, 0x94,
+	0x17, 0x2c, 0x96, 0xd4, 0x3c, 0xd2, 0x76, 0xcf, 0xcd, 0xab, 0x4c, 0x88, 0xac, 0xe0, 0x9e, 0xae,
+	0x8e, 0xb7, 0x0f, 0x1e, 0x2b, 0x1b, 0xd3, 0x3a, 0xfd, 0xde, 0x87, 0xe1, 0x5c, 0x23, 0xc3, 0x84,
+	0xad, 0x76, 0x78, 0x0c, 0xdd, 0x3c, 0x25, 0xc8, 0x41, 0x6e, 0x3f, 0xea, 0xe6, 0x29, 0x7e, 0x09,
+	0xd6, 0x46, 0xa4, 0xdb, 0x82, 0x93, 0xae, 0x83, 0xdc, 0x41, 0xf4, 0xac, 0xb0, 0x0d, 0x70, 0xe2,
+	0x87, 0x29, 0xe9, 0xe9, 0x77, 0x2d, 0x07, 0x4f, 0x61, 0x74, 0x52, 0x7e, 0x9d, 0x49, 0xd2, 0x77,
+	0x90, 0x3b, 0x8a, 0xce, 0x3c, 0x5d, 0x23, 0xca, 0x92, 0x27, 0x2a, 0x17, 0x65, 0x98, 0x92, 0x0b,
+	0x4d, 0x39, 0xf3, 0x8e, 0xf3, 0x2b, 0x51, 0xab, 0x30, 0x25, 0x96, 0x99, 0x6f, 0x14, 0x76, 0xa1,
+	0xbf, 0x91, 0x99, 0x24, 0x2f, 0x9c, 0x9e, 0x3b, 0x9c, 0x5d, 0x53, 0xb3, 0x21, 0x3d, 0x6d, 0x48,
+	0xfd, 0xb2, 0x89, 0x74, 0x05, 0xbe, 0x86, 0x0b, 0x5e, 0xd7, 0xa2, 0x26, 0x97, 0x1a, 0x60, 0x04,
+	0xbe, 0x81, 0x4b, 0xa6, 0x14, 0xdf, 0x54, 0x4a, 0x92, 0x81, 0xde, 0xf6, 0xaf, 0xc6, 0xef, 0xc1,
+	0x92, 0x8a, 0xa9, 0xad, 0x24, 0xe0, 0x20, 0x77, 0x3c, 0x9b, 0xd1, 0xff, 0xe7, 0x4b, 0x5b, 0x01,
+	0xd2, 0xa5, 0xee, 0x8c, 0x9e, 0x09, 0xf8, 0x35, 0x8c, 0x4b, 0xbe, 0x53, 0x11, 0x57, 0x75, 0x13,
+	0x54, 0x22, 0x79, 0x24, 0x43, 0x3d, 0xed, 0x1f, 0x17, 0x3b, 0x30, 0x3c, 0xf1, 0x3e, 0xf0, 0x86,
+	0x8c, 0xf4, 0xb7, 0xb6, 0xad, 0x63, 0x85, 0xb9, 0xbd, 0xc1, 0x5c, 0x69, 0x4c, 0xdb, 0x9a, 0xfa,
+	0x60, 0x99, 0xe9, 0x18, 0xc0, 0x9a, 0xfb, 0xe1, 0x22, 0xb8, 0x9b, 0x74, 0xf0, 0x15, 0x0c, 0xa2,
+	0x60, 0x15, 0x7d, 0xf1, 0x6f, 0x17, 0xc1, 0x04, 0x1d, 0x65, 0xf8, 0x71, 0x3d, 0x5f, 0x84, 0xef,
+	0xee, 0x57, 0x93, 0xee, 0x51, 0x06, 0x9f, 0xef, 0xfd, 0x4f, 0xcb, 0x55, 0x70, 0x37, 0xe9, 0xdd,
+	0x2e, 0x7e, 0xee, 0x6d, 0xf4, 0xb4, 0xb7, 0xd1, 0xef, 0xbd, 0x8d, 0x7e, 0x1c, 0xec, 0xce, 0xd3,
+	0xc1, 0xee, 0xfc, 0x3a, 0xd8, 0x9d, 0xaf, 0xb3, 0x2c, 0x57, 0x8f, 0xdb, 0x98, 0x26, 0x62, 0xe3,
+	0x2d, 0x75, 0x06, 0x6f, 0x16, 0x2c, 0x96, 0x9e, 0xc9, 0xc3, 0xdb, 0x79, 0x67, 0x7f, 0xa9, 0x6a,
+	0x2a, 0x2e, 0x63, 0x4b, 0x9f, 0xe3, 0xed, 0x9f, 0x01, 0x00, 0x94, 0x4c, 0x52, 0x41, 0xc2, 0x02,
+	0x00, 0x00,
+}
+
+func (m *FailedIcaTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedIcaTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedIcaTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedEpoch != 0 {
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(m.FailedEpoch))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.CallbackKey) > 0 {
+		i -= len(m.CallbackKey)
+		copy(dAtA[i:], m.CallbackKey)
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(len(m.CallbackKey)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.NextRetryEpoch != 0 {
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(m.NextRetryEpoch))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Status != 0 {
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFailedIcaTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CallbackArgs) > 0 {
+		i -= len(m.CallbackArgs)
+		copy(dAtA[i:], m.CallbackArgs)
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(len(m.CallbackArgs)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintFailedIcaTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFailedIcaTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFailedIcaTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FailedIcaTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovFailedIcaTx(uint64(m.Id))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovFailedIcaTx(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovFailedIcaTx(uint64(l))
+	}
+	l = len(m.CallbackArgs)
+	if l > 0 {
+		n += 1 + l + sovFailedIcaTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovFailedIcaTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFailedIcaTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovFailedIcaTx(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFailedIcaTx(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovFailedIcaTx(uint64(m.Attempts))
+	}
+	if m.Status != 0 {
+		n += 1 + sovFailedIcaTx(uint64(m.Status))
+	}
+	if m.NextRetryEpoch != 0 {
+		n += 1 + sovFailedIcaTx(uint64(m.NextRetryEpoch))
+	}
+	l = len(m.CallbackKey)
+	if l > 0 {
+		n += 1 + l + sovFailedIcaTx(uint64(l))
+	}
+	if m.FailedEpoch != 0 {
+		n += 1 + sovFailedIcaTx(uint64(m.FailedEpoch))
+	}
+	return n
+}
+
+func sovFailedIcaTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFailedIcaTx(x uint64) (n int) {
+	return sovFailedIcaTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FailedIcaTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFailedIcaTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedIcaTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedIcaTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackArgs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackArgs = append(m.CallbackArgs[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackArgs == nil {
+				m.CallbackArgs = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FailedIcaTx_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryEpoch", wireType)
+			}
+			m.NextRetryEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedEpoch", wireType)
+			}
+			m.FailedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFailedIcaTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFailedIcaTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFailedIcaTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFailedIcaTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFailedIcaTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFailedIcaTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFailedIcaTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFailedIcaTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFailedIcaTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFailedIcaTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFailedIcaTx = fmt.Errorf("proto: unexpected end of group")
+)
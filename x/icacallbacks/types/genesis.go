@@ -13,6 +13,7 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		PortId:           PortID,
 		CallbackDataList: []CallbackData{},
+		FailedIcaTxList:  []FailedIcaTx{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -34,6 +35,18 @@ func (gs GenesisState) Validate() error {
 		}
 		callbackDataIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated ID in failedIcaTx
+	failedIcaTxIdMap := make(map[uint64]bool)
+	failedIcaTxCount := gs.GetFailedIcaTxCount()
+	for _, elem := range gs.FailedIcaTxList {
+		if _, ok := failedIcaTxIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for failedIcaTx")
+		}
+		if elem.Id >= failedIcaTxCount {
+			return fmt.Errorf("failedIcaTx id should be lower or equal than the last id")
+		}
+		failedIcaTxIdMap[elem.Id] = true
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -28,6 +28,8 @@ type GenesisState struct {
 	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PortId           string         `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	CallbackDataList []CallbackData `protobuf:"bytes,3,rep,name=callbackDataList,proto3" json:"callbackDataList"`
+	FailedIcaTxList  []FailedIcaTx  `protobuf:"bytes,4,rep,name=failedIcaTxList,proto3" json:"failedIcaTxList"`
+	FailedIcaTxCount uint64         `protobuf:"varint,5,opt,name=failedIcaTxCount,proto3" json:"failedIcaTxCount,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +86,20 @@ func (m *GenesisState) GetCallbackDataList() []CallbackData {
 	return nil
 }
 
+func (m *GenesisState) GetFailedIcaTxList() []FailedIcaTx {
+	if m != nil {
+		return m.FailedIcaTxList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedIcaTxCount() uint64 {
+	if m != nil {
+		return m.FailedIcaTxCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stridelabs.stride.icacallbacks.GenesisState")
 }
@@ -91,25 +107,28 @@ func init() {
 func init() { proto.RegisterFile("icacallbacks/genesis.proto", fileDescriptor_3f2a4f344ad2af69) }
 
 var fileDescriptor_3f2a4f344ad2af69 = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0x4c, 0x4e, 0x4c,
-	0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x2b, 0x2e, 0x29, 0xca, 0x4c, 0x49, 0xcd, 0x49,
-	0x4c, 0x2a, 0xd6, 0x83, 0x30, 0xf5, 0x90, 0x55, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x95,
-	0xea, 0x83, 0x58, 0x10, 0x5d, 0x52, 0x92, 0x28, 0x26, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d,
-	0x94, 0x52, 0x40, 0x91, 0x82, 0xb1, 0xe2, 0x53, 0x12, 0x4b, 0x12, 0x21, 0x2a, 0x94, 0xce, 0x32,
-	0x72, 0xf1, 0xb8, 0x43, 0x1c, 0x11, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc2, 0xc5, 0x06, 0x31,
-	0x42, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4d, 0x0f, 0xbf, 0xa3, 0xf4, 0x02, 0xc0, 0xaa,
-	0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x15, 0x12, 0xe7, 0x62, 0x2f, 0xc8, 0x2f,
-	0x2a, 0x89, 0xcf, 0x4c, 0x91, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x0c, 0x62, 0x03, 0x71, 0x3d, 0x53,
-	0x84, 0xe2, 0xb8, 0x04, 0x60, 0x5a, 0x5d, 0x12, 0x4b, 0x12, 0x7d, 0x32, 0x8b, 0x4b, 0x24, 0x98,
-	0x15, 0x98, 0x35, 0xb8, 0x8d, 0x74, 0x08, 0x59, 0xe4, 0x8c, 0xa4, 0x0f, 0x6a, 0x1d, 0x86, 0x59,
-	0x4e, 0x3e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x94, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x1f, 0x0c, 0x36, 0x5e, 0xd7, 0x27, 0x31, 0xa9,
-	0x58, 0x1f, 0x62, 0x95, 0x7e, 0x85, 0x3e, 0x4a, 0x58, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
-	0x81, 0x03, 0xc9, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x35, 0xab, 0x44, 0xb5, 0x01, 0x00,
-	0x00,
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcd, 0x4a, 0xfb, 0x40,
+	0x14, 0xc5, 0x33, 0x6d, 0xff, 0xfd, 0xe3, 0x54, 0xb0, 0x04, 0xc1, 0x98, 0xc5, 0x18, 0x5c, 0x48,
+	0xf0, 0x23, 0x81, 0xfa, 0x06, 0x6d, 0x51, 0x0a, 0x5d, 0x48, 0xeb, 0x4a, 0xc1, 0x70, 0xf3, 0x61,
+	0x1c, 0x4c, 0x3b, 0x21, 0x73, 0x0b, 0xf5, 0x2d, 0x7c, 0xac, 0x2e, 0xbb, 0x12, 0x57, 0x22, 0xed,
+	0x8b, 0x48, 0x33, 0x29, 0xb6, 0x56, 0xec, 0xee, 0xe6, 0xe6, 0x9c, 0xdf, 0x39, 0xc3, 0xa5, 0x26,
+	0x0f, 0x20, 0x80, 0x24, 0xf1, 0x21, 0x78, 0x96, 0x6e, 0x1c, 0x0d, 0x23, 0xc9, 0xa5, 0x93, 0x66,
+	0x02, 0x85, 0xce, 0x24, 0x66, 0x3c, 0x8c, 0x12, 0xf0, 0xa5, 0xa3, 0x46, 0x67, 0x55, 0x6d, 0xee,
+	0xc7, 0x22, 0x16, 0xb9, 0xd4, 0x5d, 0x4c, 0xca, 0x65, 0x1e, 0xae, 0x11, 0x53, 0xc8, 0x60, 0x50,
+	0x00, 0x4d, 0x6b, 0xed, 0xd7, 0x72, 0xf2, 0x42, 0x40, 0xf8, 0x55, 0xf1, 0x08, 0x3c, 0x89, 0x42,
+	0x8f, 0x07, 0xe0, 0xe1, 0x58, 0x29, 0x8e, 0xdf, 0x4a, 0x74, 0xf7, 0x5a, 0xd5, 0xec, 0x23, 0x60,
+	0xa4, 0xb7, 0x69, 0x55, 0x85, 0x18, 0xc4, 0x22, 0x76, 0xad, 0x71, 0xe2, 0xfc, 0x5d, 0xdb, 0xb9,
+	0xc9, 0xd5, 0xcd, 0xca, 0xe4, 0xe3, 0x48, 0xeb, 0x15, 0x5e, 0xfd, 0x80, 0xfe, 0x4f, 0x45, 0x86,
+	0x1e, 0x0f, 0x8d, 0x92, 0x45, 0xec, 0x9d, 0x5e, 0x75, 0xf1, 0xd9, 0x09, 0xf5, 0x07, 0x5a, 0x5f,
+	0x5a, 0xdb, 0x80, 0xd0, 0xe5, 0x12, 0x8d, 0xb2, 0x55, 0xb6, 0x6b, 0x8d, 0xf3, 0x6d, 0x41, 0xad,
+	0x15, 0x5f, 0x11, 0xb7, 0xc1, 0xd2, 0xef, 0xe9, 0x9e, 0x7a, 0x66, 0x27, 0x80, 0xdb, 0x71, 0x8e,
+	0xaf, 0xe4, 0xf8, 0xb3, 0x6d, 0xf8, 0xab, 0x6f, 0x5b, 0x41, 0xff, 0x49, 0xd2, 0x4f, 0x69, 0x7d,
+	0x65, 0xd5, 0x12, 0xa3, 0x21, 0x1a, 0xff, 0x2c, 0x62, 0x57, 0x7a, 0x1b, 0xfb, 0x66, 0x77, 0x32,
+	0x63, 0x64, 0x3a, 0x63, 0xe4, 0x73, 0xc6, 0xc8, 0xeb, 0x9c, 0x69, 0xd3, 0x39, 0xd3, 0xde, 0xe7,
+	0x4c, 0xbb, 0x6b, 0xc4, 0x1c, 0x9f, 0x46, 0xbe, 0x13, 0x88, 0x81, 0xdb, 0xcf, 0x8b, 0x5c, 0x74,
+	0xc1, 0x97, 0xae, 0x2a, 0xe5, 0x8e, 0xdd, 0xb5, 0xa3, 0xe1, 0x4b, 0x1a, 0x49, 0xbf, 0x9a, 0x5f,
+	0xeb, 0xf2, 0x6b, 0x00, 0x15, 0x92, 0x18, 0xa7, 0x60, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +151,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FailedIcaTxCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FailedIcaTxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FailedIcaTxList) > 0 {
+		for iNdEx := len(m.FailedIcaTxList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedIcaTxList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.CallbackDataList) > 0 {
 		for iNdEx := len(m.CallbackDataList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +233,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FailedIcaTxList) > 0 {
+		for _, e := range m.FailedIcaTxList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.FailedIcaTxCount != 0 {
+		n += 1 + sovGenesis(uint64(m.FailedIcaTxCount))
+	}
 	return n
 }
 
@@ -332,6 +379,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedIcaTxList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedIcaTxList = append(m.FailedIcaTxList, FailedIcaTx{})
+			if err := m.FailedIcaTxList[len(m.FailedIcaTxList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedIcaTxCount", wireType)
+			}
+			m.FailedIcaTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedIcaTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
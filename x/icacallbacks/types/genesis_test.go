@@ -22,6 +22,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				PortId: types.PortID,
 				CallbackDataList: []types.CallbackData{
 					{
@@ -31,6 +32,15 @@ func TestGenesisState_Validate(t *testing.T) {
 						CallbackKey: "1",
 					},
 				},
+				FailedIcaTxList: []types.FailedIcaTx{
+					{
+						Id: 0,
+					},
+					{
+						Id: 1,
+					},
+				},
+				FailedIcaTxCount: 2,
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -49,6 +59,33 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated failedIcaTx",
+			genState: &types.GenesisState{
+				FailedIcaTxList: []types.FailedIcaTx{
+					{
+						Id: 0,
+					},
+					{
+						Id: 0,
+					},
+				},
+				FailedIcaTxCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid failedIcaTx count",
+			genState: &types.GenesisState{
+				FailedIcaTxList: []types.FailedIcaTx{
+					{
+						Id: 1,
+					},
+				},
+				FailedIcaTxCount: 0,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
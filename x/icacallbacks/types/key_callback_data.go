@@ -4,11 +4,11 @@ import (
 	"encoding/binary"
 )
 
-var _ binary.ByteOrder
-
 const (
 	// CallbackDataKeyPrefix is the prefix to retrieve all CallbackData
 	CallbackDataKeyPrefix = "CallbackData/value/"
+	// CallbackDataExpiryKeyPrefix indexes CallbackData by expiration time
+	CallbackDataExpiryKeyPrefix = "CallbackData/expiry/"
 )
 
 // CallbackDataKey returns the store key to retrieve a CallbackData from the index fields
@@ -23,3 +23,10 @@ func CallbackDataKey(
 
 	return key
 }
+
+// CallbackDataExpiryKey returns the expiry index key of a CallbackData, ordered by expiration time
+func CallbackDataExpiryKey(expirationTimestamp uint64, callbackKey string) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, expirationTimestamp)
+	return append(key, CallbackDataKey(callbackKey)...)
+}
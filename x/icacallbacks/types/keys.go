@@ -37,3 +37,10 @@ func KeyPrefix(p string) []byte {
 func PacketID(portID string, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s.%s.%d", portID, channelID, sequence)
 }
+
+const (
+	FailedIcaTxKey      = "FailedIcaTx-value-"
+	FailedIcaTxCountKey = "FailedIcaTx-count-"
+	// FailedIcaTxPacketKey indexes failed txs by the callback key of the packet they were last sent in
+	FailedIcaTxPacketKey = "FailedIcaTx-packet-"
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgDropFailedIcaTx = "drop_failed_ica_tx"
+
+var _ sdk.Msg = &MsgDropFailedIcaTx{}
+
+func NewMsgDropFailedIcaTx(creator string, id uint64) *MsgDropFailedIcaTx {
+	return &MsgDropFailedIcaTx{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgDropFailedIcaTx) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDropFailedIcaTx) Type() string {
+	return TypeMsgDropFailedIcaTx
+}
+
+func (msg *MsgDropFailedIcaTx) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDropFailedIcaTx) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDropFailedIcaTx) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	return nil
+}
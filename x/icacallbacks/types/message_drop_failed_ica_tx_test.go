@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	cmdcfg "github.com/Stride-Labs/stride/cmd/strided/config"
+	"github.com/Stride-Labs/stride/testutil/sample"
+)
+
+func TestMsgDropFailedIcaTx_ValidateBasic(t *testing.T) {
+	cmdcfg.SetBech32Prefixes(sdk.GetConfig())
+	tests := []struct {
+		name string
+		msg  MsgDropFailedIcaTx
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDropFailedIcaTx{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "not admin address",
+			msg: MsgDropFailedIcaTx{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid message",
+			msg: MsgDropFailedIcaTx{
+				Creator: "stride1u20df3trc2c2zdhm8qvh2hdjx9ewh00sv6eyy8",
+				Id:      1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgRetryFailedIcaTx = "retry_failed_ica_tx"
+
+var _ sdk.Msg = &MsgRetryFailedIcaTx{}
+
+func NewMsgRetryFailedIcaTx(creator string, id uint64) *MsgRetryFailedIcaTx {
+	return &MsgRetryFailedIcaTx{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgRetryFailedIcaTx) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRetryFailedIcaTx) Type() string {
+	return TypeMsgRetryFailedIcaTx
+}
+
+func (msg *MsgRetryFailedIcaTx) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRetryFailedIcaTx) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRetryFailedIcaTx) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	return nil
+}
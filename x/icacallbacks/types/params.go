@@ -1,10 +1,31 @@
 package types
 
 import (
+	fmt "fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
+// Default init params
+var (
+	DefaultMaxRetryAttempts   uint64 = 5
+	DefaultRetryBackoffEpochs uint64 = 1
+	// one day
+	DefaultCallbackExpiryBuffer uint64 = 86400
+	// one year, which keeps the expiration in nanoseconds far from overflowing
+	MaxCallbackExpiryBuffer uint64 = 365 * 86400
+	// a week of 5 minute stride epochs
+	DefaultFailedTxRetentionEpochs uint64 = 2016
+	// a week of 5 minute stride epochs, which keeps the backoff far from overflowing once it's doubled after each attempt
+	MaxRetryBackoffEpochs uint64 = 2016
+
+	KeyMaxRetryAttempts        = []byte("MaxRetryAttempts")
+	KeyRetryBackoffEpochs      = []byte("RetryBackoffEpochs")
+	KeyCallbackExpiryBuffer    = []byte("CallbackExpiryBuffer")
+	KeyFailedTxRetentionEpochs = []byte("FailedTxRetentionEpochs")
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -13,23 +34,86 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(maxRetryAttempts uint64, retryBackoffEpochs uint64, callbackExpiryBuffer uint64, failedTxRetentionEpochs uint64) Params {
+	return Params{
+		MaxRetryAttempts:        maxRetryAttempts,
+		RetryBackoffEpochs:      retryBackoffEpochs,
+		CallbackExpiryBuffer:    callbackExpiryBuffer,
+		FailedTxRetentionEpochs: failedTxRetentionEpochs,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxRetryAttempts, DefaultRetryBackoffEpochs, DefaultCallbackExpiryBuffer, DefaultFailedTxRetentionEpochs)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxRetryAttempts, &p.MaxRetryAttempts, validateMaxRetryAttempts),
+		paramtypes.NewParamSetPair(KeyRetryBackoffEpochs, &p.RetryBackoffEpochs, validateRetryBackoffEpochs),
+		paramtypes.NewParamSetPair(KeyCallbackExpiryBuffer, &p.CallbackExpiryBuffer, validateCallbackExpiryBuffer),
+		paramtypes.NewParamSetPair(KeyFailedTxRetentionEpochs, &p.FailedTxRetentionEpochs, validateFailedTxRetentionEpochs),
+	}
+}
+
+func validateMaxRetryAttempts(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max retry attempts must be positive")
+	}
+	return nil
+}
+
+func validateRetryBackoffEpochs(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	if v == 0 || v > MaxRetryBackoffEpochs {
+		return fmt.Errorf("retry backoff must be between 1 and %d epochs, got %d", MaxRetryBackoffEpochs, v)
+	}
+	return nil
+}
+
+func validateFailedTxRetentionEpochs(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("failed tx retention must be positive")
+	}
+	return nil
+}
+
+func validateCallbackExpiryBuffer(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	if v > MaxCallbackExpiryBuffer {
+		return fmt.Errorf("callback expiry buffer cannot exceed %d seconds, got %d", MaxCallbackExpiryBuffer, v)
+	}
+	return nil
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateMaxRetryAttempts(p.MaxRetryAttempts); err != nil {
+		return err
+	}
+	if err := validateRetryBackoffEpochs(p.RetryBackoffEpochs); err != nil {
+		return err
+	}
+	if err := validateCallbackExpiryBuffer(p.CallbackExpiryBuffer); err != nil {
+		return err
+	}
+	return validateFailedTxRetentionEpochs(p.FailedTxRetentionEpochs)
 }
 
 // String implements the Stringer interface.
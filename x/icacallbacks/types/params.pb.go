@@ -25,6 +25,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// number of times a retryable tx is resubmitted before it is marked exhausted
+	MaxRetryAttempts uint64 `protobuf:"varint,1,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty" yaml:"max_retry_attempts"`
+	// stride epochs to wait before the first resubmission, doubled after each failed attempt
+	RetryBackoffEpochs uint64 `protobuf:"varint,2,opt,name=retry_backoff_epochs,json=retryBackoffEpochs,proto3" json:"retry_backoff_epochs,omitempty" yaml:"retry_backoff_epochs"`
+	// seconds after a packet's timeout (or after it was sent, if it only has a timeout height) that its callback data
+	// is kept waiting for the ack or timeout before it expires, at most a year
+	CallbackExpiryBuffer uint64 `protobuf:"varint,3,opt,name=callback_expiry_buffer,json=callbackExpiryBuffer,proto3" json:"callback_expiry_buffer,omitempty" yaml:"callback_expiry_buffer"`
+	// stride epochs that failed and exhausted txs are kept for an admin to force or drop, before they're pruned and
+	// released to their module
+	FailedTxRetentionEpochs uint64 `protobuf:"varint,4,opt,name=failed_tx_retention_epochs,json=failedTxRetentionEpochs,proto3" json:"failed_tx_retention_epochs,omitempty" yaml:"failed_tx_retention_epochs"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +69,34 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxRetryAttempts() uint64 {
+	if m != nil {
+		return m.MaxRetryAttempts
+	}
+	return 0
+}
+
+func (m *Params) GetRetryBackoffEpochs() uint64 {
+	if m != nil {
+		return m.RetryBackoffEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetCallbackExpiryBuffer() uint64 {
+	if m != nil {
+		return m.CallbackExpiryBuffer
+	}
+	return 0
+}
+
+func (m *Params) GetFailedTxRetentionEpochs() uint64 {
+	if m != nil {
+		return m.FailedTxRetentionEpochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "stridelabs.stride.icacallbacks.Params")
 }
@@ -66,18 +104,29 @@ func init() {
 func init() { proto.RegisterFile("icacallbacks/params.proto", fileDescriptor_087fed9a38a92fde) }
 
 var fileDescriptor_087fed9a38a92fde = []byte{
-	// 162 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x4c, 0x4e, 0x4c,
-	0x4e, 0xcc, 0xc9, 0x49, 0x4a, 0x4c, 0xce, 0x2e, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x2b, 0x2e, 0x29, 0xca, 0x4c, 0x49, 0xcd, 0x49, 0x4c,
-	0x2a, 0xd6, 0x83, 0x30, 0xf5, 0x90, 0x15, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x95, 0xea,
-	0x83, 0x58, 0x10, 0x5d, 0x4a, 0x7c, 0x5c, 0x6c, 0x01, 0x60, 0x53, 0xac, 0x58, 0x66, 0x2c, 0x90,
-	0x67, 0x70, 0xf2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xa3, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0x60, 0xb0, 0xf9, 0xba, 0x3e, 0x89,
-	0x49, 0xc5, 0xfa, 0x10, 0xbb, 0xf4, 0x2b, 0xf4, 0x51, 0x9c, 0x56, 0x52, 0x59, 0x90, 0x5a, 0x9c,
-	0xc4, 0x06, 0xb6, 0xc4, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xd5, 0x66, 0x00, 0xb7, 0x00,
-	0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4b, 0xf3, 0x40,
+	0x18, 0xc7, 0x93, 0xbe, 0xa5, 0x43, 0xa6, 0x97, 0xa3, 0x68, 0x5b, 0xe9, 0xc5, 0x06, 0x04, 0x17,
+	0x13, 0xd0, 0xad, 0x9b, 0x81, 0x4e, 0x76, 0xd0, 0x28, 0x08, 0x2e, 0xe1, 0x49, 0x7a, 0x69, 0x83,
+	0x49, 0x2f, 0xe4, 0xae, 0x90, 0x7e, 0x0b, 0x47, 0x47, 0x3f, 0x8e, 0x63, 0x47, 0xa7, 0x20, 0xed,
+	0xe8, 0x96, 0x4f, 0x20, 0x79, 0xce, 0x80, 0x62, 0xdd, 0x8e, 0xff, 0xf3, 0xbb, 0xdf, 0xdd, 0xc3,
+	0xdf, 0xe8, 0xc7, 0x21, 0x84, 0x90, 0x24, 0x01, 0x84, 0x8f, 0xc2, 0xc9, 0x20, 0x87, 0x54, 0xd8,
+	0x59, 0xce, 0x25, 0x27, 0x54, 0xc8, 0x3c, 0x9e, 0xb1, 0x04, 0x02, 0x61, 0xab, 0xa3, 0xfd, 0x1d,
+	0x1e, 0x74, 0xe7, 0x7c, 0xce, 0x11, 0x75, 0xea, 0x93, 0xba, 0x65, 0x7d, 0xb4, 0x8c, 0xce, 0x35,
+	0x6a, 0xc8, 0x95, 0x41, 0x52, 0x28, 0xfc, 0x9c, 0xc9, 0x7c, 0xed, 0x83, 0x94, 0x2c, 0xcd, 0xa4,
+	0xe8, 0xe9, 0xc7, 0xfa, 0x69, 0xdb, 0x1d, 0x56, 0xa5, 0xd9, 0x5f, 0x43, 0x9a, 0x8c, 0xad, 0xdf,
+	0x8c, 0xe5, 0xfd, 0x4f, 0xa1, 0xf0, 0xea, 0xec, 0xf2, 0x2b, 0x22, 0x37, 0x46, 0x57, 0x41, 0xf5,
+	0xe3, 0x3c, 0x8a, 0x7c, 0x96, 0xf1, 0x70, 0x21, 0x7a, 0x2d, 0xd4, 0x99, 0x55, 0x69, 0x1e, 0x29,
+	0xdd, 0x3e, 0xca, 0xf2, 0x08, 0xc6, 0xae, 0x4a, 0x27, 0x18, 0x92, 0x7b, 0xe3, 0xa0, 0xd9, 0xc6,
+	0x67, 0x45, 0x16, 0xd7, 0xd7, 0x56, 0x51, 0xc4, 0xf2, 0xde, 0x3f, 0x94, 0x8e, 0xaa, 0xd2, 0x1c,
+	0x2a, 0xe9, 0x7e, 0xce, 0xf2, 0xba, 0xcd, 0x60, 0x82, 0xb9, 0x8b, 0x31, 0x09, 0x8c, 0x41, 0x04,
+	0x71, 0xc2, 0x66, 0xbe, 0xc4, 0xd5, 0xd8, 0x52, 0xc6, 0x7c, 0xd9, 0xfc, 0xb8, 0x8d, 0xf2, 0x93,
+	0xaa, 0x34, 0x47, 0x4a, 0xfe, 0x37, 0x6b, 0x79, 0x87, 0x6a, 0x78, 0x57, 0x78, 0xcd, 0x48, 0x7d,
+	0x7e, 0xdc, 0x7e, 0x7e, 0x31, 0x35, 0x77, 0xfa, 0xba, 0xa5, 0xfa, 0x66, 0x4b, 0xf5, 0xf7, 0x2d,
+	0xd5, 0x9f, 0x76, 0x54, 0xdb, 0xec, 0xa8, 0xf6, 0xb6, 0xa3, 0xda, 0xc3, 0xf9, 0x3c, 0x96, 0x8b,
+	0x55, 0x60, 0x87, 0x3c, 0x75, 0x6e, 0xb1, 0xbd, 0xb3, 0x29, 0x04, 0xc2, 0x51, 0x4d, 0x3a, 0x85,
+	0xf3, 0xa3, 0x78, 0xb9, 0xce, 0x98, 0x08, 0x3a, 0x58, 0xe1, 0xc5, 0xe7, 0x00, 0x57, 0x48, 0xea,
+	0xcd, 0x15, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +149,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FailedTxRetentionEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FailedTxRetentionEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CallbackExpiryBuffer != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CallbackExpiryBuffer))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RetryBackoffEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryBackoffEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRetryAttempts != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetryAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +189,18 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxRetryAttempts != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetryAttempts))
+	}
+	if m.RetryBackoffEpochs != 0 {
+		n += 1 + sovParams(uint64(m.RetryBackoffEpochs))
+	}
+	if m.CallbackExpiryBuffer != 0 {
+		n += 1 + sovParams(uint64(m.CallbackExpiryBuffer))
+	}
+	if m.FailedTxRetentionEpochs != 0 {
+		n += 1 + sovParams(uint64(m.FailedTxRetentionEpochs))
+	}
 	return n
 }
 
@@ -158,6 +239,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryAttempts", wireType)
+			}
+			m.MaxRetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryAttempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffEpochs", wireType)
+			}
+			m.RetryBackoffEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoffEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackExpiryBuffer", wireType)
+			}
+			m.CallbackExpiryBuffer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackExpiryBuffer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedTxRetentionEpochs", wireType)
+			}
+			m.FailedTxRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedTxRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
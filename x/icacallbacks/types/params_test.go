@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/x/icacallbacks/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		params types.Params
+		valid  bool
+	}{
+		{
+			desc:   "default is valid",
+			params: types.DefaultParams(),
+			valid:  true,
+		},
+		{
+			desc:   "max expiry buffer is valid",
+			params: types.NewParams(3, 2, types.MaxCallbackExpiryBuffer, 1),
+			valid:  true,
+		},
+		{
+			desc:   "expiry buffer above the max",
+			params: types.NewParams(3, 2, types.MaxCallbackExpiryBuffer+1, 1),
+			valid:  false,
+		},
+		{
+			desc:   "no retry attempts",
+			params: types.NewParams(0, 2, types.DefaultCallbackExpiryBuffer, 1),
+			valid:  false,
+		},
+		{
+			desc:   "no retry backoff",
+			params: types.NewParams(3, 0, types.DefaultCallbackExpiryBuffer, 1),
+			valid:  false,
+		},
+		{
+			desc:   "max retry backoff is valid",
+			params: types.NewParams(3, types.MaxRetryBackoffEpochs, types.DefaultCallbackExpiryBuffer, 1),
+			valid:  true,
+		},
+		{
+			desc:   "retry backoff above the max",
+			params: types.NewParams(3, types.MaxRetryBackoffEpochs+1, types.DefaultCallbackExpiryBuffer, 1),
+			valid:  false,
+		},
+		{
+			desc:   "no failed tx retention",
+			params: types.NewParams(3, 2, types.DefaultCallbackExpiryBuffer, 0),
+			valid:  false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
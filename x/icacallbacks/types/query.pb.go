@@ -157,15 +157,88 @@ func (m *QueryGetCallbackDataRequest) GetCallbackKey() string {
 	return ""
 }
 
+// DecodedCallbackArgs holds the callbackArgs of a CallbackData decoded by the module that handles the callback
+type DecodedCallbackArgs struct {
+	CallbackKey string `protobuf:"bytes,1,opt,name=callbackKey,proto3" json:"callbackKey,omitempty"`
+	// proto type of the args, e.g. Stridelabs.stride.stakeibc.DelegateCallback
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// args as JSON, empty if they could not be decoded
+	Json string `protobuf:"bytes,3,opt,name=json,proto3" json:"json,omitempty"`
+	// reason the args could not be decoded
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *DecodedCallbackArgs) Reset()         { *m = DecodedCallbackArgs{} }
+func (m *DecodedCallbackArgs) String() string { return proto.CompactTextString(m) }
+func (*DecodedCallbackArgs) ProtoMessage()    {}
+func (*DecodedCallbackArgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5823c9776c03825e, []int{3}
+}
+func (m *DecodedCallbackArgs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedCallbackArgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedCallbackArgs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodedCallbackArgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedCallbackArgs.Merge(m, src)
+}
+func (m *DecodedCallbackArgs) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedCallbackArgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedCallbackArgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedCallbackArgs proto.InternalMessageInfo
+
+func (m *DecodedCallbackArgs) GetCallbackKey() string {
+	if m != nil {
+		return m.CallbackKey
+	}
+	return ""
+}
+
+func (m *DecodedCallbackArgs) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DecodedCallbackArgs) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
+func (m *DecodedCallbackArgs) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type QueryGetCallbackDataResponse struct {
-	CallbackData CallbackData `protobuf:"bytes,1,opt,name=callbackData,proto3" json:"callbackData"`
+	CallbackData        CallbackData        `protobuf:"bytes,1,opt,name=callbackData,proto3" json:"callbackData"`
+	DecodedCallbackArgs DecodedCallbackArgs `protobuf:"bytes,2,opt,name=decodedCallbackArgs,proto3" json:"decodedCallbackArgs"`
 }
 
 func (m *QueryGetCallbackDataResponse) Reset()         { *m = QueryGetCallbackDataResponse{} }
 func (m *QueryGetCallbackDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCallbackDataResponse) ProtoMessage()    {}
 func (*QueryGetCallbackDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5823c9776c03825e, []int{3}
+	return fileDescriptor_5823c9776c03825e, []int{4}
 }
 func (m *QueryGetCallbackDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,15 +274,26 @@ func (m *QueryGetCallbackDataResponse) GetCallbackData() CallbackData {
 	return CallbackData{}
 }
 
+func (m *QueryGetCallbackDataResponse) GetDecodedCallbackArgs() DecodedCallbackArgs {
+	if m != nil {
+		return m.DecodedCallbackArgs
+	}
+	return DecodedCallbackArgs{}
+}
+
 type QueryAllCallbackDataRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional filters, only callback data matching all of the set fields is returned
+	Module     string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	CallbackId string `protobuf:"bytes,3,opt,name=callbackId,proto3" json:"callbackId,omitempty"`
+	ChannelId  string `protobuf:"bytes,4,opt,name=channelId,proto3" json:"channelId,omitempty"`
 }
 
 func (m *QueryAllCallbackDataRequest) Reset()         { *m = QueryAllCallbackDataRequest{} }
 func (m *QueryAllCallbackDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCallbackDataRequest) ProtoMessage()    {}
 func (*QueryAllCallbackDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5823c9776c03825e, []int{4}
+	return fileDescriptor_5823c9776c03825e, []int{5}
 }
 func (m *QueryAllCallbackDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,16 +329,39 @@ func (m *QueryAllCallbackDataRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllCallbackDataRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *QueryAllCallbackDataRequest) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryAllCallbackDataRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 type QueryAllCallbackDataResponse struct {
 	CallbackData []CallbackData      `protobuf:"bytes,1,rep,name=callbackData,proto3" json:"callbackData"`
 	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// decoded args of each callback data, in the same order
+	DecodedCallbackArgs []DecodedCallbackArgs `protobuf:"bytes,3,rep,name=decodedCallbackArgs,proto3" json:"decodedCallbackArgs"`
 }
 
 func (m *QueryAllCallbackDataResponse) Reset()         { *m = QueryAllCallbackDataResponse{} }
 func (m *QueryAllCallbackDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCallbackDataResponse) ProtoMessage()    {}
 func (*QueryAllCallbackDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5823c9776c03825e, []int{5}
+	return fileDescriptor_5823c9776c03825e, []int{6}
 }
 func (m *QueryAllCallbackDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,52 +404,264 @@ func (m *QueryAllCallbackDataResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryAllCallbackDataResponse) GetDecodedCallbackArgs() []DecodedCallbackArgs {
+	if m != nil {
+		return m.DecodedCallbackArgs
+	}
+	return nil
+}
+
+type QueryGetFailedIcaTxRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetFailedIcaTxRequest) Reset()         { *m = QueryGetFailedIcaTxRequest{} }
+func (m *QueryGetFailedIcaTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFailedIcaTxRequest) ProtoMessage()    {}
+func (*QueryGetFailedIcaTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5823c9776c03825e, []int{7}
+}
+func (m *QueryGetFailedIcaTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFailedIcaTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFailedIcaTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFailedIcaTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFailedIcaTxRequest.Merge(m, src)
+}
+func (m *QueryGetFailedIcaTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFailedIcaTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFailedIcaTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFailedIcaTxRequest proto.InternalMessageInfo
+
+func (m *QueryGetFailedIcaTxRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryGetFailedIcaTxResponse struct {
+	FailedIcaTx FailedIcaTx `protobuf:"bytes,1,opt,name=failedIcaTx,proto3" json:"failedIcaTx"`
+}
+
+func (m *QueryGetFailedIcaTxResponse) Reset()         { *m = QueryGetFailedIcaTxResponse{} }
+func (m *QueryGetFailedIcaTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetFailedIcaTxResponse) ProtoMessage()    {}
+func (*QueryGetFailedIcaTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5823c9776c03825e, []int{8}
+}
+func (m *QueryGetFailedIcaTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetFailedIcaTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetFailedIcaTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetFailedIcaTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetFailedIcaTxResponse.Merge(m, src)
+}
+func (m *QueryGetFailedIcaTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetFailedIcaTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetFailedIcaTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetFailedIcaTxResponse proto.InternalMessageInfo
+
+func (m *QueryGetFailedIcaTxResponse) GetFailedIcaTx() FailedIcaTx {
+	if m != nil {
+		return m.FailedIcaTx
+	}
+	return FailedIcaTx{}
+}
+
+type QueryAllFailedIcaTxRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllFailedIcaTxRequest) Reset()         { *m = QueryAllFailedIcaTxRequest{} }
+func (m *QueryAllFailedIcaTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFailedIcaTxRequest) ProtoMessage()    {}
+func (*QueryAllFailedIcaTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5823c9776c03825e, []int{9}
+}
+func (m *QueryAllFailedIcaTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFailedIcaTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFailedIcaTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllFailedIcaTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFailedIcaTxRequest.Merge(m, src)
+}
+func (m *QueryAllFailedIcaTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFailedIcaTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFailedIcaTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFailedIcaTxRequest proto.InternalMessageInfo
+
+func (m *QueryAllFailedIcaTxRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllFailedIcaTxResponse struct {
+	FailedIcaTx []FailedIcaTx       `protobuf:"bytes,1,rep,name=failedIcaTx,proto3" json:"failedIcaTx"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllFailedIcaTxResponse) Reset()         { *m = QueryAllFailedIcaTxResponse{} }
+func (m *QueryAllFailedIcaTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllFailedIcaTxResponse) ProtoMessage()    {}
+func (*QueryAllFailedIcaTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5823c9776c03825e, []int{10}
+}
+func (m *QueryAllFailedIcaTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllFailedIcaTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllFailedIcaTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllFailedIcaTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllFailedIcaTxResponse.Merge(m, src)
+}
+func (m *QueryAllFailedIcaTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllFailedIcaTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllFailedIcaTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllFailedIcaTxResponse proto.InternalMessageInfo
+
+func (m *QueryAllFailedIcaTxResponse) GetFailedIcaTx() []FailedIcaTx {
+	if m != nil {
+		return m.FailedIcaTx
+	}
+	return nil
+}
+
+func (m *QueryAllFailedIcaTxResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stridelabs.stride.icacallbacks.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stridelabs.stride.icacallbacks.QueryParamsResponse")
 	proto.RegisterType((*QueryGetCallbackDataRequest)(nil), "stridelabs.stride.icacallbacks.QueryGetCallbackDataRequest")
+	proto.RegisterType((*DecodedCallbackArgs)(nil), "stridelabs.stride.icacallbacks.DecodedCallbackArgs")
 	proto.RegisterType((*QueryGetCallbackDataResponse)(nil), "stridelabs.stride.icacallbacks.QueryGetCallbackDataResponse")
 	proto.RegisterType((*QueryAllCallbackDataRequest)(nil), "stridelabs.stride.icacallbacks.QueryAllCallbackDataRequest")
 	proto.RegisterType((*QueryAllCallbackDataResponse)(nil), "stridelabs.stride.icacallbacks.QueryAllCallbackDataResponse")
+	proto.RegisterType((*QueryGetFailedIcaTxRequest)(nil), "stridelabs.stride.icacallbacks.QueryGetFailedIcaTxRequest")
+	proto.RegisterType((*QueryGetFailedIcaTxResponse)(nil), "stridelabs.stride.icacallbacks.QueryGetFailedIcaTxResponse")
+	proto.RegisterType((*QueryAllFailedIcaTxRequest)(nil), "stridelabs.stride.icacallbacks.QueryAllFailedIcaTxRequest")
+	proto.RegisterType((*QueryAllFailedIcaTxResponse)(nil), "stridelabs.stride.icacallbacks.QueryAllFailedIcaTxResponse")
 }
 
 func init() { proto.RegisterFile("icacallbacks/query.proto", fileDescriptor_5823c9776c03825e) }
 
 var fileDescriptor_5823c9776c03825e = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6a, 0x14, 0x31,
-	0x18, 0xc7, 0x37, 0xb5, 0x2e, 0x98, 0x16, 0x84, 0xd8, 0x43, 0x5d, 0xcb, 0xb8, 0xe4, 0x60, 0x55,
-	0x34, 0xb1, 0x5b, 0xf0, 0x62, 0x51, 0x5a, 0x17, 0x7b, 0xb0, 0x87, 0xba, 0x82, 0x07, 0x3d, 0xc8,
-	0x37, 0xd3, 0x30, 0x0e, 0x66, 0x27, 0xd3, 0x4d, 0xb6, 0xb8, 0x88, 0x17, 0x9f, 0x40, 0xf0, 0x0d,
-	0x7c, 0x11, 0x2f, 0x0a, 0xbd, 0x59, 0xf0, 0xe2, 0x49, 0x64, 0xd7, 0x07, 0x91, 0x4d, 0xb2, 0x9a,
-	0xa1, 0x53, 0x77, 0x2b, 0xde, 0x42, 0xe6, 0xfb, 0xff, 0xbf, 0xff, 0x2f, 0xf9, 0x32, 0x78, 0x39,
-	0x4b, 0x20, 0x01, 0x29, 0x63, 0x48, 0x5e, 0x6a, 0xbe, 0xdf, 0x17, 0xbd, 0x01, 0x2b, 0x7a, 0xca,
-	0x28, 0x12, 0x69, 0xd3, 0xcb, 0xf6, 0x84, 0x84, 0x58, 0x33, 0xb7, 0x64, 0x61, 0x6d, 0x63, 0x29,
-	0x55, 0xa9, 0xb2, 0xa5, 0x7c, 0xbc, 0x72, 0xaa, 0xc6, 0x4a, 0xaa, 0x54, 0x2a, 0x05, 0x87, 0x22,
-	0xe3, 0x90, 0xe7, 0xca, 0x80, 0xc9, 0x54, 0xae, 0xfd, 0xd7, 0xeb, 0x89, 0xd2, 0x5d, 0xa5, 0x79,
-	0x0c, 0x5a, 0xb8, 0x66, 0xfc, 0x60, 0x2d, 0x16, 0x06, 0xd6, 0x78, 0x01, 0x69, 0x96, 0xdb, 0x62,
-	0x5f, 0x7b, 0xb1, 0x94, 0xac, 0x80, 0x1e, 0x74, 0x27, 0x36, 0xcd, 0xd2, 0xa7, 0xc9, 0xea, 0xf9,
-	0x1e, 0x18, 0x70, 0x15, 0x74, 0x09, 0x93, 0x47, 0x63, 0xfb, 0x5d, 0x2b, 0xeb, 0x88, 0xfd, 0xbe,
-	0xd0, 0x86, 0x3e, 0xc3, 0x17, 0x4a, 0xbb, 0xba, 0x50, 0xb9, 0x16, 0xa4, 0x8d, 0xeb, 0xce, 0x7e,
-	0x19, 0x35, 0xd1, 0xd5, 0x85, 0xd6, 0x15, 0xf6, 0x77, 0x74, 0xe6, 0xf4, 0x5b, 0xf3, 0x87, 0xdf,
-	0x2f, 0xd7, 0x3a, 0x5e, 0x4b, 0xef, 0xe1, 0x4b, 0xd6, 0x7c, 0x5b, 0x98, 0xfb, 0xbe, 0xb2, 0x0d,
-	0x06, 0x7c, 0x6f, 0xd2, 0xc4, 0x0b, 0x13, 0x83, 0x87, 0x62, 0x60, 0x3b, 0x9d, 0xeb, 0x84, 0x5b,
-	0xf4, 0x00, 0xaf, 0x54, 0x1b, 0xf8, 0x98, 0x4f, 0xf0, 0x62, 0x12, 0xec, 0xfb, 0xb0, 0x37, 0xa6,
-	0x85, 0x0d, 0xbd, 0x7c, 0xe4, 0x92, 0x0f, 0x15, 0x3e, 0xf8, 0xa6, 0x94, 0x55, 0xc1, 0x1f, 0x60,
-	0xfc, 0xe7, 0x6e, 0x7e, 0x9f, 0x90, 0xbb, 0x48, 0x36, 0xbe, 0x48, 0xe6, 0xa6, 0xc6, 0x5f, 0x24,
-	0xdb, 0x85, 0x54, 0x78, 0x6d, 0x27, 0x50, 0xd2, 0x8f, 0xc8, 0xf3, 0x1d, 0xeb, 0x73, 0x22, 0xdf,
-	0x99, 0xff, 0xc1, 0x47, 0xb6, 0x4b, 0x00, 0x73, 0x16, 0x60, 0x75, 0x2a, 0x80, 0x0b, 0x15, 0x12,
-	0xb4, 0x3e, 0xcf, 0xe3, 0xb3, 0x96, 0x80, 0x7c, 0x40, 0xb8, 0xee, 0x86, 0x80, 0xb4, 0xa6, 0xe5,
-	0x3b, 0x3e, 0x87, 0x8d, 0xf5, 0x53, 0x69, 0x5c, 0x12, 0xca, 0xdf, 0x7e, 0xfd, 0xf9, 0x7e, 0xee,
-	0x1a, 0x59, 0xe5, 0x8f, 0xad, 0xe2, 0xe6, 0x0e, 0xc4, 0x9a, 0x3b, 0x35, 0xaf, 0x78, 0x2b, 0xe4,
-	0x0b, 0xc2, 0x8b, 0xe1, 0xe1, 0x90, 0x3b, 0x33, 0xb5, 0xad, 0x9e, 0xdf, 0xc6, 0xc6, 0xbf, 0x89,
-	0x7d, 0xf8, 0xb6, 0x0d, 0x7f, 0x97, 0x6c, 0x4c, 0x0d, 0x5f, 0x7a, 0xcd, 0xfc, 0x75, 0xf0, 0x40,
-	0xde, 0x90, 0x4f, 0x08, 0x9f, 0x0f, 0xed, 0x37, 0xa5, 0x9c, 0x11, 0xaa, 0x7a, 0xb6, 0x67, 0x84,
-	0x3a, 0x61, 0x60, 0xe9, 0x6d, 0x0b, 0x75, 0x8b, 0xb0, 0xd3, 0x41, 0x6d, 0xed, 0x1c, 0x0e, 0x23,
-	0x74, 0x34, 0x8c, 0xd0, 0x8f, 0x61, 0x84, 0xde, 0x8d, 0xa2, 0xda, 0xd1, 0x28, 0xaa, 0x7d, 0x1b,
-	0x45, 0xb5, 0xa7, 0xad, 0x34, 0x33, 0x2f, 0xfa, 0x31, 0x4b, 0x54, 0xb7, 0xca, 0xf3, 0x55, 0xd9,
-	0xd5, 0x0c, 0x0a, 0xa1, 0xe3, 0xba, 0xfd, 0xe3, 0xad, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xe9,
-	0xe0, 0xba, 0xfb, 0xca, 0x05, 0x00, 0x00,
+	// 780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x4f, 0xd4, 0x40,
+	0x14, 0xdf, 0xee, 0x2e, 0x6b, 0x78, 0x4b, 0x30, 0x19, 0x88, 0x59, 0x97, 0x4d, 0xdd, 0xf4, 0x20,
+	0xfe, 0xc1, 0x56, 0x76, 0xa3, 0x07, 0x20, 0x9a, 0xc5, 0x0d, 0x84, 0xc8, 0x01, 0x17, 0xe3, 0x41,
+	0x0f, 0x64, 0xb6, 0x1d, 0x4a, 0xa5, 0xdb, 0x59, 0xda, 0xae, 0x81, 0x10, 0x2e, 0x7e, 0x02, 0x13,
+	0xbf, 0x81, 0x67, 0xbf, 0x81, 0x27, 0xa3, 0x07, 0x6e, 0x90, 0x78, 0xf1, 0x64, 0x0c, 0xeb, 0x07,
+	0x31, 0x9d, 0x99, 0x4a, 0x1b, 0x8a, 0x5d, 0x56, 0xbc, 0x4d, 0x5f, 0xdf, 0xfb, 0xcd, 0xef, 0xf7,
+	0x7e, 0xaf, 0x33, 0x85, 0x92, 0xa5, 0x63, 0x1d, 0xdb, 0x76, 0x1b, 0xeb, 0xdb, 0x9e, 0xb6, 0xd3,
+	0x23, 0xee, 0x9e, 0xda, 0x75, 0xa9, 0x4f, 0x91, 0xec, 0xf9, 0xae, 0x65, 0x10, 0x1b, 0xb7, 0x3d,
+	0x95, 0x2f, 0xd5, 0x68, 0x6e, 0x79, 0xd2, 0xa4, 0x26, 0x65, 0xa9, 0x5a, 0xb0, 0xe2, 0x55, 0xe5,
+	0x8a, 0x49, 0xa9, 0x69, 0x13, 0x0d, 0x77, 0x2d, 0x0d, 0x3b, 0x0e, 0xf5, 0xb1, 0x6f, 0x51, 0xc7,
+	0x13, 0x6f, 0xef, 0xe8, 0xd4, 0xeb, 0x50, 0x4f, 0x6b, 0x63, 0x8f, 0xf0, 0xcd, 0xb4, 0x37, 0xb3,
+	0x6d, 0xe2, 0xe3, 0x59, 0xad, 0x8b, 0x4d, 0xcb, 0x61, 0xc9, 0x22, 0xf7, 0x7a, 0x8c, 0x59, 0x17,
+	0xbb, 0xb8, 0x13, 0xc2, 0x54, 0x63, 0xaf, 0xc2, 0xd5, 0x86, 0x81, 0x7d, 0x9c, 0x98, 0xb1, 0x89,
+	0x2d, 0x9b, 0x18, 0x1b, 0x96, 0x8e, 0x37, 0xfc, 0x5d, 0x9e, 0xa1, 0x4c, 0x02, 0x7a, 0x16, 0x10,
+	0x58, 0x63, 0xc0, 0x2d, 0xb2, 0xd3, 0x23, 0x9e, 0xaf, 0xbc, 0x82, 0x89, 0x58, 0xd4, 0xeb, 0x52,
+	0xc7, 0x23, 0xa8, 0x09, 0x05, 0x4e, 0xa0, 0x24, 0x55, 0xa5, 0x5b, 0xc5, 0xda, 0x4d, 0xf5, 0xef,
+	0xcd, 0x51, 0x79, 0xfd, 0x62, 0xfe, 0xf0, 0xc7, 0x8d, 0x4c, 0x4b, 0xd4, 0x2a, 0x8f, 0x61, 0x8a,
+	0x81, 0x2f, 0x13, 0xff, 0x89, 0xc8, 0x6c, 0x62, 0x1f, 0x8b, 0xbd, 0x51, 0x15, 0x8a, 0x21, 0xc0,
+	0x53, 0xb2, 0xc7, 0x76, 0x1a, 0x6d, 0x45, 0x43, 0x4a, 0x0f, 0x26, 0x9a, 0x44, 0xa7, 0x06, 0x31,
+	0xc2, 0xfa, 0x86, 0x6b, 0x7a, 0xe9, 0x85, 0x08, 0x41, 0xde, 0xdf, 0xeb, 0x92, 0x52, 0x96, 0xbd,
+	0x62, 0xeb, 0x20, 0xf6, 0xda, 0xa3, 0x4e, 0x29, 0xc7, 0x63, 0xc1, 0x1a, 0x4d, 0xc2, 0x08, 0x71,
+	0x5d, 0xea, 0x96, 0xf2, 0x2c, 0xc8, 0x1f, 0x94, 0xbe, 0x04, 0x95, 0x64, 0xe2, 0xa2, 0x3d, 0x2f,
+	0x60, 0x4c, 0x8f, 0xc4, 0x45, 0x93, 0x66, 0xd2, 0x9a, 0x14, 0xc5, 0x12, 0xad, 0x8a, 0xe1, 0xa0,
+	0x6d, 0x98, 0x30, 0xce, 0xea, 0x65, 0x2a, 0x8a, 0xb5, 0x7a, 0x1a, 0x7c, 0x42, 0xab, 0xc4, 0x2e,
+	0x49, 0xa8, 0xca, 0x27, 0x49, 0xd8, 0xd3, 0xb0, 0xed, 0x24, 0x7b, 0x96, 0x00, 0x4e, 0x67, 0xf4,
+	0xcf, 0x1c, 0xf0, 0x81, 0x56, 0x83, 0x81, 0x56, 0xf9, 0xd7, 0x23, 0x06, 0x5a, 0x5d, 0xc3, 0x26,
+	0x11, 0xb5, 0xad, 0x48, 0x25, 0xba, 0x06, 0x85, 0x0e, 0x35, 0x7a, 0x76, 0xe8, 0x86, 0x78, 0x42,
+	0x32, 0x40, 0xc8, 0x7d, 0xc5, 0x10, 0xae, 0x44, 0x22, 0xa8, 0x02, 0xa3, 0xfa, 0x16, 0x76, 0x1c,
+	0x62, 0xaf, 0x18, 0xc2, 0x9f, 0xd3, 0x80, 0xf2, 0x31, 0x0b, 0x95, 0x64, 0xf6, 0xe7, 0x7a, 0x94,
+	0xbb, 0x14, 0x8f, 0x96, 0x63, 0x6d, 0xe1, 0xd6, 0x4c, 0xa7, 0xb6, 0x85, 0x93, 0x8a, 0xf5, 0xe5,
+	0x1c, 0xb3, 0x73, 0xd5, 0xdc, 0x7f, 0x30, 0x7b, 0x06, 0xca, 0xe1, 0x44, 0x2f, 0xb1, 0xc3, 0x61,
+	0x45, 0xc7, 0xcf, 0x77, 0x43, 0xab, 0xc7, 0x21, 0x6b, 0x19, 0xcc, 0xe2, 0x7c, 0x2b, 0x6b, 0x19,
+	0x8a, 0x0b, 0x53, 0x89, 0xd9, 0xa2, 0xb5, 0xeb, 0x50, 0xdc, 0x3c, 0x0d, 0x8b, 0xd1, 0xb8, 0x9b,
+	0xc6, 0x38, 0x82, 0x24, 0x98, 0x46, 0x51, 0x14, 0x43, 0x30, 0x6c, 0xd8, 0x76, 0x02, 0xc3, 0x4b,
+	0x1a, 0xc6, 0xd8, 0xd0, 0x0f, 0x24, 0x2d, 0xf7, 0xef, 0xd2, 0x2e, 0x6d, 0x64, 0x6a, 0x47, 0x57,
+	0x60, 0x84, 0xb1, 0x47, 0x1f, 0x24, 0x28, 0xf0, 0x33, 0x17, 0xd5, 0xd2, 0xd8, 0x9d, 0x3d, 0xf6,
+	0xcb, 0xf5, 0x0b, 0xd5, 0x70, 0x26, 0x8a, 0xf6, 0xf6, 0xdb, 0xaf, 0xf7, 0xd9, 0xdb, 0x68, 0x5a,
+	0x5b, 0x67, 0x15, 0xf7, 0x56, 0x71, 0xdb, 0xd3, 0x78, 0xb5, 0x96, 0x70, 0x79, 0xa1, 0x23, 0x09,
+	0xc6, 0xa2, 0xdf, 0x13, 0x9a, 0x1f, 0x68, 0xdb, 0xe4, 0xeb, 0xa2, 0xbc, 0x30, 0x5c, 0xb1, 0x20,
+	0xdf, 0x64, 0xe4, 0x1f, 0xa1, 0x85, 0x54, 0xf2, 0xb1, 0xeb, 0x55, 0xdb, 0x8f, 0x5c, 0x2b, 0x07,
+	0xe8, 0xab, 0x04, 0x57, 0xa3, 0xf0, 0x0d, 0xdb, 0x1e, 0x50, 0x54, 0xf2, 0x21, 0x5b, 0x5e, 0x18,
+	0xae, 0x58, 0x88, 0x7a, 0xc8, 0x44, 0xdd, 0x47, 0xea, 0xc5, 0x44, 0xa1, 0x2f, 0x12, 0x14, 0x23,
+	0x33, 0x8b, 0xe6, 0x06, 0x6d, 0xed, 0xd9, 0x2f, 0xb3, 0x3c, 0x3f, 0x54, 0xad, 0x10, 0x30, 0xcf,
+	0x04, 0x3c, 0x40, 0xf5, 0x54, 0x01, 0xb1, 0x5f, 0x1a, 0x6d, 0xdf, 0x32, 0x0e, 0xd0, 0x67, 0x09,
+	0xc6, 0x23, 0xa0, 0x81, 0x17, 0x73, 0x83, 0xb6, 0x73, 0x68, 0x21, 0xc9, 0xe7, 0xc6, 0x05, 0x9c,
+	0x88, 0x09, 0x59, 0x5c, 0x3d, 0x3c, 0x91, 0xa5, 0xe3, 0x13, 0x59, 0xfa, 0x79, 0x22, 0x4b, 0xef,
+	0xfa, 0x72, 0xe6, 0xb8, 0x2f, 0x67, 0xbe, 0xf7, 0xe5, 0xcc, 0xcb, 0x9a, 0x69, 0xf9, 0x5b, 0xbd,
+	0xb6, 0xaa, 0xd3, 0x4e, 0x12, 0xe6, 0x6e, 0x1c, 0x35, 0xf8, 0xc3, 0xf1, 0xda, 0x05, 0xf6, 0xab,
+	0x57, 0xff, 0x3d, 0x00, 0x96, 0x2d, 0xea, 0x4e, 0xe5, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -363,6 +682,10 @@ type QueryClient interface {
 	CallbackData(ctx context.Context, in *QueryGetCallbackDataRequest, opts ...grpc.CallOption) (*QueryGetCallbackDataResponse, error)
 	// Queries a list of CallbackData items.
 	CallbackDataAll(ctx context.Context, in *QueryAllCallbackDataRequest, opts ...grpc.CallOption) (*QueryAllCallbackDataResponse, error)
+	// Queries a FailedIcaTx by id.
+	FailedIcaTx(ctx context.Context, in *QueryGetFailedIcaTxRequest, opts ...grpc.CallOption) (*QueryGetFailedIcaTxResponse, error)
+	// Queries a list of FailedIcaTx items.
+	FailedIcaTxAll(ctx context.Context, in *QueryAllFailedIcaTxRequest, opts ...grpc.CallOption) (*QueryAllFailedIcaTxResponse, error)
 }
 
 type queryClient struct {
@@ -400,6 +723,24 @@ func (c *queryClient) CallbackDataAll(ctx context.Context, in *QueryAllCallbackD
 	return out, nil
 }
 
+func (c *queryClient) FailedIcaTx(ctx context.Context, in *QueryGetFailedIcaTxRequest, opts ...grpc.CallOption) (*QueryGetFailedIcaTxResponse, error) {
+	out := new(QueryGetFailedIcaTxResponse)
+	err := c.cc.Invoke(ctx, "/stridelabs.stride.icacallbacks.Query/FailedIcaTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedIcaTxAll(ctx context.Context, in *QueryAllFailedIcaTxRequest, opts ...grpc.CallOption) (*QueryAllFailedIcaTxResponse, error) {
+	out := new(QueryAllFailedIcaTxResponse)
+	err := c.cc.Invoke(ctx, "/stridelabs.stride.icacallbacks.Query/FailedIcaTxAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -408,6 +749,10 @@ type QueryServer interface {
 	CallbackData(context.Context, *QueryGetCallbackDataRequest) (*QueryGetCallbackDataResponse, error)
 	// Queries a list of CallbackData items.
 	CallbackDataAll(context.Context, *QueryAllCallbackDataRequest) (*QueryAllCallbackDataResponse, error)
+	// Queries a FailedIcaTx by id.
+	FailedIcaTx(context.Context, *QueryGetFailedIcaTxRequest) (*QueryGetFailedIcaTxResponse, error)
+	// Queries a list of FailedIcaTx items.
+	FailedIcaTxAll(context.Context, *QueryAllFailedIcaTxRequest) (*QueryAllFailedIcaTxResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -423,6 +768,12 @@ func (*UnimplementedQueryServer) CallbackData(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) CallbackDataAll(ctx context.Context, req *QueryAllCallbackDataRequest) (*QueryAllCallbackDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CallbackDataAll not implemented")
 }
+func (*UnimplementedQueryServer) FailedIcaTx(ctx context.Context, req *QueryGetFailedIcaTxRequest) (*QueryGetFailedIcaTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedIcaTx not implemented")
+}
+func (*UnimplementedQueryServer) FailedIcaTxAll(ctx context.Context, req *QueryAllFailedIcaTxRequest) (*QueryAllFailedIcaTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedIcaTxAll not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -482,6 +833,42 @@ func _Query_CallbackDataAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FailedIcaTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetFailedIcaTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedIcaTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stridelabs.stride.icacallbacks.Query/FailedIcaTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedIcaTx(ctx, req.(*QueryGetFailedIcaTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedIcaTxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllFailedIcaTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedIcaTxAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stridelabs.stride.icacallbacks.Query/FailedIcaTxAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedIcaTxAll(ctx, req.(*QueryAllFailedIcaTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stridelabs.stride.icacallbacks.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -498,6 +885,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CallbackDataAll",
 			Handler:    _Query_CallbackDataAll_Handler,
 		},
+		{
+			MethodName: "FailedIcaTx",
+			Handler:    _Query_FailedIcaTx_Handler,
+		},
+		{
+			MethodName: "FailedIcaTxAll",
+			Handler:    _Query_FailedIcaTxAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "icacallbacks/query.proto",
@@ -589,7 +984,7 @@ func (m *QueryGetCallbackDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCallbackDataResponse) Marshal() (dAtA []byte, err error) {
+func (m *DecodedCallbackArgs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -599,30 +994,48 @@ func (m *QueryGetCallbackDataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCallbackDataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecodedCallbackArgs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DecodedCallbackArgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.CallbackData.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CallbackKey) > 0 {
+		i -= len(m.CallbackKey)
+		copy(dAtA[i:], m.CallbackKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCallbackDataRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCallbackDataResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -632,16 +1045,80 @@ func (m *QueryAllCallbackDataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCallbackDataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCallbackDataResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCallbackDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.DecodedCallbackArgs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CallbackData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllCallbackDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCallbackDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCallbackDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -677,6 +1154,20 @@ func (m *QueryAllCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.DecodedCallbackArgs) > 0 {
+		for iNdEx := len(m.DecodedCallbackArgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedCallbackArgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -706,6 +1197,151 @@ func (m *QueryAllCallbackDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetFailedIcaTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFailedIcaTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFailedIcaTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetFailedIcaTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetFailedIcaTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetFailedIcaTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FailedIcaTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFailedIcaTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFailedIcaTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFailedIcaTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllFailedIcaTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllFailedIcaTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllFailedIcaTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedIcaTx) > 0 {
+		for iNdEx := len(m.FailedIcaTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedIcaTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -750,6 +1386,31 @@ func (m *QueryGetCallbackDataRequest) Size() (n int) {
 	return n
 }
 
+func (m *DecodedCallbackArgs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CallbackKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryGetCallbackDataResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -758,6 +1419,8 @@ func (m *QueryGetCallbackDataResponse) Size() (n int) {
 	_ = l
 	l = m.CallbackData.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = m.DecodedCallbackArgs.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -771,6 +1434,18 @@ func (m *QueryAllCallbackDataRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -790,16 +1465,586 @@ func (m *QueryAllCallbackDataResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	if len(m.DecodedCallbackArgs) > 0 {
+		for _, e := range m.DecodedCallbackArgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetFailedIcaTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetFailedIcaTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FailedIcaTx.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllFailedIcaTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllFailedIcaTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedIcaTx) > 0 {
+		for _, e := range m.FailedIcaTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCallbackDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedCallbackArgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedCallbackArgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedCallbackArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Json = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCallbackDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCallbackArgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecodedCallbackArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -822,12 +2067,144 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCallbackDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -849,7 +2226,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -872,15 +2249,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCallbackDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -907,7 +2284,78 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CallbackData = append(m.CallbackData, CallbackData{})
+			if err := m.CallbackData[len(m.CallbackData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedCallbackArgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodedCallbackArgs = append(m.DecodedCallbackArgs, DecodedCallbackArgs{})
+			if err := m.DecodedCallbackArgs[len(m.DecodedCallbackArgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -932,7 +2380,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFailedIcaTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -955,17 +2403,17 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCallbackDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFailedIcaTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFailedIcaTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -975,24 +2423,11 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CallbackKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1014,7 +2449,7 @@ func (m *QueryGetCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetFailedIcaTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1037,15 +2472,15 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCallbackDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetFailedIcaTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetFailedIcaTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedIcaTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1072,7 +2507,7 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CallbackData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailedIcaTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1097,7 +2532,7 @@ func (m *QueryGetCallbackDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFailedIcaTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,10 +2555,10 @@ func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCallbackDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFailedIcaTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCallbackDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFailedIcaTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1183,7 +2618,7 @@ func (m *QueryAllCallbackDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllFailedIcaTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1206,15 +2641,15 @@ func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCallbackDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllFailedIcaTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCallbackDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllFailedIcaTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedIcaTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1241,8 +2676,8 @@ func (m *QueryAllCallbackDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackData = append(m.CallbackData, CallbackData{})
-			if err := m.CallbackData[len(m.CallbackData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedIcaTx = append(m.FailedIcaTx, FailedIcaTx{})
+			if err := m.FailedIcaTx[len(m.FailedIcaTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,70 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+// QueryResponseDecorator rejects duplicate and invalid interchain query responses before any state changes, so
+// relayers racing to answer the same query don't pay for responses that would be ignored. Txs that only contain
+// first valid responses to pending queries have their fees refunded by the msg server once all the responses are
+// accepted. It must run after the fees are deducted and the signatures verified, so that a tx that isn't authorized by
+// its fee payer can't record a refund
+type QueryResponseDecorator struct {
+	k keeper.Keeper
+}
+
+func NewQueryResponseDecorator(k keeper.Keeper) QueryResponseDecorator {
+	return QueryResponseDecorator{k: k}
+}
+
+func (d QueryResponseDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	responses := []*types.MsgSubmitQueryResponse{}
+	for _, msg := range msgs {
+		if response, ok := msg.(*types.MsgSubmitQueryResponse); ok {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	answered := map[string]bool{}
+	for _, response := range responses {
+		if answered[response.QueryId] {
+			return ctx, sdkerrors.Wrapf(types.ErrAlreadyFulfilled, "query %s is answered more than once in the tx", response.QueryId)
+		}
+		answered[response.QueryId] = true
+
+		query, found := d.k.GetQuery(ctx, response.QueryId)
+		if !found || query.Answered {
+			return ctx, sdkerrors.Wrapf(types.ErrAlreadyFulfilled, "query %s is not pending", response.QueryId)
+		}
+		if err := d.k.VerifyQueryResponse(ctx, query, response); err != nil {
+			return ctx, err
+		}
+	}
+
+	// relayers pay the fees upfront, so that the mempool's min gas price still applies, and get them back once
+	// their responses are accepted
+	if len(responses) == len(msgs) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+		fee := feeTx.GetFee()
+		if !fee.IsZero() {
+			feePayer := feeTx.FeePayer()
+			if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+				feePayer = feeGranter
+			}
+			d.k.SetResponseFeeRefund(ctx, feePayer, fee, len(responses))
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
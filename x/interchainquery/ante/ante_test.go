@@ -0,0 +1,176 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	strideapp "github.com/Stride-Labs/stride/app"
+	"github.com/Stride-Labs/stride/app/apptesting"
+	cmdcfg "github.com/Stride-Labs/stride/cmd/strided/config"
+	"github.com/Stride-Labs/stride/x/interchainquery/ante"
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+var fee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+
+type AnteTestSuite struct {
+	apptesting.AppTestHelper
+	path *ibctesting.Path
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+func (s *AnteTestSuite) SetupTest() {
+	cmdcfg.SetBech32Prefixes(sdk.GetConfig())
+	s.SetupIBCChains()
+	s.path = ibctesting.NewPath(s.StrideChain, s.HostChain)
+	s.Coordinator.SetupConnections(s.path)
+}
+
+// emitResponse registers and emits a balance query, then returns a valid response to it
+func (s *AnteTestSuite) emitResponse() *types.MsgSubmitQueryResponse {
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+	request := types.NewBalanceRequest(s.HostChain.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	err := k.MakeRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID, types.BANK_STORE_QUERY_WITH_PROOF,
+		request, sdk.NewInt(-1), "", "", 0, 0, types.TimeoutPolicy{})
+	s.Require().NoError(err)
+	k.EndBlocker(ctx)
+
+	s.Coordinator.CommitBlock(s.HostChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+
+	height := s.HostChain.LastHeader.Header.Height - 1
+	res := s.HostChain.App.Query(abci.RequestQuery{Path: "store/bank/key", Data: request, Height: height, Prove: true})
+	s.Require().Zero(res.Code, res.Log)
+	return &types.MsgSubmitQueryResponse{
+		ChainId: s.HostChain.ChainID,
+		QueryId: keeper.GenerateQueryHash(s.path.EndpointA.ConnectionID, s.HostChain.ChainID,
+			types.BANK_STORE_QUERY_WITH_PROOF, request, "", 0),
+		Result:      res.Value,
+		ProofOps:    res.ProofOps,
+		Height:      height,
+		FromAddress: s.StrideChain.SenderAccount.GetAddress().String(),
+	}
+}
+
+// runDecorator deducts the tx fee from the relayer, like the fee decorator that runs before it, then runs the
+// query response decorator
+func (s *AnteTestSuite) runDecorator(ctx sdk.Context, msgs ...sdk.Msg) error {
+	builder := s.StrideChain.TxConfig.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(msgs...))
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(1000000)
+
+	relayer := s.StrideChain.SenderAccount.GetAddress()
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromAccountToModule(ctx, relayer, authtypes.FeeCollectorName, fee))
+
+	decorator := ante.NewQueryResponseDecorator(s.App.InterchainqueryKeeper)
+	_, err := decorator.AnteHandle(ctx, builder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	return err
+}
+
+func (s *AnteTestSuite) TestFirstValidResponseRefunded() {
+	response := s.emitResponse()
+	ctx := s.StrideChain.GetContext()
+	relayer := s.StrideChain.SenderAccount.GetAddress()
+	balanceBefore := s.App.BankKeeper.GetBalance(ctx, relayer, sdk.DefaultBondDenom)
+
+	s.Require().NoError(s.runDecorator(ctx, response))
+	s.Require().Equal(balanceBefore.Sub(fee[0]), s.App.BankKeeper.GetBalance(ctx, relayer, sdk.DefaultBondDenom), "fee kept until the response is accepted")
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	_, err := msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().NoError(err)
+	s.Require().Equal(balanceBefore, s.App.BankKeeper.GetBalance(ctx, relayer, sdk.DefaultBondDenom), "fee refunded")
+}
+
+func (s *AnteTestSuite) TestMixedTxNotRefunded() {
+	response := s.emitResponse()
+	ctx := s.StrideChain.GetContext()
+	relayer := s.StrideChain.SenderAccount.GetAddress()
+	balanceBefore := s.App.BankKeeper.GetBalance(ctx, relayer, sdk.DefaultBondDenom)
+
+	send := banktypes.NewMsgSend(relayer, s.StrideChain.SenderAccounts[1].SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	s.Require().NoError(s.runDecorator(ctx, response, send))
+	s.Require().Equal(balanceBefore.Sub(fee[0]), s.App.BankKeeper.GetBalance(ctx, relayer, sdk.DefaultBondDenom), "fee kept")
+}
+
+func (s *AnteTestSuite) TestDuplicateResponsesRejected() {
+	response := s.emitResponse()
+	ctx := s.StrideChain.GetContext()
+
+	// answered twice in the same tx
+	err := s.runDecorator(ctx, response, response)
+	s.Require().ErrorIs(err, types.ErrAlreadyFulfilled)
+
+	// answered after the query was fulfilled
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	_, err = msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().NoError(err)
+	err = s.runDecorator(ctx, response)
+	s.Require().ErrorIs(err, types.ErrAlreadyFulfilled)
+}
+
+func (s *AnteTestSuite) TestInvalidResponseRejected() {
+	response := s.emitResponse()
+	response.Result = []byte("forged")
+
+	err := s.runDecorator(s.StrideChain.GetContext(), response)
+	s.Require().ErrorContains(err, "unable to verify proof")
+}
+
+func (s *AnteTestSuite) TestBadlySignedTxNotRefunded() {
+	response := s.emitResponse()
+	ctx := s.StrideChain.GetContext()
+	relayer := s.StrideChain.SenderAccount
+	balanceBefore := s.App.BankKeeper.GetBalance(ctx, relayer.GetAddress(), sdk.DefaultBondDenom)
+
+	anteHandler, err := strideapp.NewAnteHandler(strideapp.HandlerOptions{
+		HandlerOptions: authante.HandlerOptions{
+			AccountKeeper:   s.App.AccountKeeper,
+			BankKeeper:      s.App.BankKeeper,
+			SignModeHandler: s.StrideChain.TxConfig.SignModeHandler(),
+			FeegrantKeeper:  s.App.FeeGrantKeeper,
+		},
+		InterchainqueryKeeper: &s.App.InterchainqueryKeeper,
+	})
+	s.Require().NoError(err)
+
+	builder := s.StrideChain.TxConfig.NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(response))
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(1000000)
+	s.Require().NoError(builder.SetSignatures(signing.SignatureV2{
+		PubKey:   relayer.GetPubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("forged")},
+		Sequence: relayer.GetSequence(),
+	}))
+	txBytes, err := s.StrideChain.TxConfig.TxEncoder()(builder.GetTx())
+	s.Require().NoError(err)
+	ctx = ctx.WithTxBytes(txBytes)
+
+	// the fee is deducted before the signature is verified, but no refund is recorded for the tx
+	_, err = anteHandler(ctx, builder.GetTx(), false)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().Equal(balanceBefore.Sub(fee[0]), s.App.BankKeeper.GetBalance(ctx, relayer.GetAddress(), sdk.DefaultBondDenom), "fee deducted")
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	_, err = msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().NoError(err)
+	s.Require().Equal(balanceBefore.Sub(fee[0]), s.App.BankKeeper.GetBalance(ctx, relayer.GetAddress(), sdk.DefaultBondDenom), "fee not refunded")
+}
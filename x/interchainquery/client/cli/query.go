@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group interchainquery queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdListQueries())
+	cmd.AddCommand(CmdShowQuery())
+	cmd.AddCommand(CmdListDataPoints())
+	cmd.AddCommand(CmdShowDataPoint())
+	cmd.AddCommand(CmdListCallbackFailures())
+	cmd.AddCommand(CmdQueryParams())
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+func CmdListDataPoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-datapoints",
+		Short: "list all stored query results",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			params := &types.QueryDataPointsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DataPoints(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDataPoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-datapoint [query-id]",
+		Short: "shows the stored result of a query",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			params := &types.QueryDataPointRequest{
+				Id: args[0],
+			}
+
+			res, err := queryClient.DataPoint(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
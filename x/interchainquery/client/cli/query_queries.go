@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+const (
+	// not --chain-id, which is the chain the cli connects to
+	FlagHostChainId  = "host-chain-id"
+	FlagConnectionId = "connection-id"
+)
+
+func CmdListQueries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-queries",
+		Short: "list all interchain queries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			chainId, err := cmd.Flags().GetString(FlagHostChainId)
+			if err != nil {
+				return err
+			}
+			connectionId, err := cmd.Flags().GetString(FlagConnectionId)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			params := &types.QueryQueriesRequest{
+				Pagination:   pageReq,
+				ChainId:      chainId,
+				ConnectionId: connectionId,
+			}
+
+			res, err := queryClient.Queries(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagHostChainId, "", "only list queries to this chain")
+	cmd.Flags().String(FlagConnectionId, "", "only list queries sent over this connection")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-query [id]",
+		Short: "shows an interchain query",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			params := &types.QueryQueryRequest{
+				Id: args[0],
+			}
+
+			res, err := queryClient.Query(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,16 +10,33 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
 	// set registered zones info from genesis
 	for _, query := range genState.Queries {
-		// Initialize empty epoch values via Cosmos SDK
+		// block heights restart after a zero height export, so queries emitted ahead of the chain are emitted again
+		if query.LastHeight.GT(sdk.NewInt(ctx.BlockHeight())) {
+			query.LastHeight = sdk.ZeroInt()
+		}
 		k.SetQuery(ctx, query)
 	}
+	for _, datapoint := range genState.Datapoints {
+		// and datapoints stored ahead of the chain expire ttl blocks from now
+		if datapoint.LocalHeight.GT(sdk.NewInt(ctx.BlockHeight())) {
+			datapoint.LocalHeight = sdk.NewInt(ctx.BlockHeight())
+		}
+		k.SetDatapoint(ctx, datapoint)
+	}
+	for _, failure := range genState.CallbackFailures {
+		k.SetCallbackFailure(ctx, failure)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Queries: k.AllQueries(ctx),
+		Queries:          k.AllQueries(ctx),
+		Params:           k.GetParams(ctx),
+		Datapoints:       k.AllDatapoints(ctx),
+		CallbackFailures: k.AllCallbackFailures(ctx),
 	}
 }
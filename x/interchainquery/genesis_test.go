@@ -0,0 +1,94 @@
+package interchainquery_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+func TestGenesis(t *testing.T) {
+	genesisState := types.GenesisState{
+		Params: types.NewParams(types.DefaultMaxResponseAge, types.DefaultCallbackGasLimit,
+			[]types.CallbackGasLimit{{Module: "stakeibc", GasLimit: 1_000_000}}),
+		Queries: []types.Query{
+			{
+				Id:           "0",
+				ConnectionId: "connection-0",
+				ChainId:      "GAIA",
+				QueryType:    types.BANK_STORE_QUERY_WITH_PROOF,
+				Request:      []byte{2, 1},
+				Period:       sdk.NewInt(-1),
+				LastHeight:   sdk.NewInt(1),
+				CallbackId:   "withdrawalbalance",
+				Ttl:          10,
+			},
+			{
+				Id:            "1",
+				ConnectionId:  "connection-1",
+				ChainId:       "OSMO",
+				QueryType:     types.STAKING_STORE_QUERY_WITH_PROOF,
+				BatchRequests: [][]byte{{0x21, 1}, {0x31, 1}},
+				Period:        sdk.NewInt(10),
+				LastHeight:    sdk.ZeroInt(),
+			},
+		},
+		Datapoints: []types.DataPoint{
+			{
+				Id:           "0",
+				RemoteHeight: sdk.NewInt(100),
+				LocalHeight:  sdk.NewInt(1),
+				Value:        []byte{1, 2, 3},
+			},
+		},
+		CallbackFailures: []types.CallbackFailure{
+			{
+				QueryId:    "2",
+				ChainId:    "GAIA",
+				Module:     "stakeibc",
+				CallbackId: "delegation",
+				Error:      "callback failed",
+				Height:     1,
+			},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.InterchainqueryKeeper(t)
+	interchainquery.InitGenesis(ctx, *k, genesisState)
+	got := interchainquery.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+
+	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.Queries, got.Queries)
+	require.ElementsMatch(t, genesisState.Datapoints, got.Datapoints)
+	require.ElementsMatch(t, genesisState.CallbackFailures, got.CallbackFailures)
+}
+
+func TestGenesisZeroHeight(t *testing.T) {
+	// state exported at a later height, imported at height 1
+	genesisState := types.DefaultGenesis()
+	genesisState.Queries = []types.Query{{
+		Id:           "0",
+		ConnectionId: "connection-0",
+		ChainId:      "GAIA",
+		Request:      []byte{1},
+		Period:       sdk.NewInt(10),
+		LastHeight:   sdk.NewInt(1000),
+	}}
+	genesisState.Datapoints = []types.DataPoint{{Id: "0", RemoteHeight: sdk.NewInt(100), LocalHeight: sdk.NewInt(1000)}}
+
+	k, ctx := keepertest.InterchainqueryKeeper(t)
+	interchainquery.InitGenesis(ctx, *k, *genesisState)
+
+	query, found := k.GetQuery(ctx, "0")
+	require.True(t, found)
+	require.True(t, query.LastHeight.IsZero(), "query emitted again")
+	datapoint, err := k.GetDatapointForId(ctx, "0")
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), datapoint.LocalHeight.Int64(), "datapoint expires ttl blocks from now")
+}
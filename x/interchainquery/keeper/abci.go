@@ -3,8 +3,11 @@ package keeper
 import (
 	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	metrics "github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cast"
@@ -17,6 +20,9 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	_ = k.Logger(ctx)
 	events := sdk.Events{}
+	// retry or fail one-shot queries that weren't answered in time, before emitting
+	k.HandleQueryTimeouts(ctx)
+
 	// emit events for periodic queries
 	k.IterateQueries(ctx, func(_ int64, queryInfo types.Query) (stop bool) {
 		if queryInfo.LastHeight.Equal(sdk.ZeroInt()) || queryInfo.LastHeight.Add(queryInfo.Period).Equal(sdk.NewInt(ctx.BlockHeight())) {
@@ -29,13 +35,38 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 				sdk.NewAttribute(types.AttributeKeyChainId, queryInfo.ChainId),
 				sdk.NewAttribute(types.AttributeKeyConnectionId, queryInfo.ConnectionId),
 				sdk.NewAttribute(types.AttributeKeyType, queryInfo.QueryType),
-				// TODO: add height to request type
-				sdk.NewAttribute(types.AttributeKeyHeight, "0"),
+				// 0 tells the relayer to query the latest height
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(queryInfo.Height, 10)),
 				sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(queryInfo.Request)),
 			)
+			if queryInfo.IsBatch() {
+				// the keys of a batched query, to be answered with a result and proof each at the same height
+				requests := make([]string, len(queryInfo.BatchRequests))
+				for i, request := range queryInfo.BatchRequests {
+					requests[i] = hex.EncodeToString(request)
+				}
+				event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRequests, strings.Join(requests, ",")))
+			}
 
 			events = append(events, event)
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, types.MetricKeyQueryEmitted},
+				1,
+				[]metrics.Label{
+					telemetry.NewLabel(types.MetricLabelChainId, queryInfo.ChainId),
+					telemetry.NewLabel(types.MetricLabelCallbackId, queryInfo.CallbackId),
+				},
+			)
 			queryInfo.LastHeight = sdk.NewInt(ctx.BlockHeight())
+			queryInfo.Answered = false
+			// record where the host was, so that responses from before the query was emitted can be rejected. If the
+			// light client can't be read, the host time is recorded by the timeout check once it can be
+			hostHeight, hostTimestamp, err := k.GetLatestHostHeight(ctx, queryInfo.ConnectionId)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("unable to get latest host height for query %s: %s", queryInfo.Id, err.Error()))
+			}
+			queryInfo.EmittedHostHeight = hostHeight
+			queryInfo.EmittedHostTimestamp = hostTimestamp
 			k.SetQuery(ctx, queryInfo)
 
 		}
@@ -57,12 +88,16 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 				k.Logger(ctx).Error(fmt.Sprintf("Error casting ttl to int64, err: %s", err.Error()))
 				return false
 			}
-			lh, err := cast.ToInt64E(dp.LocalHeight)
-			if err != nil {
-				k.Logger(ctx).Error(fmt.Sprintf("Error casting local height to int64, err: %s", err.Error()))
-				return false
-			}
-			if lh+ttl > ctx.BlockHeader().Height {
+			lh := dp.LocalHeight.Int64()
+			telemetry.SetGaugeWithLabels(
+				[]string{types.ModuleName, types.MetricKeyDatapointAge},
+				float32(ctx.BlockHeight()-lh),
+				[]metrics.Label{
+					telemetry.NewLabel(types.MetricLabelChainId, q.ChainId),
+					telemetry.NewLabel(types.MetricLabelCallbackId, q.CallbackId),
+				},
+			)
+			if lh+ttl < ctx.BlockHeader().Height {
 				// gc old data
 				k.DeleteDatapoint(ctx, dp.Id)
 			}
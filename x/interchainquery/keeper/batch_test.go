@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+// batchRequests returns the keys of the host chain sender's balance, which is set, and of a balance that isn't
+func (s *MsgServerTestSuite) batchRequests() [][]byte {
+	address := s.HostChain.SenderAccount.GetAddress()
+	return [][]byte{
+		types.NewBalanceRequest(address, sdk.DefaultBondDenom),
+		types.NewBalanceRequest(address, "unheld"),
+	}
+}
+
+// makeBatchQuery registers a batched balance query pinned to the given host height
+func (s *MsgServerTestSuite) makeBatchQuery(height int64) types.Query {
+	ctx := s.StrideChain.GetContext()
+	k := s.App.InterchainqueryKeeper
+	requests := s.batchRequests()
+	err := k.MakeBatchRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID, types.BANK_STORE_QUERY_WITH_PROOF,
+		requests, sdk.NewInt(-1), "", "", 1, height, types.TimeoutPolicy{})
+	s.Require().NoError(err)
+
+	query, found := k.GetQuery(ctx, keeper.GenerateBatchQueryHash(s.path.EndpointA.ConnectionID, s.HostChain.ChainID,
+		types.BANK_STORE_QUERY_WITH_PROOF, requests, "", height))
+	s.Require().True(found)
+	s.Require().True(query.IsBatch())
+	return query
+}
+
+// queryHostBatch queries every key of a batched query from the host store with a proof, at the given height
+func (s *MsgServerTestSuite) queryHostBatch(query types.Query, height int64) *types.MsgSubmitQueryResponse {
+	results := []types.QueryResult{}
+	for _, request := range query.BatchRequests {
+		res := s.HostChain.App.Query(abci.RequestQuery{Path: "store/bank/key", Data: request, Height: height, Prove: true})
+		s.Require().Zero(res.Code, res.Log)
+		results = append(results, types.QueryResult{Result: res.Value, ProofOps: res.ProofOps})
+	}
+	return &types.MsgSubmitQueryResponse{
+		ChainId:     query.ChainId,
+		QueryId:     query.Id,
+		Results:     results,
+		Height:      height,
+		FromAddress: s.StrideChain.SenderAccount.GetAddress().String(),
+	}
+}
+
+func (s *MsgServerTestSuite) TestSubmitBatchQueryResponse() {
+	height := s.HostChain.LastHeader.Header.Height - 1
+	query := s.makeBatchQuery(height)
+	response := s.queryHostBatch(query, height)
+	s.Require().NotEmpty(response.Results[0].Result, "balance set")
+	s.Require().Empty(response.Results[1].Result, "balance not set")
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	ctx := s.StrideChain.GetContext()
+	_, err := msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().NoError(err)
+
+	// the results of every key are stored, and passed to the callbacks, together
+	datapoint, err := s.App.InterchainqueryKeeper.GetDatapointForId(ctx, query.Id)
+	s.Require().NoError(err)
+	results := types.BatchQueryResults{}
+	s.Require().NoError(s.App.AppCodec().Unmarshal(datapoint.Value, &results))
+	s.Require().Len(results.Results, 2)
+	s.Require().Equal(response.Results[0].Result, results.Results[0])
+	s.Require().Empty(results.Results[1])
+}
+
+func (s *MsgServerTestSuite) TestSubmitBatchQueryResponseInvalid() {
+	height := s.HostChain.LastHeader.Header.Height - 1
+	query := s.makeBatchQuery(height)
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+
+	// every key must be answered
+	response := s.queryHostBatch(query, height)
+	response.Results = response.Results[:1]
+	s.Require().ErrorContains(k.VerifyQueryResponse(ctx, query, response), "has 2 requests, response has 1 results")
+
+	// a batched query isn't answered with a single result
+	single := s.queryHost(types.Query{ChainId: query.ChainId, Id: query.Id, Request: query.BatchRequests[0]}, height)
+	s.Require().ErrorContains(k.VerifyQueryResponse(ctx, query, single), "must be answered with results")
+
+	// every key's proof is verified
+	response = s.queryHostBatch(query, height)
+	response.Results[1].Result = []byte("forged")
+	s.Require().ErrorContains(k.VerifyQueryResponse(ctx, query, response), "unable to verify proof")
+}
+
+func (s *MsgServerTestSuite) TestMakeBatchRequestInvalid() {
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+	makeBatchRequest := func(requests [][]byte) error {
+		return k.MakeBatchRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID, types.BANK_STORE_QUERY_WITH_PROOF,
+			requests, sdk.NewInt(-1), "", "", 0, 0, types.TimeoutPolicy{})
+	}
+
+	s.Require().ErrorIs(makeBatchRequest(nil), types.ErrInvalidQueryType, "no requests")
+	requests := s.batchRequests()
+	s.Require().ErrorIs(makeBatchRequest(append(requests, requests[0])), types.ErrInvalidQueryType, "duplicate request")
+	s.Require().ErrorIs(makeBatchRequest(append(requests, []byte{0xff})), types.ErrInvalidQueryType, "request of another store")
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+// SetCallbackFailure records the last failed callback of a query
+func (k Keeper) SetCallbackFailure(ctx sdk.Context, failure types.CallbackFailure) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCallbackFailure)
+	bz := k.cdc.MustMarshal(&failure)
+	store.Set([]byte(failure.QueryId), bz)
+}
+
+// GetCallbackFailure returns the last failed callback of a query
+func (k Keeper) GetCallbackFailure(ctx sdk.Context, queryId string) (types.CallbackFailure, bool) {
+	failure := types.CallbackFailure{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCallbackFailure)
+	bz := store.Get([]byte(queryId))
+	if len(bz) == 0 {
+		return failure, false
+	}
+	k.cdc.MustUnmarshal(bz, &failure)
+	return failure, true
+}
+
+// DeleteCallbackFailure removes the failed callback record of a query
+func (k Keeper) DeleteCallbackFailure(ctx sdk.Context, queryId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCallbackFailure)
+	store.Delete([]byte(queryId))
+}
+
+// AllCallbackFailures returns every failed callback record in the store
+func (k Keeper) AllCallbackFailures(ctx sdk.Context) []types.CallbackFailure {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCallbackFailure)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	failures := []types.CallbackFailure{}
+	for ; iterator.Valid(); iterator.Next() {
+		failure := types.CallbackFailure{}
+		k.cdc.MustUnmarshal(iterator.Value(), &failure)
+		failures = append(failures, failure)
+	}
+	return failures
+}
+
+// executeCallbacks calls the callbacks registered for the query with its result, each module's in its own cached
+// context. It returns whether a callback asked for the query to be kept, and the module and error of the first
+// callback that failed
+func (k Keeper) executeCallbacks(ctx sdk.Context, q types.Query, result []byte, height int64) (noDelete bool, failedModule string, err error) {
+	keys := []string{}
+	for k := range k.callbacks {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Executing callbacks for queryId %s", q.Id))
+	for _, key := range keys {
+		module := k.callbacks[key]
+		if !module.Has(q.CallbackId) {
+			k.Logger(ctx).Info(fmt.Sprintf("Callback not found for module %s", key))
+			continue
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("Executing callback for module %s", key))
+		callbackErr := k.executeCallback(ctx, key, module, q, result, height)
+		k.Logger(ctx).Info(fmt.Sprintf("Callback %s executed", q.CallbackId))
+		// handle edge case; callback has resent the same query!
+		if callbackErr == types.ErrSucceededNoDelete {
+			noDelete = true
+		} else if callbackErr != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("error in callback, error: %s, query: %s, module: %s, type: %s, params: %v", callbackErr.Error(), q.Id, key, q.QueryType, q.Request))
+			if err == nil {
+				failedModule, err = key, callbackErr
+			}
+		}
+	}
+	return noDelete, failedModule, err
+}
+
+// executeCallback calls a module's callback in a cached context, capped at the module's callback gas limit. The
+// callback's writes and events are only kept if it succeeds, while the gas it used is always charged to the tx. A
+// callback that panics, whether out of gas or not, is treated as failed
+func (k Keeper) executeCallback(ctx sdk.Context, moduleName string, module types.QueryCallbacks, q types.Query, result []byte, height int64) (err error) {
+	gasLimit := k.GetParams(ctx).GetCallbackGasLimit(moduleName)
+	gasMeter := sdk.NewInfiniteGasMeter()
+	if gasLimit != 0 {
+		gasMeter = sdk.NewGasMeter(gasLimit)
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchainquery callback")
+		r := recover()
+		if r == nil {
+			return
+		}
+		// a panicking callback fails like one that returned an error, so it can't halt the chain or the tx
+		if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "callback %s of module %s ran out of gas in %s, limit %d",
+				q.CallbackId, moduleName, outOfGas.Descriptor, gasLimit)
+			return
+		}
+		err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "callback %s of module %s panicked: %v", q.CallbackId, moduleName, r)
+	}()
+
+	err = module.Call(cacheCtx, q.CallbackId, result, q, height)
+	if err != nil && err != types.ErrSucceededNoDelete {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return err
+}
+
+// handleCallbackFailure records a failed callback and applies the query's retry policy. The response stays accepted,
+// so the query isn't answered again this emission. A one-shot query is emitted again until it has been retried
+// max_retries times, after which its modules' timeout callbacks are called and it is deleted; a periodic query is
+// answered again next period
+func (k Keeper) handleCallbackFailure(ctx sdk.Context, q types.Query, module string, callbackErr error) {
+	query, found := k.GetQuery(ctx, q.Id)
+	if !found {
+		return
+	}
+	k.SetCallbackFailure(ctx, types.CallbackFailure{
+		QueryId:    query.Id,
+		ChainId:    query.ChainId,
+		Module:     module,
+		CallbackId: query.CallbackId,
+		Error:      callbackErr.Error(),
+		Height:     ctx.BlockHeight(),
+		Retries:    query.Retries,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueCallbackFailure),
+			sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+			sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+			sdk.NewAttribute(types.AttributeKeyCallbackId, query.CallbackId),
+			sdk.NewAttribute(types.AttributeKeyRetries, strconv.FormatUint(query.Retries, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, callbackErr.Error()),
+		),
+	)
+	labels := []metrics.Label{
+		telemetry.NewLabel(types.MetricLabelChainId, query.ChainId),
+		telemetry.NewLabel(types.MetricLabelCallbackId, query.CallbackId),
+	}
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, types.MetricKeyCallbackFailed}, 1, labels)
+
+	if !query.Period.IsNegative() {
+		query.Answered = true
+		k.SetQuery(ctx, query)
+		return
+	}
+	if query.Retries < query.TimeoutPolicy.MaxRetries {
+		k.Logger(ctx).Info(fmt.Sprintf("Interchainquery %s callback failed, retrying (%d/%d)", query.Id, query.Retries+1, query.TimeoutPolicy.MaxRetries))
+		query.Retries++
+		// a zero last height makes the EndBlocker emit the query again
+		query.LastHeight = sdk.ZeroInt()
+		query.Answered = false
+		k.SetQuery(ctx, query)
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, types.MetricKeyQueryRetried}, 1, labels)
+		return
+	}
+
+	k.Logger(ctx).Error(fmt.Sprintf("Interchainquery %s callback failed after %d retries", query.Id, query.Retries))
+	k.callTimeoutCallbacks(ctx, query)
+	k.DeleteQuery(ctx, query.Id)
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, types.MetricKeyQueryFailed}, 1, labels)
+}
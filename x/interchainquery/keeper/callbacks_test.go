@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+const callbackTestModule = "callbacktest"
+
+// testCallbacks runs the callback function it's given, after writing to the store, and records its timeout callback
+type testCallbacks struct {
+	k        *keeper.Keeper
+	callback func(ctx sdk.Context) error
+}
+
+func (c testCallbacks) Call(ctx sdk.Context, id string, args []byte, query types.Query, height int64) error {
+	if err := c.k.SetDatapointForId(ctx, "callback-write", args, sdk.NewInt(height), 0); err != nil {
+		return err
+	}
+	return c.callback(ctx)
+}
+
+func (c testCallbacks) CallTimeout(ctx sdk.Context, id string, query types.Query) error {
+	return c.k.SetDatapointForId(ctx, "timeout-callback", []byte(query.Id), sdk.ZeroInt(), 0)
+}
+
+func (c testCallbacks) Has(id string) bool {
+	return id == "balance"
+}
+
+func (c testCallbacks) AddCallback(id string, fn interface{}) types.QueryCallbacks {
+	return c
+}
+
+func (c testCallbacks) RegisterCallbacks() types.QueryCallbacks {
+	return c
+}
+
+// emitCallbackQuery registers a one-shot balance query with the test callback handler and emits it
+func (s *MsgServerTestSuite) emitCallbackQuery(callback func(ctx sdk.Context) error, maxRetries uint64) types.Query {
+	k := s.App.InterchainqueryKeeper
+	s.Require().NoError(k.SetCallbackHandler(callbackTestModule, testCallbacks{k: &k, callback: callback}))
+
+	ctx := s.StrideChain.GetContext()
+	request := s.balanceRequest()
+	err := k.MakeRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID, types.BANK_STORE_QUERY_WITH_PROOF,
+		request, sdk.NewInt(-1), callbackTestModule, "balance", 1, 0, types.TimeoutPolicy{MaxRetries: maxRetries})
+	s.Require().NoError(err)
+	k.EndBlocker(ctx)
+
+	query, found := k.GetQuery(ctx, keeper.GenerateQueryHash(s.path.EndpointA.ConnectionID, s.HostChain.ChainID,
+		types.BANK_STORE_QUERY_WITH_PROOF, request, callbackTestModule, 0))
+	s.Require().True(found)
+	return query
+}
+
+// respond answers the query at the host's latest committed height
+func (s *MsgServerTestSuite) respond(ctx sdk.Context, query types.Query) {
+	s.Coordinator.CommitBlock(s.HostChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	response := s.queryHost(query, s.HostChain.LastHeader.Header.Height-1)
+	_, err := msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().NoError(err, "a failed callback doesn't fail the response")
+}
+
+func (s *MsgServerTestSuite) TestCallbackFailureRetried() {
+	callbackErr := errors.New("callback failed")
+	query := s.emitCallbackQuery(func(ctx sdk.Context) error { return callbackErr }, 1)
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+
+	s.respond(ctx, query)
+
+	// the callback's writes are discarded, but the response is kept
+	_, err := k.GetDatapointForId(ctx, "callback-write")
+	s.Require().Error(err, "callback writes discarded")
+	_, err = k.GetDatapointForId(ctx, query.Id)
+	s.Require().NoError(err, "datapoint stored")
+
+	failure, found := k.GetCallbackFailure(ctx, query.Id)
+	s.Require().True(found)
+	s.Require().Equal(callbackTestModule, failure.Module)
+	s.Require().Equal(callbackErr.Error(), failure.Error)
+	s.Require().Equal(uint64(0), failure.Retries)
+
+	// the query is emitted again
+	retried, found := k.GetQuery(ctx, query.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), retried.Retries)
+	s.Require().True(retried.LastHeight.IsZero())
+	k.EndBlocker(ctx)
+
+	_, err = k.GetDatapointForId(ctx, "timeout-callback")
+	s.Require().Error(err, "timeout callback not called while retrying")
+
+	// and deleted once it runs out of retries, after its module is told it failed
+	s.respond(ctx, query)
+	_, found = k.GetQuery(ctx, query.Id)
+	s.Require().False(found, "query failed")
+	_, err = k.GetDatapointForId(ctx, "timeout-callback")
+	s.Require().NoError(err, "timeout callback called")
+	failure, found = k.GetCallbackFailure(ctx, query.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), failure.Retries)
+}
+
+func (s *MsgServerTestSuite) TestCallbackGasLimit() {
+	gasLimit := uint64(50_000)
+	query := s.emitCallbackQuery(func(ctx sdk.Context) error {
+		ctx.GasMeter().ConsumeGas(gasLimit, "expensive callback")
+		return nil
+	}, 0)
+	k := s.App.InterchainqueryKeeper
+	params := k.GetParams(s.StrideChain.GetContext())
+	params.CallbackGasLimits = []types.CallbackGasLimit{{Module: callbackTestModule, GasLimit: gasLimit}}
+	k.SetParams(s.StrideChain.GetContext(), params)
+
+	ctx := s.StrideChain.GetContext().WithGasMeter(sdk.NewGasMeter(10 * gasLimit))
+	s.respond(ctx, query)
+
+	failure, found := k.GetCallbackFailure(ctx, query.Id)
+	s.Require().True(found)
+	s.Require().Contains(failure.Error, "ran out of gas")
+	s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), gasLimit, "callback gas charged to the tx")
+	_, err := k.GetDatapointForId(ctx, "callback-write")
+	s.Require().Error(err, "callback writes discarded")
+}
+
+func (s *MsgServerTestSuite) TestCallbackPanic() {
+	query := s.emitCallbackQuery(func(ctx sdk.Context) error { panic("unexpected state") }, 0)
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+
+	s.respond(ctx, query)
+
+	failure, found := k.GetCallbackFailure(ctx, query.Id)
+	s.Require().True(found)
+	s.Require().Contains(failure.Error, "panicked: unexpected state")
+	_, err := k.GetDatapointForId(ctx, "callback-write")
+	s.Require().Error(err, "callback writes discarded")
+}
+
+func (s *MsgServerTestSuite) TestCallbackSucceeded() {
+	query := s.emitCallbackQuery(func(ctx sdk.Context) error { return nil }, 0)
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+	k.SetCallbackFailure(ctx, types.CallbackFailure{QueryId: query.Id, Error: "earlier failure"})
+
+	s.respond(ctx, query)
+
+	_, err := k.GetDatapointForId(ctx, "callback-write")
+	s.Require().NoError(err, "callback writes kept")
+	_, found := k.GetCallbackFailure(ctx, query.Id)
+	s.Require().False(found, "failure cleared")
+}
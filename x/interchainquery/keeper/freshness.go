@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+// ValidateResponseFreshness rejects responses to latest-height queries that are older than the query, older than an
+// answer that was already accepted, or that trail the light client's latest height by more than the MaxResponseAge
+// param. Without it, a valid proof from hours earlier could answer a fresh query
+func (k Keeper) ValidateResponseFreshness(ctx sdk.Context, query types.Query, height int64) error {
+	if height < 0 {
+		return sdkerrors.Wrapf(types.ErrStaleResponse, "query %s response height %d cannot be negative", query.Id, height)
+	}
+	responseHeight := uint64(height)
+
+	if responseHeight < query.EmittedHostHeight {
+		return sdkerrors.Wrapf(types.ErrStaleResponse, "query %s response at host height %d predates its emission at host height %d",
+			query.Id, responseHeight, query.EmittedHostHeight)
+	}
+
+	if datapoint, err := k.GetDatapointForId(ctx, query.Id); err == nil && datapoint.RemoteHeight.Int64() >= height {
+		return sdkerrors.Wrapf(types.ErrStaleResponse, "query %s was already answered at host height %s, response at host height %d",
+			query.Id, datapoint.RemoteHeight, responseHeight)
+	}
+
+	maxResponseAge := k.GetParams(ctx).MaxResponseAge
+	if maxResponseAge == 0 {
+		return nil
+	}
+	latestHeight, _, err := k.GetLatestHostHeight(ctx, query.ConnectionId)
+	if err != nil {
+		return err
+	}
+	if latestHeight > responseHeight+maxResponseAge {
+		return sdkerrors.Wrapf(types.ErrStaleResponse, "query %s response at host height %d is more than %d blocks behind the latest client height %d",
+			query.Id, responseHeight, maxResponseAge, latestHeight)
+	}
+	return nil
+}
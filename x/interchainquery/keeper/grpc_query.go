@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+var _ types.QueryServiceServer = Keeper{}
+
+// Queries lists the interchain queries, filtered by chain id and connection id if they are set
+func (k Keeper) Queries(c context.Context, req *types.QueryQueriesRequest) (*types.QueryQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var queries []types.Query
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
+
+	pageRes, err := query.FilteredPaginate(queryStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var queryInfo types.Query
+		if err := k.cdc.Unmarshal(value, &queryInfo); err != nil {
+			return false, err
+		}
+		if req.ChainId != "" && queryInfo.ChainId != req.ChainId {
+			return false, nil
+		}
+		if req.ConnectionId != "" && queryInfo.ConnectionId != req.ConnectionId {
+			return false, nil
+		}
+
+		if accumulate {
+			queries = append(queries, queryInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueriesResponse{Queries: queries, Pagination: pageRes}, nil
+}
+
+// Query returns an interchain query by id
+func (k Keeper) Query(c context.Context, req *types.QueryQueryRequest) (*types.QueryQueryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queryInfo, found := k.GetQuery(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryQueryResponse{Query: queryInfo}, nil
+}
+
+// DataPoints lists the stored query results
+func (k Keeper) DataPoints(c context.Context, req *types.QueryDataPointsRequest) (*types.QueryDataPointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var datapoints []types.DataPoint
+	ctx := sdk.UnwrapSDKContext(c)
+
+	datapointStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+
+	pageRes, err := query.Paginate(datapointStore, req.Pagination, func(key []byte, value []byte) error {
+		var datapoint types.DataPoint
+		if err := k.cdc.Unmarshal(value, &datapoint); err != nil {
+			return err
+		}
+
+		datapoints = append(datapoints, datapoint)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDataPointsResponse{Datapoints: datapoints, Pagination: pageRes}, nil
+}
+
+// DataPoint returns the stored result of a query by the query's id
+func (k Keeper) DataPoint(c context.Context, req *types.QueryDataPointRequest) (*types.QueryDataPointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	datapoint, err := k.GetDatapointForId(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryDataPointResponse{Datapoint: datapoint}, nil
+}
+
+// CallbackFailures lists the last failed callback of each query that hasn't succeeded since
+func (k Keeper) CallbackFailures(c context.Context, req *types.QueryCallbackFailuresRequest) (*types.QueryCallbackFailuresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var failures []types.CallbackFailure
+	ctx := sdk.UnwrapSDKContext(c)
+
+	failureStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCallbackFailure)
+
+	pageRes, err := query.Paginate(failureStore, req.Pagination, func(key []byte, value []byte) error {
+		var failure types.CallbackFailure
+		if err := k.cdc.Unmarshal(value, &failure); err != nil {
+			return err
+		}
+
+		failures = append(failures, failure)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCallbackFailuresResponse{Failures: failures, Pagination: pageRes}, nil
+}
+
+// Params returns the module parameters
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/testutil/nullify"
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+func createQueries(k *keeper.Keeper, ctx sdk.Context) []types.Query {
+	queries := []types.Query{
+		*k.NewQuery(ctx, "stakeibc", "connection-0", "GAIA", types.BANK_STORE_QUERY_WITH_PROOF, []byte{1}, sdk.NewInt(10), "withdrawalbalance", 0, 0),
+		*k.NewQuery(ctx, "stakeibc", "connection-0", "GAIA", types.BANK_STORE_QUERY_WITH_PROOF, []byte{2}, sdk.NewInt(10), "withdrawalbalance", 0, 0),
+		*k.NewQuery(ctx, "stakeibc", "connection-1", "OSMO", types.BANK_STORE_QUERY_WITH_PROOF, []byte{1}, sdk.NewInt(10), "withdrawalbalance", 0, 0),
+	}
+	for _, q := range queries {
+		k.SetQuery(ctx, q)
+	}
+	return queries
+}
+
+func TestQueriesQuery(t *testing.T) {
+	k, ctx := keepertest.InterchainqueryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	queries := createQueries(k, ctx)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryQueriesRequest
+		expected []types.Query
+	}{
+		{
+			desc:     "All",
+			request:  &types.QueryQueriesRequest{},
+			expected: queries,
+		},
+		{
+			desc:     "ChainId",
+			request:  &types.QueryQueriesRequest{ChainId: "GAIA"},
+			expected: queries[:2],
+		},
+		{
+			desc:     "ConnectionId",
+			request:  &types.QueryQueriesRequest{ConnectionId: "connection-1"},
+			expected: queries[2:],
+		},
+		{
+			desc:     "NoMatch",
+			request:  &types.QueryQueriesRequest{ChainId: "GAIA", ConnectionId: "connection-1"},
+			expected: nil,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			tc.request.Pagination = &query.PageRequest{CountTotal: true}
+			resp, err := k.Queries(wctx, tc.request)
+			require.NoError(t, err)
+			require.ElementsMatch(t, nullify.Fill(tc.expected), nullify.Fill(resp.Queries))
+			require.Equal(t, len(tc.expected), int(resp.Pagination.Total), "total")
+		})
+	}
+
+	t.Run("Paginated", func(t *testing.T) {
+		resp, err := k.Queries(wctx, &types.QueryQueriesRequest{Pagination: &query.PageRequest{Limit: 2}})
+		require.NoError(t, err)
+		require.Len(t, resp.Queries, 2)
+
+		resp, err = k.Queries(wctx, &types.QueryQueriesRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+		require.NoError(t, err)
+		require.Len(t, resp.Queries, 1)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := k.Queries(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestQueryQuery(t *testing.T) {
+	k, ctx := keepertest.InterchainqueryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	queries := createQueries(k, ctx)
+
+	resp, err := k.Query(wctx, &types.QueryQueryRequest{Id: queries[1].Id})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(&queries[1]), nullify.Fill(&resp.Query))
+
+	_, err = k.Query(wctx, &types.QueryQueryRequest{Id: "missing"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = k.Query(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestDataPointQueries(t *testing.T) {
+	k, ctx := keepertest.InterchainqueryKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	queries := createQueries(k, ctx)
+	for i, q := range queries {
+		require.NoError(t, k.SetDatapointForId(ctx, q.Id, []byte{byte(i)}, sdk.NewInt(100), 0))
+	}
+
+	resp, err := k.DataPoints(wctx, &types.QueryDataPointsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, resp.Datapoints, len(queries))
+	require.Equal(t, len(queries), int(resp.Pagination.Total), "total")
+
+	datapointResp, err := k.DataPoint(wctx, &types.QueryDataPointRequest{Id: queries[2].Id})
+	require.NoError(t, err)
+	require.Equal(t, queries[2].Id, datapointResp.Datapoint.Id)
+	require.Equal(t, []byte{2}, datapointResp.Datapoint.Value)
+	require.Equal(t, int64(100), datapointResp.Datapoint.RemoteHeight.Int64())
+
+	_, err = k.DataPoint(wctx, &types.QueryDataPointRequest{Id: "missing"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+
+	_, err = k.DataPoints(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	"github.com/spf13/cast"
 	"github.com/tendermint/tendermint/libs/log"
@@ -17,19 +18,32 @@ import (
 
 // Keeper of this module maintains collections of registered zones.
 type Keeper struct {
-	cdc       codec.Codec
-	storeKey  sdk.StoreKey
-	callbacks map[string]types.QueryCallbacks
-	IBCKeeper *ibckeeper.Keeper
+	cdc        codec.Codec
+	storeKey   sdk.StoreKey
+	tstoreKey  sdk.StoreKey
+	paramstore paramtypes.Subspace
+	callbacks  map[string]types.QueryCallbacks
+	queryTypes types.QueryTypeRegistry
+	bankKeeper types.BankKeeper
+	IBCKeeper  *ibckeeper.Keeper
 }
 
 // NewKeeper returns a new instance of zones Keeper
-func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, ibckeeper *ibckeeper.Keeper) Keeper {
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, tstoreKey sdk.StoreKey, ps paramtypes.Subspace, bankKeeper types.BankKeeper, ibckeeper *ibckeeper.Keeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		callbacks: make(map[string]types.QueryCallbacks),
-		IBCKeeper: ibckeeper,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		tstoreKey:  tstoreKey,
+		paramstore: ps,
+		callbacks:  make(map[string]types.QueryCallbacks),
+		queryTypes: types.DefaultQueryTypeRegistry(),
+		bankKeeper: bankKeeper,
+		IBCKeeper:  ibckeeper,
 	}
 }
 
@@ -42,19 +56,30 @@ func (k *Keeper) SetCallbackHandler(module string, handler types.QueryCallbacks)
 	return nil
 }
 
+// RegisterQueryType allows requests of a query type beyond the defaults, restricted to host store keys starting
+// with one of the given prefixes
+func (k *Keeper) RegisterQueryType(queryType string, prefixes ...[]byte) {
+	k.queryTypes.Register(queryType, prefixes...)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k *Keeper) SetDatapointForId(ctx sdk.Context, id string, result []byte, height sdk.Int) error {
-	mapping := types.DataPoint{Id: id, RemoteHeight: height, LocalHeight: sdk.NewInt(ctx.BlockHeight()), Value: result}
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
-	bz := k.cdc.MustMarshal(&mapping)
-	store.Set([]byte(id), bz)
+func (k *Keeper) SetDatapointForId(ctx sdk.Context, id string, result []byte, height sdk.Int, emittedHostHeight uint64) error {
+	mapping := types.DataPoint{Id: id, RemoteHeight: height, LocalHeight: sdk.NewInt(ctx.BlockHeight()), Value: result, EmittedHostHeight: emittedHostHeight}
+	k.SetDatapoint(ctx, mapping)
 	return nil
 }
 
+// SetDatapoint stores a datapoint as is
+func (k Keeper) SetDatapoint(ctx sdk.Context, datapoint types.DataPoint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	bz := k.cdc.MustMarshal(&datapoint)
+	store.Set([]byte(datapoint.Id), bz)
+}
+
 func (k *Keeper) GetDatapointForId(ctx sdk.Context, id string) (types.DataPoint, error) {
 	mapping := types.DataPoint{}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
@@ -86,6 +111,16 @@ func (k Keeper) IterateDatapoints(ctx sdk.Context, fn func(index int64, dp types
 	}
 }
 
+// AllDatapoints returns every datapoint in the store
+func (k Keeper) AllDatapoints(ctx sdk.Context) []types.DataPoint {
+	datapoints := []types.DataPoint{}
+	k.IterateDatapoints(ctx, func(_ int64, dp types.DataPoint) (stop bool) {
+		datapoints = append(datapoints, dp)
+		return false
+	})
+	return datapoints
+}
+
 func (k Keeper) DeleteDatapoint(ctx sdk.Context, id string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
 	store.Delete([]byte(id))
@@ -100,7 +135,7 @@ func (k *Keeper) GetDatapointOrRequest(ctx sdk.Context, module string, connectio
 	val, err := k.GetDatapoint(ctx, module, connection_id, chain_id, query_type, request, height)
 	if err != nil {
 		// no datapoint
-		err := k.MakeRequest(ctx, connection_id, chain_id, query_type, request, sdk.NewInt(-1), "", "", max_age, height)
+		err := k.MakeRequest(ctx, connection_id, chain_id, query_type, request, sdk.NewInt(-1), "", "", max_age, height, types.TimeoutPolicy{})
 		if err != nil {
 			return types.DataPoint{}, err
 		}
@@ -112,7 +147,7 @@ func (k *Keeper) GetDatapointOrRequest(ctx sdk.Context, module string, connectio
 		return types.DataPoint{}, err
 	}
 	if val.LocalHeight.LT(sdk.NewInt(ctx.BlockHeight() - max_age_)) { // this is somewhat arbitrary; TODO: make this better
-		err := k.MakeRequest(ctx, connection_id, chain_id, query_type, request, sdk.NewInt(-1), "", "", max_age, height)
+		err := k.MakeRequest(ctx, connection_id, chain_id, query_type, request, sdk.NewInt(-1), "", "", max_age, height, types.TimeoutPolicy{})
 		if err != nil {
 			return types.DataPoint{}, err
 		}
@@ -122,27 +157,48 @@ func (k *Keeper) GetDatapointOrRequest(ctx sdk.Context, module string, connectio
 	return val, nil
 }
 
-func (k *Keeper) MakeRequest(ctx sdk.Context, connection_id string, chain_id string, query_type string, request []byte, period sdk.Int, module string, callback_id string, ttl uint64, height int64) error {
+func (k *Keeper) MakeRequest(ctx sdk.Context, connection_id string, chain_id string, query_type string, request []byte, period sdk.Int, module string, callback_id string, ttl uint64, height int64, timeoutPolicy types.TimeoutPolicy) error {
+	return k.makeRequest(ctx, connection_id, chain_id, query_type, request, nil, period, module, callback_id, ttl, height, timeoutPolicy)
+}
+
+// MakeBatchRequest registers a query for several keys of the same host store, answered together by one response with
+// a proof per key at the same host height. The callback gets the values of all the keys at once, as BatchQueryResults
+func (k *Keeper) MakeBatchRequest(ctx sdk.Context, connection_id string, chain_id string, query_type string, requests [][]byte, period sdk.Int, module string, callback_id string, ttl uint64, height int64, timeoutPolicy types.TimeoutPolicy) error {
+	if len(requests) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidQueryType, "batched query has no requests")
+	}
+	requested := map[string]bool{}
+	for _, request := range requests {
+		if requested[string(request)] {
+			return sdkerrors.Wrapf(types.ErrInvalidQueryType, "request %X is batched more than once", request)
+		}
+		requested[string(request)] = true
+	}
+	return k.makeRequest(ctx, connection_id, chain_id, query_type, nil, requests, period, module, callback_id, ttl, height, timeoutPolicy)
+}
+
+func (k *Keeper) makeRequest(ctx sdk.Context, connection_id string, chain_id string, query_type string, request []byte, batchRequests [][]byte, period sdk.Int, module string, callback_id string, ttl uint64, height int64, timeoutPolicy types.TimeoutPolicy) error {
 	k.Logger(ctx).Info(
 		"MakeRequest",
 		"connection_id", connection_id,
 		"chain_id", chain_id,
 		"query_type", query_type,
 		"request", request,
+		"batch_requests", len(batchRequests),
 		"period", period,
 		"module", module,
 		"callback", callback_id,
 		"ttl", ttl,
 		"height", height,
+		"timeout_policy", timeoutPolicy,
 	)
 
 	// ======================================================================================================================
 	// Perform basic validation on the query input
 
-	// today we only support queries at the latest block height on the host zone, specified by "height=0"
-
-	if height != 0 {
-		return fmt.Errorf("ICQ query height must be 0! Found a query at non-zero height %d", height)
+	// height 0 queries the latest block height on the host zone, any other height pins the query to that host block
+	if height < 0 {
+		return fmt.Errorf("ICQ query height cannot be negative! Found a query at height %d", height)
 	}
 
 	// connection id cannot be empty and must begin with "connection"
@@ -152,17 +208,27 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, connection_id string, chain_id str
 	if !strings.HasPrefix(connection_id, "connection") {
 		k.Logger(ctx).Error("[ICQ Validation Check] Failed! connection id must begin with 'connection'")
 	}
-	// height must be 0
-	if height != 0 {
-		k.Logger(ctx).Error("[ICQ Validation Check] Failed! height for interchainquery must be 0 (we exclusively query at the latest height on the host zone)")
-	}
 	// chain_id cannot be empty
 	if chain_id == "" {
 		k.Logger(ctx).Error("[ICQ Validation Check] Failed! chain_id cannot be empty")
 	}
+	// the query type must be registered, and the request must be a key allowed for it
+	requests := [][]byte{request}
+	if len(batchRequests) > 0 {
+		requests = batchRequests
+	}
+	for _, request := range requests {
+		if err := k.queryTypes.Validate(query_type, request); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("[ICQ Validation Check] Failed! %s", err.Error()))
+			return err
+		}
+	}
 	// ======================================================================================================================
 
 	key := GenerateQueryHash(connection_id, chain_id, query_type, request, module, height)
+	if len(batchRequests) > 0 {
+		key = GenerateBatchQueryHash(connection_id, chain_id, query_type, batchRequests, module, height)
+	}
 	existingQuery, found := k.GetQuery(ctx, key)
 	if !found {
 		if module != "" {
@@ -179,11 +245,17 @@ func (k *Keeper) MakeRequest(ctx sdk.Context, connection_id string, chain_id str
 			}
 		}
 		newQuery := k.NewQuery(ctx, module, connection_id, chain_id, query_type, request, period, callback_id, ttl, height)
+		newQuery.Id = key
+		newQuery.BatchRequests = batchRequests
+		newQuery.TimeoutPolicy = timeoutPolicy
 		k.SetQuery(ctx, *newQuery)
 
 	} else {
-		// a re-request of an existing query triggers resetting of height to trigger immediately.
+		// a re-request of an existing query triggers resetting of height to trigger immediately, with a fresh set of retries
 		existingQuery.LastHeight = sdk.ZeroInt()
+		existingQuery.TimeoutPolicy = timeoutPolicy
+		existingQuery.Retries = 0
+		existingQuery.Answered = false
 		k.SetQuery(ctx, existingQuery)
 	}
 	return nil
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	strideapp "github.com/Stride-Labs/stride/app"
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := strideapp.InitTestApp(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "stride-1", Time: time.Now().UTC()})
+	k := app.InterchainqueryKeeper
+
+	// remove the params, as they were before the migration
+	paramsStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	for _, key := range [][]byte{types.KeyMaxResponseAge, types.KeyDefaultCallbackGasLimit, types.KeyCallbackGasLimits} {
+		paramsStore.Delete(append([]byte(types.ModuleName+"/"), key...))
+	}
+	require.Panics(t, func() { k.GetParams(ctx) })
+
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxResponseAge, params.MaxResponseAge)
+	require.Equal(t, types.DefaultCallbackGasLimit, params.DefaultCallbackGasLimit)
+	require.Empty(t, params.CallbackGasLimits)
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"sort"
 	"strings"
 
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	tmclienttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	"github.com/spf13/cast"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 
 	"github.com/Stride-Labs/stride/x/interchainquery/types"
 )
@@ -31,108 +35,71 @@ var _ types.MsgServer = msgServer{}
 func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubmitQueryResponse) (*types.MsgSubmitQueryResponseResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	q, found := k.GetQuery(ctx, msg.QueryId)
-	if found {
-		pathParts := strings.Split(q.QueryType, "/")
-		if pathParts[len(pathParts)-1] == "key" {
-			if msg.ProofOps == nil {
-				return nil, fmt.Errorf("unable to validate proof. No proof submitted")
-			}
-			connection, _ := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, q.ConnectionId)
-
-			msgHeight, err := cast.ToUint64E(msg.Height)
-			if err != nil {
-				return nil, err
-			}
-			height := clienttypes.NewHeight(clienttypes.ParseChainID(q.ChainId), msgHeight+1)
-			consensusState, found := k.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, height)
-
-			if !found {
-				return nil, fmt.Errorf("unable to fetch consensus state")
-			}
-
-			clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
-			if !found {
-				return nil, fmt.Errorf("unable to fetch client state")
-			}
-			path := commitmenttypes.NewMerklePath([]string{pathParts[1], url.PathEscape(string(q.Request))}...)
-
-			merkleProof, err := commitmenttypes.ConvertProofs(msg.ProofOps)
-			if err != nil {
-				k.Logger(ctx).Error("error converting proofs")
-			}
-
-			tmclientstate, ok := clientState.(*tmclienttypes.ClientState)
-			if !ok {
-				k.Logger(ctx).Error(fmt.Sprintf("error unmarshaling client state %v", clientState))
-			}
-
-			if len(msg.Result) != 0 {
-				// if we got a non-nil response, verify inclusion proof.
-				if err := merkleProof.VerifyMembership(tmclientstate.ProofSpecs, consensusState.GetRoot(), path, msg.Result); err != nil {
-					return nil, fmt.Errorf("unable to verify proof: %s", err)
-				}
-				k.Logger(ctx).Info(fmt.Sprintf("Proof validated! module: %s, queryId %s", types.ModuleName, q.Id))
+	if found && !q.Answered {
+		if err := k.VerifyQueryResponse(ctx, q, msg); err != nil {
+			return nil, err
+		}
 
-			} else {
-				// if we got a nil response, verify non inclusion proof.
-				if err := merkleProof.VerifyNonMembership(tmclientstate.ProofSpecs, consensusState.GetRoot(), path); err != nil {
-					return nil, fmt.Errorf("unable to verify proof: %s", err)
-				}
-				k.Logger(ctx).Info(fmt.Sprintf("Non-inclusion Proof validated! module: %s, queryId %s", types.ModuleName, q.Id))
+		// the callbacks of a batched query get the values of all its keys at once
+		result := msg.Result
+		if q.IsBatch() {
+			batchResults := types.BatchQueryResults{}
+			for _, queryResult := range msg.Results {
+				batchResults.Results = append(batchResults.Results, queryResult.Result)
 			}
+			result = k.cdc.MustMarshal(&batchResults)
 		}
 
-		noDelete := false
-		// execute registered callbacks.
-
-		keys := []string{}
-		for k := range k.callbacks {
-			keys = append(keys, k)
+		// a failed callback doesn't fail the response, which was verified, but is recorded and retried
+		noDelete, failedModule, callbackErr := k.executeCallbacks(ctx, q, result, msg.Height)
+
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.MetricKeyQueryAnswered},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.MetricLabelChainId, q.ChainId),
+				telemetry.NewLabel(types.MetricLabelCallbackId, q.CallbackId),
+			},
+		)
+
+		// the first valid response collects the query's reward
+		relayer, err := sdk.AccAddressFromBech32(msg.FromAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid relayer address %s", msg.FromAddress)
 		}
-
-		sort.Strings(keys)
-
-		k.Logger(ctx).Info(fmt.Sprintf("Executing callbacks for queryId %s", q.Id))
-		for _, key := range keys {
-			k.Logger(ctx).Info(fmt.Sprintf("Executing callback for module %s", key))
-			module := k.callbacks[key]
-			if module.Has(q.CallbackId) {
-				err := module.Call(ctx, q.CallbackId, msg.Result, q)
-				k.Logger(ctx).Info(fmt.Sprintf("Callback %s executed", q.CallbackId))
-				if err != nil {
-					k.Logger(ctx).Error(fmt.Sprintf("error executing callback %s: %v", q.CallbackId, err))
-					// handle edge case; callback has resent the same query!
-					// set noDelete to true and short circuit error handling!
-					if err == types.ErrSucceededNoDelete {
-						noDelete = true
-					} else {
-						k.Logger(ctx).Error(fmt.Sprintf("error in callback, error: %s, msg: %s, result: %v, type: %s, params: %v", err.Error(), msg.QueryId, msg.Result, q.QueryType, q.Request))
-						return nil, err
-					}
-				}
-			} else {
-				k.Logger(ctx).Info(fmt.Sprintf("Callback not found for module %s", key))
-			}
+		if err := k.payQueryReward(ctx, &q, relayer); err != nil {
+			k.Logger(ctx).Error(err.Error())
+			return nil, err
 		}
 
 		if q.Ttl > 0 {
 			// don't store if ttl is 0
-			if err := k.SetDatapointForId(ctx, msg.QueryId, msg.Result, sdk.NewInt(msg.Height)); err != nil {
+			if err := k.SetDatapointForId(ctx, msg.QueryId, result, sdk.NewInt(msg.Height), q.EmittedHostHeight); err != nil {
 				return nil, err
 			}
 		}
 
-		if q.Period.IsNegative() {
-			if !noDelete {
-				k.DeleteQuery(ctx, msg.QueryId)
-			}
+		if callbackErr != nil {
+			k.handleCallbackFailure(ctx, q, failedModule, callbackErr)
 		} else {
-			k.SetQuery(ctx, q)
+			k.DeleteCallbackFailure(ctx, q.Id)
+			if q.Period.IsNegative() {
+				if !noDelete {
+					k.DeleteQuery(ctx, msg.QueryId)
+				}
+			} else {
+				// later responses to this emission are duplicates
+				q.Answered = true
+				k.SetQuery(ctx, q)
+			}
 		}
 
+		if err := k.settleResponseFee(ctx); err != nil {
+			return nil, err
+		}
 	} else {
-		k.Logger(ctx).Info("Ignoring duplicate query")
-		return &types.MsgSubmitQueryResponseResponse{}, nil // technically this is an error, but will cause the entire tx to fail if we have one 'bad' message, so we can just no-op here.
+		// the ante handler rejects these first, but not when they're wrapped in another msg, like an authz exec
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyFulfilled, "query %s is not pending", msg.QueryId)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -144,3 +111,100 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 
 	return &types.MsgSubmitQueryResponseResponse{}, nil
 }
+
+// VerifyQueryResponse checks that a response answers the query at an acceptable height, and verifies its proof
+// against the light client's consensus state. It doesn't change state, so it's also run by the ante handler to
+// reject invalid responses before their fees are refunded
+func (k Keeper) VerifyQueryResponse(ctx sdk.Context, q types.Query, msg *types.MsgSubmitQueryResponse) error {
+	// queries pinned to a host height must be answered at that height
+	if q.Height != 0 && msg.Height != q.Height {
+		return sdkerrors.Wrapf(types.ErrInvalidHeight, "query %s requested at height %d, response at height %d", q.Id, q.Height, msg.Height)
+	}
+	if q.Height == 0 {
+		if err := k.ValidateResponseFreshness(ctx, q, msg.Height); err != nil {
+			k.Logger(ctx).Error(err.Error())
+			return err
+		}
+	}
+
+	if q.IsBatch() {
+		if len(msg.Result) != 0 || msg.ProofOps != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batched query %s must be answered with results", q.Id)
+		}
+		if len(msg.Results) != len(q.BatchRequests) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batched query %s has %d requests, response has %d results", q.Id, len(q.BatchRequests), len(msg.Results))
+		}
+	} else if len(msg.Results) != 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query %s is not batched, response has %d results", q.Id, len(msg.Results))
+	}
+
+	pathParts := strings.Split(q.QueryType, "/")
+	if pathParts[len(pathParts)-1] != "key" {
+		return nil
+	}
+	connection, _ := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, q.ConnectionId)
+
+	msgHeight, err := cast.ToUint64E(msg.Height)
+	if err != nil {
+		return err
+	}
+	// the state at the response height is committed to in the app hash of the next block's header
+	height := clienttypes.NewHeight(clienttypes.ParseChainID(q.ChainId), msgHeight+1)
+	consensusState, found := k.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, height)
+
+	if !found {
+		return fmt.Errorf("unable to fetch consensus state at height %s, the client must be updated to it", height)
+	}
+
+	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return fmt.Errorf("unable to fetch client state")
+	}
+	tmclientstate, ok := clientState.(*tmclienttypes.ClientState)
+	if !ok {
+		k.Logger(ctx).Error(fmt.Sprintf("error unmarshaling client state %v", clientState))
+		return fmt.Errorf("client state is not a tendermint client state")
+	}
+
+	if !q.IsBatch() {
+		return k.verifyProof(ctx, q, tmclientstate, consensusState.GetRoot(), pathParts[1], q.Request, msg.Result, msg.ProofOps)
+	}
+	// every key of a batched query is proven against the same consensus state
+	for i, request := range q.BatchRequests {
+		if err := k.verifyProof(ctx, q, tmclientstate, consensusState.GetRoot(), pathParts[1], request, msg.Results[i].Result, msg.Results[i].ProofOps); err != nil {
+			return sdkerrors.Wrapf(err, "request %d of batched query", i)
+		}
+	}
+	return nil
+}
+
+// verifyProof verifies the proof that the host store holds the result at the request key, or that the key isn't set
+// if the result is empty
+func (k Keeper) verifyProof(ctx sdk.Context, q types.Query, clientState *tmclienttypes.ClientState, root exported.Root, storeName string, request []byte, result []byte, proofOps *tmcrypto.ProofOps) error {
+	if proofOps == nil {
+		return fmt.Errorf("unable to validate proof. No proof submitted")
+	}
+	path := commitmenttypes.NewMerklePath([]string{storeName, url.PathEscape(string(request))}...)
+
+	merkleProof, err := commitmenttypes.ConvertProofs(proofOps)
+	if err != nil {
+		k.Logger(ctx).Error("error converting proofs")
+		return fmt.Errorf("unable to convert proofs: %s", err)
+	}
+
+	if len(result) != 0 {
+		// if we got a non-nil response, verify inclusion proof.
+		if err := merkleProof.VerifyMembership(clientState.ProofSpecs, root, path, result); err != nil {
+			return fmt.Errorf("unable to verify proof: %s", err)
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("Proof validated! module: %s, queryId %s", types.ModuleName, q.Id))
+
+	} else {
+		// if we got a nil response, verify non inclusion proof.
+		if err := merkleProof.VerifyNonMembership(clientState.ProofSpecs, root, path); err != nil {
+			return fmt.Errorf("unable to verify proof: %s", err)
+		}
+		k.Logger(ctx).Info(fmt.Sprintf("Non-inclusion Proof validated! module: %s, queryId %s", types.ModuleName, q.Id))
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Stride-Labs/stride/app/apptesting"
+	cmdcfg "github.com/Stride-Labs/stride/cmd/strided/config"
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+type MsgServerTestSuite struct {
+	apptesting.AppTestHelper
+	path *ibctesting.Path
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	cmdcfg.SetBech32Prefixes(sdk.GetConfig())
+	s.SetupIBCChains()
+	s.path = ibctesting.NewPath(s.StrideChain, s.HostChain)
+	s.Coordinator.SetupConnections(s.path)
+}
+
+// balanceRequest returns the bank store key of the host chain sender's balance
+func (s *MsgServerTestSuite) balanceRequest() []byte {
+	address := s.HostChain.SenderAccount.GetAddress()
+	return types.NewBalanceRequest(address, sdk.DefaultBondDenom)
+}
+
+// makeQuery registers a balance query pinned to the given host height
+func (s *MsgServerTestSuite) makeQuery(height int64) types.Query {
+	ctx := s.StrideChain.GetContext()
+	k := s.App.InterchainqueryKeeper
+	request := s.balanceRequest()
+	err := k.MakeRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID, types.BANK_STORE_QUERY_WITH_PROOF,
+		request, sdk.NewInt(-1), "", "", 1, height, types.TimeoutPolicy{})
+	s.Require().NoError(err)
+
+	query, found := k.GetQuery(ctx, keeper.GenerateQueryHash(s.path.EndpointA.ConnectionID, s.HostChain.ChainID,
+		types.BANK_STORE_QUERY_WITH_PROOF, request, "", height))
+	s.Require().True(found)
+	return query
+}
+
+// queryHost queries the host store with a proof for the state at the given height
+func (s *MsgServerTestSuite) queryHost(query types.Query, height int64) *types.MsgSubmitQueryResponse {
+	res := s.HostChain.App.Query(abci.RequestQuery{
+		Path:   "store/bank/key",
+		Data:   query.Request,
+		Height: height,
+		Prove:  true,
+	})
+	s.Require().Zero(res.Code, res.Log)
+	return &types.MsgSubmitQueryResponse{
+		ChainId:     query.ChainId,
+		QueryId:     query.Id,
+		Result:      res.Value,
+		ProofOps:    res.ProofOps,
+		Height:      height,
+		FromAddress: s.StrideChain.SenderAccount.GetAddress().String(),
+	}
+}
+
+func (s *MsgServerTestSuite) TestSubmitQueryResponseAtHistoricalHeight() {
+	// the state at height h is committed to in the header of height h+1, which the stride client is updated to
+	historicalHeight := s.HostChain.LastHeader.Header.Height - 1
+	query := s.makeQuery(historicalHeight)
+
+	// the host moves on before the query is answered
+	s.Coordinator.CommitNBlocks(s.HostChain, 3)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	ctx := s.StrideChain.GetContext()
+
+	// a response at the latest height is rejected
+	latestResponse := s.queryHost(query, s.HostChain.LastHeader.Header.Height-1)
+	_, err := msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), latestResponse)
+	s.Require().ErrorIs(err, types.ErrInvalidHeight)
+
+	// a response at the requested height is verified against the consensus state there
+	response := s.queryHost(query, historicalHeight)
+	s.Require().NotEmpty(response.Result)
+	_, err = msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().NoError(err)
+
+	datapoint, err := s.App.InterchainqueryKeeper.GetDatapointForId(ctx, query.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(historicalHeight, datapoint.RemoteHeight.Int64(), "remote height")
+	s.Require().Equal(response.Result, datapoint.Value)
+}
+
+func (s *MsgServerTestSuite) TestSubmitQueryResponseInvalidProof() {
+	height := s.HostChain.LastHeader.Header.Height - 1
+	query := s.makeQuery(height)
+	response := s.queryHost(query, height)
+	response.Result = []byte("forged")
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	_, err := msgServer.SubmitQueryResponse(sdk.WrapSDKContext(s.StrideChain.GetContext()), response)
+	s.Require().ErrorContains(err, "unable to verify proof")
+}
+
+func (s *MsgServerTestSuite) TestMakeRequestHeight() {
+	ctx := s.StrideChain.GetContext()
+	err := s.App.InterchainqueryKeeper.MakeRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID,
+		types.BANK_STORE_QUERY_WITH_PROOF, s.balanceRequest(), sdk.NewInt(-1), "", "", 0, -1, types.TimeoutPolicy{})
+	s.Require().ErrorContains(err, "cannot be negative")
+
+	// the query's height is emitted for the relayer
+	query := s.makeQuery(5)
+	s.App.InterchainqueryKeeper.EndBlocker(ctx)
+	emitted := false
+	for _, event := range ctx.EventManager().Events() {
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+		if attributes[types.AttributeKeyQueryId] == query.Id {
+			s.Require().Equal("5", attributes[types.AttributeKeyHeight], "emitted height")
+			emitted = true
+		}
+	}
+	s.Require().True(emitted, "query emitted")
+}
+
+func (s *MsgServerTestSuite) TestMakeRequestQueryType() {
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+	makeRequest := func(queryType string, request []byte) error {
+		return k.MakeRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID, queryType, request,
+			sdk.NewInt(-1), "", "", 0, 0, types.TimeoutPolicy{})
+	}
+
+	err := makeRequest("store/gov/key", []byte{1})
+	s.Require().ErrorIs(err, types.ErrInvalidQueryType, "unregistered type")
+	err = makeRequest(types.BANK_STORE_QUERY_WITH_PROOF, types.NewValidatorRequest(sdk.ValAddress(s.HostChain.SenderAccount.GetAddress())))
+	s.Require().ErrorIs(err, types.ErrInvalidQueryType, "key of another store")
+
+	k.RegisterQueryType("store/gov/key", []byte{1})
+	s.Require().NoError(makeRequest("store/gov/key", []byte{1, 2}))
+}
+
+// emitQuery registers a latest-height balance query and emits it, recording the client's latest host height
+func (s *MsgServerTestSuite) emitQuery() types.Query {
+	query := s.makeQuery(0)
+	ctx := s.StrideChain.GetContext()
+	s.App.InterchainqueryKeeper.EndBlocker(ctx)
+
+	query, found := s.App.InterchainqueryKeeper.GetQuery(ctx, query.Id)
+	s.Require().True(found)
+	s.Require().Equal(s.path.EndpointA.GetClientState().GetLatestHeight().GetRevisionHeight(), query.EmittedHostHeight, "emitted host height")
+	return query
+}
+
+func (s *MsgServerTestSuite) TestSubmitQueryResponseBeforeEmission() {
+	query := s.emitQuery()
+
+	// the host moves on and the client is updated, so both the old and new state can be proven
+	s.Coordinator.CommitBlock(s.HostChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	ctx := s.StrideChain.GetContext()
+
+	// state from before the query was emitted is rejected
+	_, err := msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), s.queryHost(query, int64(query.EmittedHostHeight)-1))
+	s.Require().ErrorIs(err, types.ErrStaleResponse)
+
+	// state from the emission height onwards is accepted
+	responseHeight := s.HostChain.LastHeader.Header.Height - 1
+	s.Require().GreaterOrEqual(responseHeight, int64(query.EmittedHostHeight))
+	_, err = msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), s.queryHost(query, responseHeight))
+	s.Require().NoError(err)
+
+	datapoint, err := s.App.InterchainqueryKeeper.GetDatapointForId(ctx, query.Id)
+	s.Require().NoError(err)
+	s.Require().Equal(responseHeight, datapoint.RemoteHeight.Int64(), "remote height")
+	s.Require().Equal(query.EmittedHostHeight, datapoint.EmittedHostHeight, "emitted host height")
+}
+
+func (s *MsgServerTestSuite) TestSubmitQueryResponseMaxAge() {
+	query := s.emitQuery()
+	s.Coordinator.CommitBlock(s.HostChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	responseHeight := s.HostChain.LastHeader.Header.Height - 1
+
+	// by the time the response is submitted, the client has moved further ahead than the freshness window
+	s.Coordinator.CommitNBlocks(s.HostChain, 5)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	ctx := s.StrideChain.GetContext()
+	params := types.DefaultParams()
+	params.MaxResponseAge = 2
+	s.App.InterchainqueryKeeper.SetParams(ctx, params)
+	response := s.queryHost(query, responseHeight)
+
+	_, err := msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().ErrorIs(err, types.ErrStaleResponse)
+	s.Require().ErrorContains(err, "blocks behind the latest client height")
+
+	// a wider window accepts it
+	params.MaxResponseAge = 10
+	s.App.InterchainqueryKeeper.SetParams(ctx, params)
+	_, err = msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().NoError(err)
+}
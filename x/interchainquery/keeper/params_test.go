@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+func TestGetParams(t *testing.T) {
+	k, ctx := testkeeper.InterchainqueryKeeper(t)
+	params := types.NewParams(25, 100_000, []types.CallbackGasLimit{{Module: "stakeibc", GasLimit: 200_000}})
+
+	k.SetParams(ctx, params)
+
+	require.EqualValues(t, params, k.GetParams(ctx))
+	require.Equal(t, uint64(200_000), params.GetCallbackGasLimit("stakeibc"), "module override")
+	require.Equal(t, uint64(100_000), params.GetCallbackGasLimit("records"), "default")
+}
+
+func TestValidateParams(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.CallbackGasLimits = []types.CallbackGasLimit{{Module: "stakeibc", GasLimit: 1}, {Module: "stakeibc", GasLimit: 2}}
+	require.ErrorContains(t, params.Validate(), "duplicate callback gas limit")
+
+	params.CallbackGasLimits = []types.CallbackGasLimit{{GasLimit: 1}}
+	require.ErrorContains(t, params.Validate(), "module cannot be empty")
+}
@@ -15,6 +15,11 @@ func GenerateQueryHash(connection_id string, chain_id string, query_type string,
 	return fmt.Sprintf("%x", crypto.Sha256(append([]byte(module+connection_id+chain_id+query_type+strconv.FormatInt(height, 10)), request...)))
 }
 
+// GenerateBatchQueryHash returns the id of a batched query, which hashes its keys in order
+func GenerateBatchQueryHash(connection_id string, chain_id string, query_type string, requests [][]byte, module string, height int64) string {
+	return GenerateQueryHash(connection_id, chain_id, query_type, types.BatchRequestsKey(requests), module, height)
+}
+
 // ----------------------------------------------------------------
 
 func (k Keeper) NewQuery(ctx sdk.Context, module string, connection_id string, chain_id string, query_type string, request []byte, period sdk.Int, callback_id string, ttl uint64, height int64) *types.Query {
@@ -38,12 +43,46 @@ func (k Keeper) SetQuery(ctx sdk.Context, query types.Query) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
 	bz := k.cdc.MustMarshal(&query)
 	store.Set([]byte(query.Id), bz)
+
+	timeoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTimeoutQuery)
+	if canTimeOut(query) {
+		timeoutStore.Set([]byte(query.Id), []byte{})
+	} else {
+		timeoutStore.Delete([]byte(query.Id))
+	}
 }
 
 // DeleteQuery delete query info
 func (k Keeper) DeleteQuery(ctx sdk.Context, id string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
 	store.Delete([]byte(id))
+
+	timeoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTimeoutQuery)
+	timeoutStore.Delete([]byte(id))
+}
+
+// canTimeOut returns whether the query is a one-shot query with a timeout policy. Periodic queries are emitted again
+// every period, so they never time out
+func canTimeOut(query types.Query) bool {
+	policy := query.TimeoutPolicy
+	return !query.Period.IsNil() && query.Period.IsNegative() && (policy.Blocks != 0 || policy.Duration != 0)
+}
+
+// TimeoutQueries returns the queries that can time out, which are indexed apart so they're checked without
+// iterating every query
+func (k Keeper) TimeoutQueries(ctx sdk.Context) []types.Query {
+	timeoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTimeoutQuery)
+	iterator := sdk.KVStorePrefixIterator(timeoutStore, nil)
+	defer iterator.Close()
+
+	queries := []types.Query{}
+	for ; iterator.Valid(); iterator.Next() {
+		query, found := k.GetQuery(ctx, string(iterator.Key()))
+		if found {
+			queries = append(queries, query)
+		}
+	}
+	return queries
 }
 
 // IterateQueries iterate through queries
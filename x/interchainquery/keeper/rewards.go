@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+// FundQueryReward moves a reward from the requesting module's account into the query's reward pool. The pool is
+// paid to the relayer whose response is accepted first, or returned to the module if the query fails
+func (k Keeper) FundQueryReward(ctx sdk.Context, module string, queryId string, reward sdk.Coins) error {
+	query, found := k.GetQuery(ctx, queryId)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "query %s not found", queryId)
+	}
+	if query.RewardModule != "" && query.RewardModule != module {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query %s reward is funded by %s, not %s", queryId, query.RewardModule, module)
+	}
+	if !reward.IsValid() || reward.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid reward %s", reward)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, module, types.ModuleName, reward); err != nil {
+		return sdkerrors.Wrapf(err, "unable to fund query %s reward from %s", queryId, module)
+	}
+	query.Reward = query.Reward.Add(reward...)
+	query.RewardModule = module
+	k.SetQuery(ctx, query)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Funded query %s reward with %s from %s", queryId, reward, module))
+	return nil
+}
+
+// payQueryReward pays the query's reward pool to the relayer that answered it, and empties the pool
+func (k Keeper) payQueryReward(ctx sdk.Context, query *types.Query, relayer sdk.AccAddress) error {
+	if query.Reward.IsZero() {
+		return nil
+	}
+	reward := query.Reward
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, reward); err != nil {
+		return sdkerrors.Wrapf(err, "unable to pay query %s reward to %s", query.Id, relayer)
+	}
+	k.clearQueryReward(ctx, query)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQueryReward),
+			sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+	)
+	return nil
+}
+
+// refundQueryReward returns the reward pool of a query that failed to the module that funded it
+func (k Keeper) refundQueryReward(ctx sdk.Context, query *types.Query) error {
+	if query.Reward.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, query.RewardModule, query.Reward); err != nil {
+		return sdkerrors.Wrapf(err, "unable to refund query %s reward to %s", query.Id, query.RewardModule)
+	}
+	k.clearQueryReward(ctx, query)
+	return nil
+}
+
+// clearQueryReward empties the reward pool of the query and of its stored copy, which a callback may have
+// re-requested in the meantime
+func (k Keeper) clearQueryReward(ctx sdk.Context, query *types.Query) {
+	query.Reward = sdk.Coins{}
+	if stored, found := k.GetQuery(ctx, query.Id); found {
+		stored.Reward = sdk.Coins{}
+		k.SetQuery(ctx, stored)
+	}
+}
+
+// SetResponseFeeRefund records the fee of the current tx, which only contains query responses, to be refunded once
+// all of them have been accepted. The refund is kept in the transient store, so it's dropped with the block
+func (k Keeper) SetResponseFeeRefund(ctx sdk.Context, feePayer sdk.AccAddress, fee sdk.Coins, responses int) {
+	store := prefix.NewStore(ctx.TransientStore(k.tstoreKey), types.KeyPrefixResponseFeeRefund)
+	refund := types.ResponseFeeRefund{
+		FeePayer:         feePayer.String(),
+		Fee:              fee,
+		PendingResponses: uint64(responses),
+	}
+	store.Set(tmhash.Sum(ctx.TxBytes()), k.cdc.MustMarshal(&refund))
+}
+
+// settleResponseFee counts an accepted response of the current tx, and refunds the tx's fee from the fee collector
+// once every response of the tx has been accepted. It runs with the msg, so the refund is reverted if the tx fails
+func (k Keeper) settleResponseFee(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.TransientStore(k.tstoreKey), types.KeyPrefixResponseFeeRefund)
+	key := tmhash.Sum(ctx.TxBytes())
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil
+	}
+	refund := types.ResponseFeeRefund{}
+	k.cdc.MustUnmarshal(bz, &refund)
+
+	refund.PendingResponses--
+	if refund.PendingResponses > 0 {
+		store.Set(key, k.cdc.MustMarshal(&refund))
+		return nil
+	}
+	store.Delete(key)
+
+	feePayer, err := sdk.AccAddressFromBech32(refund.FeePayer)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, feePayer, refund.Fee); err != nil {
+		return sdkerrors.Wrapf(err, "unable to refund query response fee to %s", feePayer)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+var queryReward = sdk.NewCoins(sdk.NewInt64Coin("ustrd", 100))
+
+// fundReward mints a reward to the stakeibc module account and funds the query's reward pool with it
+func (s *MsgServerTestSuite) fundReward(queryId string) {
+	ctx := s.StrideChain.GetContext()
+	s.Require().NoError(s.App.BankKeeper.MintCoins(ctx, stakeibctypes.ModuleName, queryReward))
+	s.Require().NoError(s.App.InterchainqueryKeeper.FundQueryReward(ctx, stakeibctypes.ModuleName, queryId, queryReward))
+
+	query, found := s.App.InterchainqueryKeeper.GetQuery(ctx, queryId)
+	s.Require().True(found)
+	s.Require().Equal(queryReward, query.Reward, "query reward")
+	s.Require().Equal(stakeibctypes.ModuleName, query.RewardModule, "query reward module")
+}
+
+func (s *MsgServerTestSuite) TestQueryRewardPaidToFirstResponder() {
+	query := s.emitQuery()
+	s.fundReward(query.Id)
+	s.Coordinator.CommitBlock(s.HostChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+
+	msgServer := keeper.NewMsgServerImpl(s.App.InterchainqueryKeeper)
+	ctx := s.StrideChain.GetContext()
+	response := s.queryHost(query, s.HostChain.LastHeader.Header.Height-1)
+	relayer := s.StrideChain.SenderAccount.GetAddress()
+	balanceBefore := s.App.BankKeeper.GetBalance(ctx, relayer, "ustrd")
+
+	_, err := msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().NoError(err)
+	balance := s.App.BankKeeper.GetBalance(ctx, relayer, "ustrd")
+	s.Require().Equal(balanceBefore.Add(queryReward[0]), balance, "relayer paid")
+
+	// the duplicate response is rejected and isn't paid
+	_, err = msgServer.SubmitQueryResponse(sdk.WrapSDKContext(ctx), response)
+	s.Require().ErrorIs(err, types.ErrAlreadyFulfilled)
+	s.Require().Equal(balance, s.App.BankKeeper.GetBalance(ctx, relayer, "ustrd"), "duplicate not paid")
+
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(ctx, moduleAddress).IsZero(), "reward pool empty")
+}
+
+func (s *MsgServerTestSuite) TestQueryRewardRefundedOnFailure() {
+	_, queryId := s.makeTimeoutQuery(types.TimeoutPolicy{Blocks: 1})
+	s.fundReward(queryId)
+
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+	k.EndBlocker(ctx)
+	k.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	_, found := k.GetQuery(ctx, queryId)
+	s.Require().False(found, "query failed")
+
+	stakeibcAddress := s.App.AccountKeeper.GetModuleAddress(stakeibctypes.ModuleName)
+	s.Require().Equal(queryReward, s.App.BankKeeper.GetAllBalances(ctx, stakeibcAddress), "reward refunded")
+}
+
+func (s *MsgServerTestSuite) TestFundQueryRewardOtherModule() {
+	query := s.emitQuery()
+	s.fundReward(query.Id)
+
+	err := s.App.InterchainqueryKeeper.FundQueryReward(s.StrideChain.GetContext(), "records", query.Id, queryReward)
+	s.Require().ErrorContains(err, "reward is funded by stakeibc")
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+// GetLatestHostHeight returns the latest host chain height known to the light client behind the connection, and
+// the host chain time (ns) at that height
+func (k Keeper) GetLatestHostHeight(ctx sdk.Context, connectionId string) (height uint64, timestamp uint64, err error) {
+	connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, connectionId)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(connectiontypes.ErrConnectionNotFound, "connection %s not found", connectionId)
+	}
+	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, connection.ClientId)
+	if !found {
+		return 0, 0, fmt.Errorf("unable to fetch client state for client %s", connection.ClientId)
+	}
+	latestHeight := clientState.GetLatestHeight()
+	consensusState, found := k.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, connection.ClientId, latestHeight)
+	if !found {
+		return 0, 0, fmt.Errorf("unable to fetch consensus state for client %s at height %s", connection.ClientId, latestHeight)
+	}
+	return latestHeight.GetRevisionHeight(), consensusState.GetTimestamp(), nil
+}
+
+// IsQueryTimedOut returns true if an emitted one-shot query has waited longer than its timeout policy allows.
+// Periodic queries are emitted again every period, so they never time out
+func (k Keeper) IsQueryTimedOut(ctx sdk.Context, query types.Query) bool {
+	if !query.Period.IsNegative() || query.LastHeight.IsZero() {
+		return false
+	}
+	policy := query.TimeoutPolicy
+	if policy.Blocks != 0 && ctx.BlockHeight() >= query.LastHeight.Int64()+int64(policy.Blocks) {
+		return true
+	}
+	if policy.Duration != 0 && query.EmittedHostTimestamp != 0 {
+		_, hostTimestamp, err := k.GetLatestHostHeight(ctx, query.ConnectionId)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to get host timestamp for query %s: %s", query.Id, err.Error()))
+			return false
+		}
+		if hostTimestamp >= query.EmittedHostTimestamp+policy.Duration {
+			return true
+		}
+	}
+	return false
+}
+
+// setMissingHostTimestamp records the host time of a query that was emitted while the light client couldn't be read,
+// once it can be, so that its duration timeout still applies. It's measured from then instead of from the emission
+func (k Keeper) setMissingHostTimestamp(ctx sdk.Context, query *types.Query) {
+	if query.TimeoutPolicy.Duration == 0 || query.LastHeight.IsZero() || query.EmittedHostTimestamp != 0 {
+		return
+	}
+	_, hostTimestamp, err := k.GetLatestHostHeight(ctx, query.ConnectionId)
+	if err != nil {
+		return
+	}
+	query.EmittedHostTimestamp = hostTimestamp
+	k.SetQuery(ctx, *query)
+}
+
+// HandleQueryTimeouts re-emits one-shot queries that timed out, and fails the ones that are out of retries.
+// A failed query is deleted after its module's timeout callback is called
+func (k Keeper) HandleQueryTimeouts(ctx sdk.Context) {
+	for _, query := range k.TimeoutQueries(ctx) {
+		k.setMissingHostTimestamp(ctx, &query)
+		if !k.IsQueryTimedOut(ctx, query) {
+			continue
+		}
+
+		labels := []metrics.Label{
+			telemetry.NewLabel(types.MetricLabelChainId, query.ChainId),
+			telemetry.NewLabel(types.MetricLabelCallbackId, query.CallbackId),
+		}
+
+		if query.Retries < query.TimeoutPolicy.MaxRetries {
+			k.Logger(ctx).Info(fmt.Sprintf("Interchainquery %s timed out, retrying (%d/%d)", query.Id, query.Retries+1, query.TimeoutPolicy.MaxRetries))
+			query.Retries++
+			// a zero last height makes the EndBlocker emit the query again
+			query.LastHeight = sdk.ZeroInt()
+			k.SetQuery(ctx, query)
+			telemetry.IncrCounterWithLabels([]string{types.ModuleName, types.MetricKeyQueryRetried}, 1, labels)
+			continue
+		}
+
+		k.Logger(ctx).Error(fmt.Sprintf("Interchainquery %s timed out after %d retries", query.Id, query.Retries))
+		k.callTimeoutCallbacks(ctx, query)
+		if err := k.refundQueryReward(ctx, &query); err != nil {
+			k.Logger(ctx).Error(err.Error())
+		}
+		k.DeleteQuery(ctx, query.Id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQueryTimeout),
+				sdk.NewAttribute(types.AttributeKeyQueryId, query.Id),
+				sdk.NewAttribute(types.AttributeKeyChainId, query.ChainId),
+				sdk.NewAttribute(types.AttributeKeyConnectionId, query.ConnectionId),
+				sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+				sdk.NewAttribute(types.AttributeKeyRetries, strconv.FormatUint(query.Retries, 10)),
+			),
+		)
+		telemetry.IncrCounterWithLabels([]string{types.ModuleName, types.MetricKeyQueryFailed}, 1, labels)
+	}
+}
+
+// callTimeoutCallbacks notifies the modules that registered the query's callback that it failed
+func (k Keeper) callTimeoutCallbacks(ctx sdk.Context, query types.Query) {
+	keys := []string{}
+	for k := range k.callbacks {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		module := k.callbacks[key]
+		if !module.Has(query.CallbackId) {
+			continue
+		}
+		if err := module.CallTimeout(ctx, query.CallbackId, query); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("error executing timeout callback %s for module %s: %s", query.CallbackId, key, err.Error()))
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+const timeoutTestModule = "timeouttest"
+
+// timeoutCallbacks records the queries whose timeout callback was called
+type timeoutCallbacks struct {
+	timedOut *[]string
+}
+
+func (c timeoutCallbacks) Call(ctx sdk.Context, id string, args []byte, query types.Query, height int64) error {
+	return nil
+}
+
+func (c timeoutCallbacks) CallTimeout(ctx sdk.Context, id string, query types.Query) error {
+	*c.timedOut = append(*c.timedOut, query.Id)
+	return nil
+}
+
+func (c timeoutCallbacks) Has(id string) bool {
+	return id == "balance"
+}
+
+func (c timeoutCallbacks) AddCallback(id string, fn interface{}) types.QueryCallbacks {
+	return c
+}
+
+func (c timeoutCallbacks) RegisterCallbacks() types.QueryCallbacks {
+	return c
+}
+
+// makeTimeoutQuery registers a one-shot balance query with the test callback handler and the given timeout policy
+func (s *MsgServerTestSuite) makeTimeoutQuery(policy types.TimeoutPolicy) (*[]string, string) {
+	timedOut := []string{}
+	k := s.App.InterchainqueryKeeper
+	s.Require().NoError(k.SetCallbackHandler(timeoutTestModule, timeoutCallbacks{&timedOut}))
+
+	ctx := s.StrideChain.GetContext()
+	request := s.balanceRequest()
+	err := k.MakeRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID, types.BANK_STORE_QUERY_WITH_PROOF,
+		request, sdk.NewInt(-1), timeoutTestModule, "balance", 0, 0, policy)
+	s.Require().NoError(err)
+
+	queryId := keeper.GenerateQueryHash(s.path.EndpointA.ConnectionID, s.HostChain.ChainID,
+		types.BANK_STORE_QUERY_WITH_PROOF, request, timeoutTestModule, 0)
+	return &timedOut, queryId
+}
+
+func (s *MsgServerTestSuite) TestQueryTimeoutRetriesThenFails() {
+	timedOut, queryId := s.makeTimeoutQuery(types.TimeoutPolicy{Blocks: 5, MaxRetries: 1})
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+	height := ctx.BlockHeight()
+
+	k.EndBlocker(ctx)
+	query, found := k.GetQuery(ctx, queryId)
+	s.Require().True(found)
+	s.Require().Equal(height, query.LastHeight.Int64(), "query emitted")
+
+	// not timed out yet
+	k.EndBlocker(ctx.WithBlockHeight(height + 4))
+	query, _ = k.GetQuery(ctx, queryId)
+	s.Require().Equal(uint64(0), query.Retries, "retries before timeout")
+
+	// the first timeout re-emits the query
+	ctx = ctx.WithBlockHeight(height + 5)
+	k.EndBlocker(ctx)
+	query, found = k.GetQuery(ctx, queryId)
+	s.Require().True(found, "query retried")
+	s.Require().Equal(uint64(1), query.Retries, "retries after timeout")
+	s.Require().Equal(height+5, query.LastHeight.Int64(), "query emitted again")
+	s.Require().Empty(*timedOut, "no timeout callback while retrying")
+
+	// the second timeout fails the query
+	ctx = ctx.WithBlockHeight(height + 10)
+	k.EndBlocker(ctx)
+	_, found = k.GetQuery(ctx, queryId)
+	s.Require().False(found, "failed query removed")
+	s.Require().Equal([]string{queryId}, *timedOut, "timeout callback called")
+}
+
+func (s *MsgServerTestSuite) TestQueryTimeoutHostDuration() {
+	timedOut, queryId := s.makeTimeoutQuery(types.TimeoutPolicy{Duration: 1})
+	k := s.App.InterchainqueryKeeper
+
+	ctx := s.StrideChain.GetContext()
+	k.EndBlocker(ctx)
+	query, found := k.GetQuery(ctx, queryId)
+	s.Require().True(found)
+	s.Require().NotZero(query.EmittedHostTimestamp, "host timestamp recorded on emission")
+
+	// the host time known to stride hasn't moved
+	k.HandleQueryTimeouts(ctx)
+	_, found = k.GetQuery(ctx, queryId)
+	s.Require().True(found, "query pending")
+
+	// once the client sees a later host block, the query has timed out
+	s.Coordinator.CommitBlock(s.HostChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	ctx = s.StrideChain.GetContext()
+	k.HandleQueryTimeouts(ctx)
+	_, found = k.GetQuery(ctx, queryId)
+	s.Require().False(found, "query failed")
+	s.Require().Equal([]string{queryId}, *timedOut)
+}
+
+func (s *MsgServerTestSuite) TestQueryTimeoutMissingHostTimestamp() {
+	timedOut, queryId := s.makeTimeoutQuery(types.TimeoutPolicy{Duration: 1})
+	k := s.App.InterchainqueryKeeper
+
+	// the query was emitted while the light client couldn't be read
+	ctx := s.StrideChain.GetContext()
+	k.EndBlocker(ctx)
+	query, found := k.GetQuery(ctx, queryId)
+	s.Require().True(found)
+	query.EmittedHostTimestamp = 0
+	k.SetQuery(ctx, query)
+
+	// the host time is recorded by the next timeout check
+	k.HandleQueryTimeouts(ctx)
+	query, found = k.GetQuery(ctx, queryId)
+	s.Require().True(found, "query pending")
+	s.Require().NotZero(query.EmittedHostTimestamp, "host timestamp recorded")
+
+	// so the query still times out
+	s.Coordinator.CommitBlock(s.HostChain)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	ctx = s.StrideChain.GetContext()
+	k.HandleQueryTimeouts(ctx)
+	_, found = k.GetQuery(ctx, queryId)
+	s.Require().False(found, "query failed")
+	s.Require().Equal([]string{queryId}, *timedOut)
+}
+
+func (s *MsgServerTestSuite) TestTimeoutQueries() {
+	_, queryId := s.makeTimeoutQuery(types.TimeoutPolicy{Blocks: 5})
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+
+	// a periodic query and a query without a timeout policy can't time out
+	k.SetQuery(ctx, types.Query{Id: "periodic", Period: sdk.NewInt(10), LastHeight: sdk.ZeroInt(),
+		TimeoutPolicy: types.TimeoutPolicy{Blocks: 5}})
+	k.SetQuery(ctx, types.Query{Id: "no-policy", Period: sdk.NewInt(-1), LastHeight: sdk.ZeroInt()})
+
+	timeoutQueries := k.TimeoutQueries(ctx)
+	s.Require().Len(timeoutQueries, 1)
+	s.Require().Equal(queryId, timeoutQueries[0].Id)
+
+	k.DeleteQuery(ctx, queryId)
+	s.Require().Empty(k.TimeoutQueries(ctx), "index cleared with the query")
+}
+
+func (s *MsgServerTestSuite) TestDatapointTtl() {
+	k := s.App.InterchainqueryKeeper
+	ctx := s.StrideChain.GetContext()
+	request := s.balanceRequest()
+	err := k.MakeRequest(ctx, s.path.EndpointA.ConnectionID, s.HostChain.ChainID, types.BANK_STORE_QUERY_WITH_PROOF,
+		request, sdk.NewInt(10), "", "", 10, 0, types.TimeoutPolicy{})
+	s.Require().NoError(err)
+	queryId := keeper.GenerateQueryHash(s.path.EndpointA.ConnectionID, s.HostChain.ChainID,
+		types.BANK_STORE_QUERY_WITH_PROOF, request, "", 0)
+
+	height := ctx.BlockHeight()
+	s.Require().NoError(k.SetDatapointForId(ctx, queryId, []byte{1}, sdk.NewInt(1), 0))
+
+	// datapoints within their ttl are kept
+	k.EndBlocker(ctx.WithBlockHeight(height + 10))
+	_, err = k.GetDatapointForId(ctx, queryId)
+	s.Require().NoError(err, "fresh datapoint kept")
+
+	// older datapoints are removed
+	k.EndBlocker(ctx.WithBlockHeight(height + 11))
+	_, err = k.GetDatapointForId(ctx, queryId)
+	s.Require().Error(err, "stale datapoint removed")
+}
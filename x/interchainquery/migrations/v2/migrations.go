@@ -0,0 +1,17 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+// MigrateParams sets the interchainquery params, which chains that started before v2 don't have, to their defaults
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	params := types.DefaultParams()
+	paramSpace.SetParamSet(ctx, &params)
+
+	paramSpace.GetParamSet(ctx, &params)
+	return params.Validate()
+}
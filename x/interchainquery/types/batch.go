@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsBatch returns whether the query is a batched query, answered with a result and a proof per key
+func (q Query) IsBatch() bool {
+	return len(q.BatchRequests) > 0
+}
+
+// BatchRequestsKey joins the keys of a batched query, length prefixed so that different batches can't join to the
+// same bytes. Batched queries are identified by the hash of their joined keys
+func BatchRequestsKey(requests [][]byte) []byte {
+	key := []byte{}
+	for _, request := range requests {
+		key = append(key, sdk.Uint64ToBigEndian(uint64(len(request)))...)
+		key = append(key, request...)
+	}
+	return key
+}
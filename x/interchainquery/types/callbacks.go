@@ -7,6 +7,9 @@ import (
 type QueryCallbacks interface {
 	AddCallback(id string, fn interface{}) QueryCallbacks
 	RegisterCallbacks() QueryCallbacks
-	Call(ctx sdk.Context, id string, args []byte, query Query) error
+	// Call executes the callback with the query result, and the host height the result was proven at
+	Call(ctx sdk.Context, id string, args []byte, query Query, height int64) error
+	// CallTimeout is called instead of Call when a one-shot query runs out of retries without a response
+	CallTimeout(ctx sdk.Context, id string, query Query) error
 	Has(id string) bool
 }
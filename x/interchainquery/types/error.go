@@ -5,4 +5,7 @@ import "errors"
 var (
 	ErrAlreadyFulfilled  = errors.New("query already fulfilled")
 	ErrSucceededNoDelete = errors.New("query succeeded; do not not execute default behavior")
+	ErrInvalidHeight     = errors.New("query response is not at the requested height")
+	ErrStaleResponse     = errors.New("query response is stale")
+	ErrInvalidQueryType  = errors.New("invalid query type")
 )
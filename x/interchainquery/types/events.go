@@ -7,8 +7,17 @@ const (
 	AttributeKeyType         = "type"
 	AttributeKeyParams       = "parameters"
 	AttributeKeyRequest      = "request"
+	AttributeKeyRequests     = "requests"
 	AttributeKeyHeight       = "height"
+	AttributeKeyRetries      = "retries"
+	AttributeKeyRelayer      = "relayer"
+	AttributeKeyReward       = "reward"
+	AttributeKeyCallbackId   = "callback_id"
+	AttributeKeyError        = "error"
 
-	AttributeValueCategory = ModuleName
-	AttributeValueQuery    = "query"
+	AttributeValueCategory        = ModuleName
+	AttributeValueQuery           = "query"
+	AttributeValueQueryTimeout    = "query_timeout"
+	AttributeValueQueryReward     = "query_reward"
+	AttributeValueCallbackFailure = "callback_failure"
 )
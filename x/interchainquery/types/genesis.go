@@ -1,18 +1,90 @@
 package types
 
-func NewGenesisState(queries []Query) *GenesisState {
-	return &GenesisState{Queries: queries}
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+func NewGenesisState(queries []Query, datapoints []DataPoint, callbackFailures []CallbackFailure, params Params) *GenesisState {
+	return &GenesisState{Queries: queries, Datapoints: datapoints, CallbackFailures: callbackFailures, Params: params}
 }
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	queries := []Query{}
-	return NewGenesisState(queries)
+	return NewGenesisState([]Query{}, []DataPoint{}, []CallbackFailure{}, DefaultParams())
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// TODO: validate genesis state.
+	queryIds := map[string]bool{}
+	for _, query := range gs.Queries {
+		if err := query.Validate(); err != nil {
+			return err
+		}
+		if queryIds[query.Id] {
+			return fmt.Errorf("duplicate query %s", query.Id)
+		}
+		queryIds[query.Id] = true
+	}
+
+	datapointIds := map[string]bool{}
+	for _, datapoint := range gs.Datapoints {
+		// datapoints of queries that no longer exist are removed by the EndBlocker
+		if !queryIds[datapoint.Id] {
+			return fmt.Errorf("datapoint %s has no query", datapoint.Id)
+		}
+		if datapointIds[datapoint.Id] {
+			return fmt.Errorf("duplicate datapoint %s", datapoint.Id)
+		}
+		datapointIds[datapoint.Id] = true
+		if datapoint.RemoteHeight.IsNil() || datapoint.RemoteHeight.IsNegative() {
+			return fmt.Errorf("datapoint %s remote height must be non-negative", datapoint.Id)
+		}
+		if datapoint.LocalHeight.IsNil() || datapoint.LocalHeight.IsNegative() {
+			return fmt.Errorf("datapoint %s local height must be non-negative", datapoint.Id)
+		}
+	}
+
+	// the failures of queries that ran out of retries are kept after the query is deleted
+	failureIds := map[string]bool{}
+	for _, failure := range gs.CallbackFailures {
+		if failure.QueryId == "" {
+			return fmt.Errorf("callback failure query id cannot be empty")
+		}
+		if failureIds[failure.QueryId] {
+			return fmt.Errorf("duplicate callback failure for query %s", failure.QueryId)
+		}
+		failureIds[failure.QueryId] = true
+	}
+
+	return gs.Params.Validate()
+}
+
+// Validate checks that the query can be emitted
+func (q Query) Validate() error {
+	if q.Id == "" {
+		return fmt.Errorf("query id cannot be empty")
+	}
+	if q.ChainId == "" {
+		return fmt.Errorf("query %s chain id cannot be empty", q.Id)
+	}
+	if err := host.ConnectionIdentifierValidator(q.ConnectionId); err != nil {
+		return fmt.Errorf("query %s has an invalid connection id: %s", q.Id, err.Error())
+	}
+	// one-shot queries have a negative period, periodic queries are emitted every period blocks
+	if q.Period.IsNil() || q.Period.IsZero() {
+		return fmt.Errorf("query %s period must be positive, or negative for a one-shot query", q.Id)
+	}
+	if q.LastHeight.IsNil() || q.LastHeight.IsNegative() {
+		return fmt.Errorf("query %s last height must be non-negative", q.Id)
+	}
+	if q.Height < 0 {
+		return fmt.Errorf("query %s height cannot be negative", q.Id)
+	}
+	if len(q.Request) == 0 && !q.IsBatch() {
+		return fmt.Errorf("query %s has no request", q.Id)
+	}
 	return nil
 }
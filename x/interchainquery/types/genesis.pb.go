@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -36,6 +37,24 @@ type Query struct {
 	CallbackId   string                                 `protobuf:"bytes,8,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	Ttl          uint64                                 `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	Height       int64                                  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	// when a one-shot query that hasn't been answered times out
+	TimeoutPolicy TimeoutPolicy `protobuf:"bytes,11,opt,name=timeout_policy,json=timeoutPolicy,proto3" json:"timeout_policy"`
+	// number of times the query was emitted again after timing out
+	Retries uint64 `protobuf:"varint,12,opt,name=retries,proto3" json:"retries,omitempty"`
+	// host chain time (ns) known to the light client when the query was last emitted
+	EmittedHostTimestamp uint64 `protobuf:"varint,13,opt,name=emitted_host_timestamp,json=emittedHostTimestamp,proto3" json:"emitted_host_timestamp,omitempty"`
+	// latest host height known to the light client when the query was last emitted. Responses from before it are
+	// rejected, so an old proof can't be replayed for a new query
+	EmittedHostHeight uint64 `protobuf:"varint,14,opt,name=emitted_host_height,json=emittedHostHeight,proto3" json:"emitted_host_height,omitempty"`
+	// whether the query was answered since it was last emitted, later responses are duplicates
+	Answered bool `protobuf:"varint,15,opt,name=answered,proto3" json:"answered,omitempty"`
+	// paid to the relayer whose response is accepted first, held by the interchainquery module account
+	Reward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,16,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
+	// module that funded the reward, which gets it back if the query fails
+	RewardModule string `protobuf:"bytes,17,opt,name=reward_module,json=rewardModule,proto3" json:"reward_module,omitempty"`
+	// keys of a batched query, answered together by one response with a proof per key at the same host height.
+	// request is unset for batched queries
+	BatchRequests [][]byte `protobuf:"bytes,18,rep,name=batch_requests,json=batchRequests,proto3" json:"batch_requests,omitempty"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -127,18 +146,300 @@ func (m *Query) GetHeight() int64 {
 	return 0
 }
 
+func (m *Query) GetTimeoutPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.TimeoutPolicy
+	}
+	return TimeoutPolicy{}
+}
+
+func (m *Query) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Query) GetEmittedHostTimestamp() uint64 {
+	if m != nil {
+		return m.EmittedHostTimestamp
+	}
+	return 0
+}
+
+func (m *Query) GetEmittedHostHeight() uint64 {
+	if m != nil {
+		return m.EmittedHostHeight
+	}
+	return 0
+}
+
+func (m *Query) GetAnswered() bool {
+	if m != nil {
+		return m.Answered
+	}
+	return false
+}
+
+func (m *Query) GetReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
+}
+
+func (m *Query) GetRewardModule() string {
+	if m != nil {
+		return m.RewardModule
+	}
+	return ""
+}
+
+func (m *Query) GetBatchRequests() [][]byte {
+	if m != nil {
+		return m.BatchRequests
+	}
+	return nil
+}
+
+// TimeoutPolicy bounds how long a one-shot query waits for a response. A query that times out is emitted again
+// until it has been retried max_retries times, after which it fails and its module's timeout callback is called
+type TimeoutPolicy struct {
+	// stride blocks to wait for a response after the query is emitted, 0 for no limit
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// host chain time (ns) to wait for a response after the query is emitted, as seen by the light client, 0 for
+	// no limit
+	Duration   uint64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	MaxRetries uint64 `protobuf:"varint,3,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+}
+
+func (m *TimeoutPolicy) Reset()         { *m = TimeoutPolicy{} }
+func (m *TimeoutPolicy) String() string { return proto.CompactTextString(m) }
+func (*TimeoutPolicy) ProtoMessage()    {}
+func (*TimeoutPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78d192af57b24e05, []int{1}
+}
+func (m *TimeoutPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeoutPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeoutPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeoutPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeoutPolicy.Merge(m, src)
+}
+func (m *TimeoutPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeoutPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeoutPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeoutPolicy proto.InternalMessageInfo
+
+func (m *TimeoutPolicy) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *TimeoutPolicy) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *TimeoutPolicy) GetMaxRetries() uint64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+// CallbackFailure records the last failed callback of a query that hasn't succeeded since. The failed callback's
+// writes are discarded, but the response it was called with is accepted
+type CallbackFailure struct {
+	QueryId    string `protobuf:"bytes,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Module     string `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+	CallbackId string `protobuf:"bytes,4,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Error      string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// stride block the callback failed at
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// retries of the query when the callback failed
+	Retries uint64 `protobuf:"varint,7,opt,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (m *CallbackFailure) Reset()         { *m = CallbackFailure{} }
+func (m *CallbackFailure) String() string { return proto.CompactTextString(m) }
+func (*CallbackFailure) ProtoMessage()    {}
+func (*CallbackFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78d192af57b24e05, []int{2}
+}
+func (m *CallbackFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallbackFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackFailure.Merge(m, src)
+}
+func (m *CallbackFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackFailure proto.InternalMessageInfo
+
+func (m *CallbackFailure) GetQueryId() string {
+	if m != nil {
+		return m.QueryId
+	}
+	return ""
+}
+
+func (m *CallbackFailure) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *CallbackFailure) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *CallbackFailure) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *CallbackFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CallbackFailure) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CallbackFailure) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+// ResponseFeeRefund is the fee of a tx that only contains query responses, refunded to its payer once every
+// response in the tx has been accepted. It's only kept in the transient store for the tx
+type ResponseFeeRefund struct {
+	FeePayer string                                   `protobuf:"bytes,1,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	Fee      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// responses of the tx that haven't been accepted yet
+	PendingResponses uint64 `protobuf:"varint,3,opt,name=pending_responses,json=pendingResponses,proto3" json:"pending_responses,omitempty"`
+}
+
+func (m *ResponseFeeRefund) Reset()         { *m = ResponseFeeRefund{} }
+func (m *ResponseFeeRefund) String() string { return proto.CompactTextString(m) }
+func (*ResponseFeeRefund) ProtoMessage()    {}
+func (*ResponseFeeRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78d192af57b24e05, []int{3}
+}
+func (m *ResponseFeeRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseFeeRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseFeeRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseFeeRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseFeeRefund.Merge(m, src)
+}
+func (m *ResponseFeeRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseFeeRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseFeeRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseFeeRefund proto.InternalMessageInfo
+
+func (m *ResponseFeeRefund) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *ResponseFeeRefund) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *ResponseFeeRefund) GetPendingResponses() uint64 {
+	if m != nil {
+		return m.PendingResponses
+	}
+	return 0
+}
+
 type DataPoint struct {
 	Id           string                                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RemoteHeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remote_height,json=remoteHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remote_height"`
 	LocalHeight  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=local_height,json=localHeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"local_height"`
 	Value        []byte                                 `protobuf:"bytes,4,opt,name=value,proto3" json:"result,omitempty"`
+	// latest host height known to the light client when the query was emitted, remote_height is the response's
+	EmittedHostHeight uint64 `protobuf:"varint,5,opt,name=emitted_host_height,json=emittedHostHeight,proto3" json:"emitted_host_height,omitempty"`
 }
 
 func (m *DataPoint) Reset()         { *m = DataPoint{} }
 func (m *DataPoint) String() string { return proto.CompactTextString(m) }
 func (*DataPoint) ProtoMessage()    {}
 func (*DataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78d192af57b24e05, []int{1}
+	return fileDescriptor_78d192af57b24e05, []int{4}
 }
 func (m *DataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,16 +482,26 @@ func (m *DataPoint) GetValue() []byte {
 	return nil
 }
 
+func (m *DataPoint) GetEmittedHostHeight() uint64 {
+	if m != nil {
+		return m.EmittedHostHeight
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
-	Queries []Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Queries          []Query           `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Params           Params            `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Datapoints       []DataPoint       `protobuf:"bytes,3,rep,name=datapoints,proto3" json:"datapoints"`
+	CallbackFailures []CallbackFailure `protobuf:"bytes,4,rep,name=callback_failures,json=callbackFailures,proto3" json:"callback_failures"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78d192af57b24e05, []int{2}
+	return fileDescriptor_78d192af57b24e05, []int{5}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,8 +537,32 @@ func (m *GenesisState) GetQueries() []Query {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDatapoints() []DataPoint {
+	if m != nil {
+		return m.Datapoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCallbackFailures() []CallbackFailure {
+	if m != nil {
+		return m.CallbackFailures
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Query)(nil), "stride.interchainquery.Query")
+	proto.RegisterType((*TimeoutPolicy)(nil), "stride.interchainquery.TimeoutPolicy")
+	proto.RegisterType((*CallbackFailure)(nil), "stride.interchainquery.CallbackFailure")
+	proto.RegisterType((*ResponseFeeRefund)(nil), "stride.interchainquery.ResponseFeeRefund")
 	proto.RegisterType((*DataPoint)(nil), "stride.interchainquery.DataPoint")
 	proto.RegisterType((*GenesisState)(nil), "stride.interchainquery.GenesisState")
 }
@@ -235,39 +570,68 @@ func init() {
 func init() { proto.RegisterFile("interchainquery/v1/genesis.proto", fileDescriptor_78d192af57b24e05) }
 
 var fileDescriptor_78d192af57b24e05 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6a, 0xdb, 0x40,
-	0x10, 0xb6, 0x2c, 0xff, 0xc4, 0x63, 0xa5, 0x84, 0x25, 0x04, 0x25, 0x10, 0x59, 0xb8, 0x50, 0x4c,
-	0xa9, 0x25, 0xda, 0xd2, 0x5b, 0x7b, 0x31, 0x85, 0xd6, 0xd0, 0xd2, 0x56, 0xc9, 0xa9, 0x50, 0xcc,
-	0x5a, 0x5a, 0xec, 0x25, 0x92, 0x56, 0xd1, 0x8e, 0x42, 0xfd, 0x0c, 0xbd, 0xe4, 0x61, 0xfa, 0x10,
-	0x39, 0x86, 0x9e, 0x4a, 0x0f, 0xa6, 0xd8, 0xb7, 0x3e, 0x45, 0xd9, 0x95, 0x44, 0x4b, 0xda, 0xa3,
-	0x4f, 0xde, 0x99, 0x6f, 0xe6, 0xfb, 0xe6, 0xb3, 0x66, 0xc0, 0xe5, 0x29, 0xb2, 0x3c, 0x5c, 0x52,
-	0x9e, 0x5e, 0x16, 0x2c, 0x5f, 0xf9, 0x57, 0x8f, 0xfd, 0x05, 0x4b, 0x99, 0xe4, 0xd2, 0xcb, 0x72,
-	0x81, 0x82, 0x1c, 0x49, 0xcc, 0x79, 0xc4, 0xbc, 0x3b, 0x85, 0x27, 0x87, 0x0b, 0xb1, 0x10, 0xba,
-	0xc4, 0x57, 0xaf, 0xb2, 0xfa, 0xe4, 0x38, 0x14, 0x32, 0x11, 0x72, 0x56, 0x02, 0x65, 0x50, 0x42,
-	0xc3, 0x6b, 0x13, 0xda, 0x1f, 0x54, 0x2b, 0xb9, 0x07, 0x4d, 0x1e, 0xd9, 0x86, 0x6b, 0x8c, 0x7a,
-	0x41, 0x93, 0x47, 0xe4, 0x3e, 0xec, 0x87, 0x22, 0x4d, 0x59, 0x88, 0x5c, 0xa4, 0x33, 0x1e, 0xd9,
-	0x4d, 0x0d, 0x59, 0x7f, 0x92, 0xd3, 0x88, 0x1c, 0xc3, 0x9e, 0x56, 0x57, 0xb8, 0xa9, 0xf1, 0xae,
-	0x8e, 0xa7, 0x11, 0x39, 0x05, 0xd0, 0x33, 0xcd, 0x70, 0x95, 0x31, 0xbb, 0xa5, 0xc1, 0x9e, 0xce,
-	0x9c, 0xaf, 0x32, 0x46, 0x6c, 0xe8, 0xe6, 0xec, 0xb2, 0x60, 0x12, 0xed, 0xb6, 0x6b, 0x8c, 0xac,
-	0xa0, 0x0e, 0xc9, 0x39, 0x74, 0x32, 0x96, 0x73, 0x11, 0xd9, 0x1d, 0xd5, 0x34, 0x79, 0x7e, 0xb3,
-	0x1e, 0x34, 0x7e, 0xac, 0x07, 0x0f, 0x16, 0x1c, 0x97, 0xc5, 0xdc, 0x0b, 0x45, 0x52, 0x79, 0xa8,
-	0x7e, 0xc6, 0x32, 0xba, 0xf0, 0x95, 0x8a, 0xf4, 0xa6, 0x29, 0x7e, 0xfb, 0x3a, 0x86, 0xca, 0xe2,
-	0x34, 0xc5, 0xa0, 0xe2, 0x22, 0x9f, 0xa0, 0x1f, 0x53, 0x89, 0xb3, 0x25, 0xe3, 0x8b, 0x25, 0xda,
-	0xdd, 0x1d, 0x50, 0x83, 0x22, 0x7c, 0xad, 0xf9, 0xc8, 0x00, 0xfa, 0x21, 0x8d, 0xe3, 0x39, 0x0d,
-	0x2f, 0xd4, 0x7f, 0xb1, 0xa7, 0xed, 0x42, 0x9d, 0x9a, 0x46, 0xe4, 0x00, 0x4c, 0xc4, 0xd8, 0xee,
-	0xb9, 0xc6, 0xa8, 0x15, 0xa8, 0x27, 0x39, 0x82, 0x4e, 0x35, 0x0c, 0xb8, 0xc6, 0xc8, 0x0c, 0xaa,
-	0x68, 0xf8, 0xa5, 0x09, 0xbd, 0x97, 0x14, 0xe9, 0x7b, 0xc1, 0x53, 0xfc, 0xe7, 0xb3, 0x50, 0xd8,
-	0xcf, 0x59, 0x22, 0x90, 0xd5, 0x4e, 0x9a, 0x3b, 0x70, 0x62, 0x95, 0x94, 0x95, 0x97, 0x19, 0x58,
-	0xb1, 0x08, 0x69, 0x5c, 0x2b, 0x98, 0x3b, 0x50, 0xe8, 0x6b, 0xc6, 0x4a, 0xe0, 0x21, 0xb4, 0xaf,
-	0x68, 0x5c, 0x94, 0x5b, 0x61, 0x4d, 0x0e, 0x7f, 0xad, 0x07, 0x07, 0x39, 0x93, 0x45, 0x8c, 0x8f,
-	0x44, 0xc2, 0x91, 0x25, 0x19, 0xae, 0x82, 0xb2, 0x64, 0xf8, 0x16, 0xac, 0x57, 0xe5, 0xea, 0x9f,
-	0x21, 0x45, 0x46, 0x5e, 0x40, 0x57, 0x2d, 0x11, 0x67, 0xd2, 0x36, 0x5c, 0x73, 0xd4, 0x7f, 0x72,
-	0xea, 0xfd, 0xff, 0x16, 0x3c, 0xbd, 0xd6, 0x93, 0x96, 0x1a, 0x3b, 0xa8, 0x7b, 0x26, 0xef, 0x6e,
-	0x36, 0x8e, 0x71, 0xbb, 0x71, 0x8c, 0x9f, 0x1b, 0xc7, 0xb8, 0xde, 0x3a, 0x8d, 0xdb, 0xad, 0xd3,
-	0xf8, 0xbe, 0x75, 0x1a, 0x1f, 0x9f, 0xfd, 0xe5, 0xeb, 0x4c, 0x33, 0x8e, 0xdf, 0xd0, 0xb9, 0xf4,
-	0x4b, 0x76, 0xff, 0xb3, 0x7f, 0xf7, 0x28, 0xb5, 0xd5, 0x79, 0x47, 0xdf, 0xd1, 0xd3, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa4, 0x2b, 0xde, 0xaf, 0xb4, 0x03, 0x00, 0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x8e, 0x13, 0x3f, 0x3b, 0x69, 0x32, 0x44, 0xd1, 0x26, 0xa8, 0xb6, 0x31, 0x2a,
+	0x58, 0x40, 0xbc, 0x24, 0xc0, 0xad, 0x5c, 0x52, 0xd4, 0x36, 0x12, 0x88, 0xb0, 0xcd, 0xa9, 0x52,
+	0xb5, 0x1a, 0xef, 0xbe, 0xd8, 0xa3, 0xec, 0xee, 0x6c, 0x77, 0x66, 0xd3, 0xf8, 0x5b, 0xf0, 0x39,
+	0x38, 0x81, 0xc4, 0x0d, 0x3e, 0x40, 0x8e, 0x15, 0x27, 0xc4, 0x21, 0xa0, 0xe4, 0xc6, 0xa7, 0x40,
+	0xf3, 0x67, 0x83, 0xed, 0xd6, 0x52, 0x0f, 0x39, 0xed, 0xbe, 0xf7, 0xe6, 0xfd, 0xde, 0xcc, 0x7b,
+	0xbf, 0xdf, 0x0c, 0x74, 0x59, 0x2a, 0x31, 0x0f, 0xc7, 0x94, 0xa5, 0x2f, 0x0b, 0xcc, 0x27, 0xde,
+	0xf9, 0xbe, 0x37, 0xc2, 0x14, 0x05, 0x13, 0x83, 0x2c, 0xe7, 0x92, 0x93, 0x6d, 0x21, 0x73, 0x16,
+	0xe1, 0x60, 0x6e, 0xe1, 0xee, 0xd6, 0x88, 0x8f, 0xb8, 0x5e, 0xe2, 0xa9, 0x3f, 0xb3, 0x7a, 0x77,
+	0x27, 0xe4, 0x22, 0xe1, 0x22, 0x30, 0x01, 0x63, 0xd8, 0x50, 0xdb, 0x58, 0xde, 0x90, 0x0a, 0xf4,
+	0xce, 0xf7, 0x87, 0x28, 0xe9, 0xbe, 0x17, 0x72, 0x96, 0xda, 0x78, 0xe7, 0x2d, 0x5b, 0xc9, 0x68,
+	0x4e, 0x13, 0x0b, 0xd0, 0xfb, 0xa5, 0x0e, 0xcb, 0x3f, 0xa8, 0x08, 0x59, 0x87, 0x0a, 0x8b, 0x5c,
+	0xa7, 0xeb, 0xf4, 0x1b, 0x7e, 0x85, 0x45, 0xe4, 0x43, 0x58, 0x0b, 0x79, 0x9a, 0x62, 0x28, 0x19,
+	0x4f, 0x03, 0x16, 0xb9, 0x15, 0x1d, 0x6a, 0xfd, 0xef, 0x3c, 0x8a, 0xc8, 0x0e, 0xac, 0x6a, 0x70,
+	0x15, 0xaf, 0xea, 0xf8, 0x8a, 0xb6, 0x8f, 0x22, 0x72, 0x1f, 0x40, 0x97, 0x0c, 0xe4, 0x24, 0x43,
+	0xb7, 0xa6, 0x83, 0x0d, 0xed, 0x39, 0x99, 0x64, 0x48, 0x5c, 0x58, 0xc9, 0xf1, 0x65, 0x81, 0x42,
+	0xba, 0xcb, 0x5d, 0xa7, 0xdf, 0xf2, 0x4b, 0x93, 0x9c, 0x40, 0x3d, 0xc3, 0x9c, 0xf1, 0xc8, 0xad,
+	0xab, 0xa4, 0xc3, 0x87, 0x97, 0x57, 0x9d, 0xa5, 0xbf, 0xae, 0x3a, 0x1f, 0x8d, 0x98, 0x1c, 0x17,
+	0xc3, 0x41, 0xc8, 0x13, 0xdb, 0x04, 0xfb, 0xd9, 0x13, 0xd1, 0x99, 0xa7, 0xaa, 0x88, 0xc1, 0x51,
+	0x2a, 0xff, 0xf8, 0x75, 0x0f, 0x6c, 0x8f, 0x8e, 0x52, 0xe9, 0x5b, 0x2c, 0xf2, 0x02, 0x9a, 0x31,
+	0x15, 0x32, 0x18, 0x23, 0x1b, 0x8d, 0xa5, 0xbb, 0x72, 0x07, 0xd0, 0xa0, 0x00, 0x9f, 0x6a, 0x3c,
+	0xd2, 0x81, 0x66, 0x48, 0xe3, 0x78, 0x48, 0xc3, 0x33, 0xd5, 0x8b, 0x55, 0x7d, 0x5c, 0x28, 0x5d,
+	0x47, 0x11, 0xd9, 0x80, 0xaa, 0x94, 0xb1, 0xdb, 0xe8, 0x3a, 0xfd, 0x9a, 0xaf, 0x7e, 0xc9, 0x36,
+	0xd4, 0xed, 0x66, 0xa0, 0xeb, 0xf4, 0xab, 0xbe, 0xb5, 0x88, 0x0f, 0xeb, 0x92, 0x25, 0xc8, 0x0b,
+	0x19, 0x64, 0x3c, 0x66, 0xe1, 0xc4, 0x6d, 0x76, 0x9d, 0x7e, 0xf3, 0xe0, 0xc1, 0xe0, 0xed, 0xac,
+	0x19, 0x9c, 0x98, 0xd5, 0xc7, 0x7a, 0xf1, 0x61, 0x4d, 0x9d, 0xc9, 0x5f, 0x93, 0xd3, 0x4e, 0xd3,
+	0x6d, 0x99, 0x33, 0x14, 0x6e, 0x4b, 0xef, 0xa0, 0x34, 0xc9, 0x97, 0xb0, 0x8d, 0x09, 0x93, 0x12,
+	0xa3, 0x60, 0xcc, 0x85, 0x0c, 0x54, 0x9e, 0x90, 0x34, 0xc9, 0xdc, 0x35, 0xbd, 0x70, 0xcb, 0x46,
+	0x9f, 0x72, 0x21, 0x4f, 0xca, 0x18, 0x19, 0xc0, 0x7b, 0x33, 0x59, 0xf6, 0x20, 0xeb, 0x3a, 0x65,
+	0x73, 0x2a, 0xc5, 0xb6, 0x67, 0x17, 0x56, 0x69, 0x2a, 0x5e, 0x61, 0x8e, 0x91, 0x7b, 0xaf, 0xeb,
+	0xf4, 0x57, 0xfd, 0x5b, 0x9b, 0x84, 0x50, 0xcf, 0xf1, 0x15, 0xcd, 0x23, 0x77, 0xa3, 0x5b, 0xed,
+	0x37, 0x0f, 0x76, 0x06, 0xb6, 0xc7, 0x8a, 0xd4, 0x03, 0x4b, 0xea, 0xc1, 0x23, 0xce, 0xd2, 0xc3,
+	0xcf, 0xd5, 0xd9, 0x7e, 0xfa, 0xbb, 0xd3, 0x7f, 0x87, 0x79, 0xa9, 0x04, 0xe1, 0x5b, 0x68, 0xc5,
+	0x66, 0xf3, 0x17, 0x24, 0x3c, 0x2a, 0x62, 0x74, 0x37, 0x0d, 0x9b, 0x8d, 0xf3, 0x3b, 0xed, 0x23,
+	0x0f, 0x60, 0x7d, 0x48, 0x65, 0x38, 0x0e, 0x2c, 0x15, 0x85, 0x4b, 0xba, 0xd5, 0x7e, 0xcb, 0x5f,
+	0xd3, 0x5e, 0xdf, 0x3a, 0x7b, 0x11, 0xac, 0xcd, 0xb4, 0x5c, 0x4d, 0x72, 0x18, 0xf3, 0xf0, 0x4c,
+	0x68, 0xf9, 0xd4, 0x7c, 0x6b, 0xa9, 0x53, 0x47, 0x45, 0x4e, 0x95, 0x56, 0xb4, 0x7a, 0x6a, 0xfe,
+	0xad, 0xad, 0x08, 0x93, 0xd0, 0x8b, 0xa0, 0x9c, 0x4a, 0x55, 0x87, 0x21, 0xa1, 0x17, 0xbe, 0xf1,
+	0xf4, 0x2e, 0x1d, 0xb8, 0xf7, 0xc8, 0xf2, 0xe7, 0x31, 0x65, 0x71, 0x91, 0xa3, 0x92, 0x9b, 0xd1,
+	0xd4, 0xad, 0x52, 0x57, 0xb4, 0x3d, 0xa7, 0xc4, 0xca, 0xac, 0x12, 0xb7, 0xa1, 0x6e, 0x0f, 0x6d,
+	0x24, 0x6a, 0xad, 0x79, 0xce, 0xd6, 0xde, 0xe0, 0xec, 0x16, 0x2c, 0x63, 0x9e, 0xf3, 0x5c, 0x2b,
+	0xb4, 0xe1, 0x1b, 0x63, 0x8a, 0xb7, 0xf5, 0x19, 0xde, 0x4e, 0x71, 0x6c, 0x65, 0x86, 0x63, 0xbd,
+	0xdf, 0x1d, 0xd8, 0xf4, 0x51, 0x64, 0x3c, 0x15, 0xf8, 0x18, 0xd1, 0xc7, 0xd3, 0x22, 0x8d, 0xc8,
+	0xfb, 0xd0, 0x38, 0x45, 0x0c, 0x32, 0x3a, 0xc1, 0xdc, 0x9e, 0x66, 0xf5, 0x14, 0xf1, 0x58, 0xd9,
+	0xe4, 0x05, 0x54, 0x4f, 0x11, 0xdd, 0xca, 0xdd, 0x33, 0x42, 0xe1, 0x92, 0x4f, 0x61, 0x33, 0xc3,
+	0x34, 0x62, 0xe9, 0x28, 0xc8, 0xed, 0xc6, 0xca, 0x19, 0x6c, 0xd8, 0x40, 0xb9, 0x61, 0xd1, 0xfb,
+	0xad, 0x02, 0x8d, 0x6f, 0xa8, 0xa4, 0xc7, 0x9c, 0xa5, 0xf2, 0x8d, 0x7b, 0x92, 0x2a, 0x66, 0x25,
+	0x5c, 0x62, 0x29, 0x82, 0xca, 0x1d, 0x5c, 0x2d, 0x2d, 0x03, 0x69, 0xd5, 0x13, 0x40, 0x2b, 0xe6,
+	0x21, 0x8d, 0xcb, 0x0a, 0xd5, 0x3b, 0xa8, 0xd0, 0xd4, 0x88, 0xb6, 0xc0, 0x27, 0xb0, 0x7c, 0x4e,
+	0xe3, 0xc2, 0x5c, 0xd3, 0xad, 0xc3, 0xad, 0x7f, 0xaf, 0x3a, 0x1b, 0x39, 0x8a, 0x22, 0x96, 0x9f,
+	0xf1, 0x84, 0x49, 0x4c, 0x32, 0x39, 0xf1, 0xcd, 0x92, 0x45, 0xd2, 0x5f, 0x5e, 0x20, 0xfd, 0xde,
+	0xcf, 0x15, 0x68, 0x3d, 0x31, 0xaf, 0xdf, 0x33, 0x49, 0x25, 0x92, 0xaf, 0x41, 0x93, 0x56, 0xf1,
+	0xc4, 0xd1, 0xe3, 0xbd, 0xbf, 0xe8, 0x62, 0xd3, 0x0f, 0x93, 0xbd, 0xd0, 0xca, 0x1c, 0xf2, 0x10,
+	0xea, 0xe6, 0x05, 0xd3, 0x8d, 0x6e, 0x1e, 0xb4, 0x17, 0x65, 0x1f, 0xeb, 0x55, 0x36, 0xdd, 0xe6,
+	0x90, 0x27, 0x00, 0x11, 0x95, 0x34, 0x53, 0xa3, 0x54, 0x13, 0x57, 0xf5, 0x3f, 0x58, 0x84, 0x70,
+	0x3b, 0x74, 0x0b, 0x32, 0x95, 0x4a, 0x9e, 0xc3, 0xe6, 0xad, 0x78, 0x4e, 0x8d, 0x3c, 0x85, 0x5b,
+	0xd3, 0x78, 0x1f, 0x2f, 0xc2, 0x9b, 0x93, 0xb3, 0x45, 0xdd, 0x08, 0x67, 0xdd, 0xe2, 0xf0, 0xfb,
+	0xcb, 0xeb, 0xb6, 0xf3, 0xfa, 0xba, 0xed, 0xfc, 0x73, 0xdd, 0x76, 0x7e, 0xbc, 0x69, 0x2f, 0xbd,
+	0xbe, 0x69, 0x2f, 0xfd, 0x79, 0xd3, 0x5e, 0x7a, 0xfe, 0xd5, 0xd4, 0xac, 0x9f, 0xe9, 0x22, 0x7b,
+	0xdf, 0xd2, 0xa1, 0xf0, 0x4c, 0x41, 0xef, 0xc2, 0x9b, 0x7f, 0xef, 0xf5, 0xf8, 0x87, 0x75, 0xfd,
+	0xd8, 0x7f, 0xf1, 0xdf, 0x00, 0x1f, 0x67, 0x03, 0xcd, 0x9a, 0x08, 0x00, 0x00,
 }
 
 func (m *Query) Marshal() (dAtA []byte, err error) {
@@ -290,6 +654,77 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BatchRequests) > 0 {
+		for iNdEx := len(m.BatchRequests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BatchRequests[iNdEx])
+			copy(dAtA[i:], m.BatchRequests[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BatchRequests[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RewardModule) > 0 {
+		i -= len(m.RewardModule)
+		copy(dAtA[i:], m.RewardModule)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RewardModule)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Answered {
+		i--
+		if m.Answered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.EmittedHostHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EmittedHostHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.EmittedHostTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EmittedHostTimestamp))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Retries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.TimeoutPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	if m.Height != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
 		i--
@@ -365,7 +800,7 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+func (m *TimeoutPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -375,43 +810,203 @@ func (m *DataPoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *TimeoutPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TimeoutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+	if m.MaxRetries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRetries))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x18
 	}
-	{
-		size := m.LocalHeight.Size()
-		i -= size
-		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.Duration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RemoteHeight.Size()
-		i -= size
-		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.Blocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueryId) > 0 {
+		i -= len(m.QueryId)
+		copy(dAtA[i:], m.QueryId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.QueryId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseFeeRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseFeeRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseFeeRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingResponses != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PendingResponses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmittedHostHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EmittedHostHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.LocalHeight.Size()
+		i -= size
+		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RemoteHeight.Size()
+		i -= size
+		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -442,6 +1037,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CallbackFailures) > 0 {
+		for iNdEx := len(m.CallbackFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Datapoints) > 0 {
+		for iNdEx := len(m.Datapoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Datapoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Queries) > 0 {
 		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -510,6 +1143,111 @@ func (m *Query) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovGenesis(uint64(m.Height))
 	}
+	l = m.TimeoutPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Retries != 0 {
+		n += 1 + sovGenesis(uint64(m.Retries))
+	}
+	if m.EmittedHostTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.EmittedHostTimestamp))
+	}
+	if m.EmittedHostHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EmittedHostHeight))
+	}
+	if m.Answered {
+		n += 2
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.RewardModule)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BatchRequests) > 0 {
+		for _, b := range m.BatchRequests {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimeoutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovGenesis(uint64(m.Blocks))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovGenesis(uint64(m.Duration))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRetries))
+	}
+	return n
+}
+
+func (m *CallbackFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovGenesis(uint64(m.Retries))
+	}
+	return n
+}
+
+func (m *ResponseFeeRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingResponses != 0 {
+		n += 1 + sovGenesis(uint64(m.PendingResponses))
+	}
 	return n
 }
 
@@ -531,6 +1269,9 @@ func (m *DataPoint) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.EmittedHostHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EmittedHostHeight))
+	}
 	return n
 }
 
@@ -546,16 +1287,695 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Datapoints) > 0 {
+		for _, e := range m.Datapoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CallbackFailures) > 0 {
+		for _, e := range m.CallbackFailures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmittedHostTimestamp", wireType)
+			}
+			m.EmittedHostTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmittedHostTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmittedHostHeight", wireType)
+			}
+			m.EmittedHostHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmittedHostHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Answered = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRequests", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchRequests = append(m.BatchRequests, make([]byte, postIndex-iNdEx))
+			copy(m.BatchRequests[len(m.BatchRequests)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeoutPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeoutPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeoutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *CallbackFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -578,15 +1998,15 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallbackFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallbackFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -614,11 +2034,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.QueryId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -646,11 +2066,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -678,11 +2098,11 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -710,13 +2130,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QueryType = string(dAtA[iNdEx:postIndex])
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -726,31 +2146,29 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
-			if m.Request == nil {
-				m.Request = []byte{}
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -760,31 +2178,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
-			var stringLen uint64
+			m.Retries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -794,29 +2197,64 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Retries |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseFeeRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseFeeRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseFeeRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -844,13 +2282,13 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.Ttl = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -860,16 +2298,31 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ttl |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingResponses", wireType)
 			}
-			m.Height = 0
+			m.PendingResponses = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -879,7 +2332,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.PendingResponses |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1068,6 +2521,25 @@ func (m *DataPoint) Unmarshal(dAtA []byte) error {
 				m.Value = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmittedHostHeight", wireType)
+			}
+			m.EmittedHostHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmittedHostHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1152,6 +2624,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datapoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datapoints = append(m.Datapoints, DataPoint{})
+			if err := m.Datapoints[len(m.Datapoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackFailures = append(m.CallbackFailures, CallbackFailure{})
+			if err := m.CallbackFailures[len(m.CallbackFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
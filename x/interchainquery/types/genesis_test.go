@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+func validQuery(id string) types.Query {
+	return types.Query{
+		Id:           id,
+		ConnectionId: "connection-0",
+		ChainId:      "GAIA",
+		Request:      []byte{1},
+		Period:       sdk.NewInt(-1),
+		LastHeight:   sdk.ZeroInt(),
+	}
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	datapoint := types.DataPoint{Id: "0", RemoteHeight: sdk.NewInt(10), LocalHeight: sdk.NewInt(1)}
+	withQuery := func(update func(q *types.Query)) []types.Query {
+		query := validQuery("0")
+		update(&query)
+		return []types.Query{query}
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		err      string
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Queries:          []types.Query{validQuery("0"), validQuery("1")},
+				Datapoints:       []types.DataPoint{datapoint},
+				CallbackFailures: []types.CallbackFailure{{QueryId: "2"}},
+			},
+		},
+		{
+			desc:     "duplicated query",
+			genState: &types.GenesisState{Params: types.DefaultParams(), Queries: []types.Query{validQuery("0"), validQuery("0")}},
+			err:      "duplicate query",
+		},
+		{
+			desc:     "invalid connection id",
+			genState: &types.GenesisState{Params: types.DefaultParams(), Queries: withQuery(func(q *types.Query) { q.ConnectionId = "channel-0" })},
+			err:      "invalid connection id",
+		},
+		{
+			desc:     "zero period",
+			genState: &types.GenesisState{Params: types.DefaultParams(), Queries: withQuery(func(q *types.Query) { q.Period = sdk.ZeroInt() })},
+			err:      "period must be positive",
+		},
+		{
+			desc:     "missing period",
+			genState: &types.GenesisState{Params: types.DefaultParams(), Queries: withQuery(func(q *types.Query) { q.Period = sdk.Int{} })},
+			err:      "period must be positive",
+		},
+		{
+			desc: "duplicated datapoint",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				Queries:    []types.Query{validQuery("0")},
+				Datapoints: []types.DataPoint{datapoint, datapoint},
+			},
+			err: "duplicate datapoint",
+		},
+		{
+			desc:     "datapoint without query",
+			genState: &types.GenesisState{Params: types.DefaultParams(), Datapoints: []types.DataPoint{datapoint}},
+			err:      "has no query",
+		},
+		{
+			desc: "duplicated callback failure",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				CallbackFailures: []types.CallbackFailure{{QueryId: "0"}, {QueryId: "0"}},
+			},
+			err: "duplicate callback failure",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
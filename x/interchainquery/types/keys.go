@@ -7,6 +7,9 @@ const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the message route for slashing
 	RouterKey = ModuleName
 
@@ -16,8 +19,15 @@ const (
 
 // prefix bytes for the interchainquery persistent store
 const (
-	prefixData  = iota + 1
-	prefixQuery = iota + 1
+	prefixData            = iota + 1
+	prefixQuery           = iota + 1
+	prefixCallbackFailure = iota + 1
+	prefixTimeoutQuery    = iota + 1
+)
+
+// prefix bytes for the interchainquery transient store
+const (
+	prefixResponseFeeRefund = iota + 1
 )
 
 // keys for proof queries to various stores, note: there's an implicit assumption here that
@@ -26,13 +36,21 @@ const (
 // new chain
 
 const (
-	STAKING_STORE_QUERY_WITH_PROOF = "store/staking/key"
-	BANK_STORE_QUERY_WITH_PROOF    = "store/bank/key"
+	STAKING_STORE_QUERY_WITH_PROOF      = "store/staking/key"
+	BANK_STORE_QUERY_WITH_PROOF         = "store/bank/key"
+	SLASHING_STORE_QUERY_WITH_PROOF     = "store/slashing/key"
+	DISTRIBUTION_STORE_QUERY_WITH_PROOF = "store/distribution/key"
+	AUTH_STORE_QUERY_WITH_PROOF         = "store/acc/key"
 )
 
 var (
-	KeyPrefixData  = []byte{prefixData}
-	KeyPrefixQuery = []byte{prefixQuery}
+	KeyPrefixData            = []byte{prefixData}
+	KeyPrefixQuery           = []byte{prefixQuery}
+	KeyPrefixCallbackFailure = []byte{prefixCallbackFailure}
+	// index of the one-shot queries with a timeout policy, the only ones that can time out
+	KeyPrefixTimeoutQuery = []byte{prefixTimeoutQuery}
+
+	KeyPrefixResponseFeeRefund = []byte{prefixResponseFeeRefund}
 )
 
 func KeyPrefix(p string) []byte {
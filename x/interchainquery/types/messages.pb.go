@@ -39,6 +39,8 @@ type MsgSubmitQueryResponse struct {
 	ProofOps    *crypto.ProofOps `protobuf:"bytes,4,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty" yaml:"proof_ops"`
 	Height      int64            `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
 	FromAddress string           `protobuf:"bytes,6,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// answers a batched query instead of result and proof_ops, in the order of the query's batch_requests
+	Results []QueryResult `protobuf:"bytes,7,rep,name=results,proto3" json:"results"`
 }
 
 func (m *MsgSubmitQueryResponse) Reset()         { *m = MsgSubmitQueryResponse{} }
@@ -74,6 +76,105 @@ func (m *MsgSubmitQueryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitQueryResponse proto.InternalMessageInfo
 
+// QueryResult is the value of one key of a batched query, with its proof.
+type QueryResult struct {
+	Result   []byte           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty" yaml:"result"`
+	ProofOps *crypto.ProofOps `protobuf:"bytes,2,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty" yaml:"proof_ops"`
+}
+
+func (m *QueryResult) Reset()         { *m = QueryResult{} }
+func (m *QueryResult) String() string { return proto.CompactTextString(m) }
+func (*QueryResult) ProtoMessage()    {}
+func (*QueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9add76d337d1a013, []int{1}
+}
+func (m *QueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResult.Merge(m, src)
+}
+func (m *QueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResult proto.InternalMessageInfo
+
+func (m *QueryResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *QueryResult) GetProofOps() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofOps
+	}
+	return nil
+}
+
+// BatchQueryResults is passed to the callback of a batched query, with the values of its keys in the order of
+// the query's batch_requests.
+type BatchQueryResults struct {
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *BatchQueryResults) Reset()         { *m = BatchQueryResults{} }
+func (m *BatchQueryResults) String() string { return proto.CompactTextString(m) }
+func (*BatchQueryResults) ProtoMessage()    {}
+func (*BatchQueryResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9add76d337d1a013, []int{2}
+}
+func (m *BatchQueryResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchQueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchQueryResults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchQueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchQueryResults.Merge(m, src)
+}
+func (m *BatchQueryResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchQueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchQueryResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchQueryResults proto.InternalMessageInfo
+
+func (m *BatchQueryResults) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // MsgSubmitQueryResponseResponse defines the MsgSubmitQueryResponse response
 // type.
 type MsgSubmitQueryResponseResponse struct {
@@ -83,7 +184,7 @@ func (m *MsgSubmitQueryResponseResponse) Reset()         { *m = MsgSubmitQueryRe
 func (m *MsgSubmitQueryResponseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitQueryResponseResponse) ProtoMessage()    {}
 func (*MsgSubmitQueryResponseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9add76d337d1a013, []int{1}
+	return fileDescriptor_9add76d337d1a013, []int{3}
 }
 func (m *MsgSubmitQueryResponseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,45 +215,51 @@ var xxx_messageInfo_MsgSubmitQueryResponseResponse proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*MsgSubmitQueryResponse)(nil), "stride.interchainquery.MsgSubmitQueryResponse")
+	proto.RegisterType((*QueryResult)(nil), "stride.interchainquery.QueryResult")
+	proto.RegisterType((*BatchQueryResults)(nil), "stride.interchainquery.BatchQueryResults")
 	proto.RegisterType((*MsgSubmitQueryResponseResponse)(nil), "stride.interchainquery.MsgSubmitQueryResponseResponse")
 }
 
 func init() { proto.RegisterFile("interchainquery/v1/messages.proto", fileDescriptor_9add76d337d1a013) }
 
 var fileDescriptor_9add76d337d1a013 = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0x16, 0x7a, 0x77, 0xb9, 0x22, 0x20, 0x57, 0x9d, 0x42, 0x81, 0x24, 0x78, 0xa1,
-	0x20, 0x2e, 0x56, 0x8b, 0x60, 0x28, 0x13, 0xdd, 0x4e, 0xe2, 0x38, 0x48, 0x37, 0x96, 0x2a, 0x69,
-	0x7c, 0xae, 0xa5, 0x26, 0x0e, 0xb6, 0x7b, 0xba, 0xae, 0x4c, 0x8c, 0x48, 0x2c, 0x8c, 0xfd, 0x0a,
-	0x48, 0x2c, 0x7c, 0x03, 0xc6, 0x13, 0x2c, 0x4c, 0x15, 0x6a, 0x19, 0x60, 0xed, 0x27, 0x40, 0xb1,
-	0x13, 0x40, 0xa5, 0x0b, 0x93, 0x5f, 0xde, 0xff, 0x97, 0xf7, 0xfe, 0xcf, 0x7e, 0xe6, 0x2d, 0x9a,
-	0x4a, 0xcc, 0x87, 0xa3, 0x90, 0xa6, 0x2f, 0x27, 0x98, 0x4f, 0xd1, 0x69, 0x1b, 0x25, 0x58, 0x88,
-	0x90, 0x60, 0xe1, 0x67, 0x9c, 0x49, 0x66, 0xed, 0x0b, 0xc9, 0x69, 0x8c, 0xfd, 0x35, 0xb2, 0xd9,
-	0x20, 0x8c, 0x30, 0x85, 0xa0, 0x3c, 0xd2, 0x74, 0xf3, 0xda, 0x90, 0x89, 0x84, 0x89, 0x81, 0x16,
-	0xf4, 0x47, 0x21, 0xdd, 0x20, 0x8c, 0x91, 0x31, 0x46, 0x61, 0x46, 0x51, 0x98, 0xa6, 0x4c, 0x86,
-	0x92, 0xb2, 0xb4, 0x54, 0x6f, 0x4a, 0x9c, 0xc6, 0x98, 0x27, 0x34, 0x95, 0x68, 0xc8, 0xa7, 0x99,
-	0x64, 0x28, 0xe3, 0x8c, 0x9d, 0x68, 0x19, 0xfe, 0xac, 0x98, 0xfb, 0x47, 0x82, 0xf4, 0x27, 0x51,
-	0x42, 0xe5, 0xf3, 0xdc, 0x40, 0x80, 0x45, 0xc6, 0x52, 0x81, 0x2d, 0xdf, 0xdc, 0x56, 0xb6, 0x06,
-	0x34, 0xb6, 0x81, 0x07, 0x5a, 0x3b, 0xbd, 0xbd, 0xd5, 0xdc, 0xbd, 0x3c, 0x0d, 0x93, 0x71, 0x17,
-	0x96, 0x0a, 0x0c, 0xb6, 0x54, 0x78, 0x18, 0xe7, 0xbc, 0x9a, 0x20, 0xe7, 0x2b, 0xeb, 0x7c, 0xa9,
-	0xc0, 0x60, 0x4b, 0x85, 0x87, 0xb1, 0x75, 0xc7, 0xac, 0x71, 0x2c, 0x26, 0x63, 0x69, 0x57, 0x3d,
-	0xd0, 0xaa, 0xf7, 0xae, 0xae, 0xe6, 0xee, 0x25, 0x4d, 0xeb, 0x3c, 0x0c, 0x0a, 0xc0, 0x7a, 0x6a,
-	0xee, 0x28, 0xd3, 0x03, 0x96, 0x09, 0xfb, 0x82, 0x07, 0x5a, 0xbb, 0x9d, 0xeb, 0xfe, 0x9f, 0xc1,
-	0x7c, 0x3d, 0x98, 0xff, 0x2c, 0x67, 0x8e, 0x33, 0xd1, 0x6b, 0xac, 0xe6, 0xee, 0x15, 0x5d, 0xea,
-	0xf7, 0x7f, 0x30, 0xd8, 0xce, 0x0a, 0x3d, 0x6f, 0x3d, 0xc2, 0x94, 0x8c, 0xa4, 0x7d, 0xd1, 0x03,
-	0xad, 0xea, 0xdf, 0xad, 0x75, 0x1e, 0x06, 0x05, 0x60, 0x3d, 0x32, 0xeb, 0x27, 0x9c, 0x25, 0x83,
-	0x30, 0x8e, 0x39, 0x16, 0xc2, 0xae, 0xa9, 0xc9, 0xec, 0xcf, 0x1f, 0x0e, 0x1a, 0xc5, 0x2b, 0x3c,
-	0xd6, 0x4a, 0x5f, 0x72, 0x9a, 0x92, 0x60, 0x37, 0xa7, 0x8b, 0x54, 0xb7, 0xfe, 0x7a, 0xe6, 0x1a,
-	0xef, 0x66, 0x2e, 0xf8, 0x31, 0x73, 0x0d, 0xe8, 0x99, 0xce, 0xe6, 0xab, 0x2e, 0xcf, 0xce, 0x47,
-	0x60, 0x56, 0x8f, 0x04, 0xb1, 0xde, 0x03, 0x73, 0x6f, 0xe3, 0x93, 0xf8, 0x9b, 0x97, 0xc6, 0xdf,
-	0x5c, 0xb7, 0xf9, 0xf0, 0xff, 0xf8, 0xf2, 0x84, 0x9d, 0x57, 0x5f, 0xbe, 0xbf, 0xad, 0xdc, 0x83,
-	0xb7, 0xd1, 0xfa, 0x1e, 0xcb, 0x33, 0x74, 0xda, 0x8e, 0xb0, 0x0c, 0xdb, 0x48, 0xa8, 0x02, 0x2a,
-	0xdd, 0x05, 0x77, 0x7b, 0xc7, 0x9f, 0x16, 0x0e, 0x38, 0x5f, 0x38, 0xe0, 0xdb, 0xc2, 0x01, 0x6f,
-	0x96, 0x8e, 0x71, 0xbe, 0x74, 0x8c, 0xaf, 0x4b, 0xc7, 0x78, 0xf1, 0x80, 0x50, 0x39, 0x9a, 0x44,
-	0xfe, 0x90, 0x25, 0xa8, 0xaf, 0xfc, 0x1c, 0x3c, 0x09, 0x23, 0x81, 0xb4, 0x37, 0x74, 0xf6, 0x6f,
-	0x93, 0x69, 0x86, 0x45, 0x54, 0x53, 0x1b, 0x7a, 0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x6e, 0x36, 0x14, 0x4c, 0x03, 0x00, 0x00,
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc6, 0x7d, 0x75, 0x69, 0xda, 0x4b, 0x10, 0xd4, 0x8d, 0x2a, 0x13, 0xc0, 0x36, 0xc7, 0x80,
+	0x41, 0xc4, 0xa7, 0x04, 0xc1, 0x10, 0x26, 0xcc, 0x54, 0x89, 0x52, 0x70, 0x36, 0x96, 0xc8, 0x89,
+	0xaf, 0x8e, 0xa5, 0xd8, 0x67, 0x7c, 0x97, 0xaa, 0x59, 0x99, 0x3a, 0x22, 0xb1, 0x30, 0xe6, 0x09,
+	0x90, 0x90, 0x58, 0x78, 0x83, 0x8e, 0x15, 0x2c, 0x4c, 0x11, 0x4a, 0x18, 0x98, 0xf3, 0x04, 0xc8,
+	0x3e, 0xbb, 0x8d, 0x42, 0x18, 0x2a, 0x26, 0x9f, 0xff, 0xdf, 0xef, 0xee, 0xbe, 0xef, 0x7f, 0x77,
+	0xf0, 0x4e, 0x10, 0x71, 0x92, 0xf4, 0xfa, 0x6e, 0x10, 0xbd, 0x1d, 0x92, 0x64, 0x84, 0x8f, 0x1a,
+	0x38, 0x24, 0x8c, 0xb9, 0x3e, 0x61, 0x56, 0x9c, 0x50, 0x4e, 0x95, 0x5d, 0xc6, 0x93, 0xc0, 0x23,
+	0xd6, 0x12, 0x59, 0xab, 0xfa, 0xd4, 0xa7, 0x19, 0x82, 0xd3, 0x91, 0xa0, 0x6b, 0x37, 0x7a, 0x94,
+	0x85, 0x94, 0x75, 0x84, 0x20, 0x7e, 0x72, 0xe9, 0x96, 0x4f, 0xa9, 0x3f, 0x20, 0xd8, 0x8d, 0x03,
+	0xec, 0x46, 0x11, 0xe5, 0x2e, 0x0f, 0x68, 0x54, 0xa8, 0xb7, 0x39, 0x89, 0x3c, 0x92, 0x84, 0x41,
+	0xc4, 0x71, 0x2f, 0x19, 0xc5, 0x9c, 0xe2, 0x38, 0xa1, 0xf4, 0x50, 0xc8, 0xe8, 0x93, 0x0c, 0x77,
+	0xf7, 0x99, 0xdf, 0x1e, 0x76, 0xc3, 0x80, 0xbf, 0x4e, 0x0d, 0x38, 0x84, 0xc5, 0x34, 0x62, 0x44,
+	0xb1, 0xe0, 0x66, 0x66, 0xab, 0x13, 0x78, 0x2a, 0x30, 0x80, 0xb9, 0x65, 0xef, 0xcc, 0x27, 0xfa,
+	0xb5, 0x91, 0x1b, 0x0e, 0x5a, 0xa8, 0x50, 0x90, 0x53, 0xca, 0x86, 0x7b, 0x5e, 0xca, 0x67, 0x09,
+	0x52, 0x7e, 0x6d, 0x99, 0x2f, 0x14, 0xe4, 0x94, 0xb2, 0xe1, 0x9e, 0xa7, 0xdc, 0x87, 0x1b, 0x09,
+	0x61, 0xc3, 0x01, 0x57, 0x65, 0x03, 0x98, 0x15, 0x7b, 0x7b, 0x3e, 0xd1, 0xaf, 0x0a, 0x5a, 0xd4,
+	0x91, 0x93, 0x03, 0xca, 0x4b, 0xb8, 0x95, 0x99, 0xee, 0xd0, 0x98, 0xa9, 0xeb, 0x06, 0x30, 0xcb,
+	0xcd, 0x9b, 0xd6, 0x45, 0x30, 0x4b, 0x04, 0xb3, 0x5e, 0xa5, 0xcc, 0x41, 0xcc, 0xec, 0xea, 0x7c,
+	0xa2, 0x5f, 0x17, 0x4b, 0x9d, 0xcf, 0x43, 0xce, 0x66, 0x9c, 0xeb, 0xe9, 0xd6, 0x7d, 0x12, 0xf8,
+	0x7d, 0xae, 0x5e, 0x31, 0x80, 0x29, 0x2f, 0x6e, 0x2d, 0xea, 0xc8, 0xc9, 0x01, 0xe5, 0x29, 0xac,
+	0x1c, 0x26, 0x34, 0xec, 0xb8, 0x9e, 0x97, 0x10, 0xc6, 0xd4, 0x8d, 0x2c, 0x99, 0xfa, 0xed, 0x4b,
+	0xbd, 0x9a, 0x9f, 0xc2, 0x33, 0xa1, 0xb4, 0x79, 0x12, 0x44, 0xbe, 0x53, 0x4e, 0xe9, 0xbc, 0xa4,
+	0x3c, 0x87, 0x25, 0x91, 0x80, 0xa9, 0x25, 0x43, 0x36, 0xcb, 0xcd, 0xbb, 0xd6, 0xea, 0x53, 0xb7,
+	0x8a, 0xd6, 0x0f, 0x07, 0xdc, 0x5e, 0x3f, 0x9d, 0xe8, 0x92, 0x53, 0xcc, 0x6c, 0x55, 0x4e, 0xc6,
+	0xba, 0xf4, 0x71, 0xac, 0x83, 0xdf, 0x63, 0x5d, 0x42, 0x27, 0x00, 0x96, 0x17, 0xe0, 0x85, 0x2e,
+	0x82, 0x4b, 0x75, 0x71, 0xed, 0xbf, 0xbb, 0x88, 0xea, 0x70, 0xdb, 0x76, 0x79, 0xaf, 0xbf, 0x60,
+	0x87, 0x29, 0xea, 0x45, 0x64, 0x60, 0xc8, 0x66, 0xe5, 0x3c, 0x07, 0x32, 0xa0, 0xb6, 0xfa, 0xa6,
+	0x15, 0xdf, 0xe6, 0x57, 0x00, 0xe5, 0x7d, 0xe6, 0x2b, 0x9f, 0x01, 0xdc, 0x59, 0x79, 0x23, 0xff,
+	0xd5, 0xbd, 0xd5, 0xeb, 0xd6, 0x9e, 0x5c, 0x8e, 0x2f, 0xbe, 0xa8, 0xf9, 0xee, 0xfb, 0xaf, 0x0f,
+	0x6b, 0x0f, 0xd1, 0x3d, 0xbc, 0xfc, 0x8c, 0xf9, 0x31, 0x3e, 0x6a, 0x74, 0x09, 0x77, 0x1b, 0x98,
+	0x65, 0x0b, 0x64, 0xe5, 0x16, 0x78, 0x60, 0x1f, 0x9c, 0x4e, 0x35, 0x70, 0x36, 0xd5, 0xc0, 0xcf,
+	0xa9, 0x06, 0xde, 0xcf, 0x34, 0xe9, 0x6c, 0xa6, 0x49, 0x3f, 0x66, 0x9a, 0xf4, 0xe6, 0xb1, 0x1f,
+	0xf0, 0xfe, 0xb0, 0x6b, 0xf5, 0x68, 0x88, 0xdb, 0x99, 0x9f, 0xfa, 0x0b, 0xb7, 0xcb, 0xb0, 0xf0,
+	0x86, 0x8f, 0xff, 0xde, 0x64, 0x14, 0x13, 0xd6, 0xdd, 0xc8, 0x1e, 0xe8, 0xa3, 0x3f, 0x03, 0x00,
+	0xcd, 0xe9, 0x1d, 0x31, 0x4b, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -257,6 +364,20 @@ func (m *MsgSubmitQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.FromAddress) > 0 {
 		i -= len(m.FromAddress)
 		copy(dAtA[i:], m.FromAddress)
@@ -305,6 +426,80 @@ func (m *MsgSubmitQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofOps != nil {
+		{
+			size, err := m.ProofOps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessages(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchQueryResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchQueryResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchQueryResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintMessages(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitQueryResponseResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -368,6 +563,44 @@ func (m *MsgSubmitQueryResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchQueryResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -600,6 +833,242 @@ func (m *MsgSubmitQueryResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, QueryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofOps == nil {
+				m.ProofOps = &crypto.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchQueryResults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchQueryResults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchQueryResults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
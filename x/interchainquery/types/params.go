@@ -0,0 +1,102 @@
+package types
+
+import (
+	fmt "fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Default init params
+var (
+	// 100 host blocks ~= 10 minutes on a 6s block time chain
+	DefaultMaxResponseAge uint64 = 100
+	// enough for a callback that updates a host zone and submits an ICA tx
+	DefaultCallbackGasLimit uint64 = 5_000_000
+
+	KeyMaxResponseAge          = []byte("MaxResponseAge")
+	KeyDefaultCallbackGasLimit = []byte("DefaultCallbackGasLimit")
+	KeyCallbackGasLimits       = []byte("CallbackGasLimits")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable the param key table for the interchainquery module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(maxResponseAge uint64, defaultCallbackGasLimit uint64, callbackGasLimits []CallbackGasLimit) Params {
+	return Params{
+		MaxResponseAge:          maxResponseAge,
+		DefaultCallbackGasLimit: defaultCallbackGasLimit,
+		CallbackGasLimits:       callbackGasLimits,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMaxResponseAge, DefaultCallbackGasLimit, []CallbackGasLimit{})
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxResponseAge, &p.MaxResponseAge, validateUint64),
+		paramtypes.NewParamSetPair(KeyDefaultCallbackGasLimit, &p.DefaultCallbackGasLimit, validateUint64),
+		paramtypes.NewParamSetPair(KeyCallbackGasLimits, &p.CallbackGasLimits, validateCallbackGasLimits),
+	}
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	return nil
+}
+
+func validateCallbackGasLimits(i interface{}) error {
+	gasLimits, ok := i.([]CallbackGasLimit)
+	if !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	modules := map[string]bool{}
+	for _, gasLimit := range gasLimits {
+		if gasLimit.Module == "" {
+			return fmt.Errorf("callback gas limit module cannot be empty")
+		}
+		if modules[gasLimit.Module] {
+			return fmt.Errorf("duplicate callback gas limit for module %s", gasLimit.Module)
+		}
+		modules[gasLimit.Module] = true
+	}
+	return nil
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateUint64(p.MaxResponseAge); err != nil {
+		return err
+	}
+	if err := validateUint64(p.DefaultCallbackGasLimit); err != nil {
+		return err
+	}
+	return validateCallbackGasLimits(p.CallbackGasLimits)
+}
+
+// GetCallbackGasLimit returns the gas the callbacks of a module may use per query response, 0 for no limit
+func (p Params) GetCallbackGasLimit(module string) uint64 {
+	for _, gasLimit := range p.CallbackGasLimits {
+		if gasLimit.Module == module {
+			return gasLimit.GasLimit
+		}
+	}
+	return p.DefaultCallbackGasLimit
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,620 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: interchainquery/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// host blocks a response's height may trail the light client's latest height before the response is rejected as
+	// stale (0 accepts responses of any age). Queries pinned to a host height are exempt
+	MaxResponseAge uint64 `protobuf:"varint,1,opt,name=max_response_age,json=maxResponseAge,proto3" json:"max_response_age,omitempty" yaml:"max_response_age"`
+	// gas the callbacks of a module may use per query response, for modules without a callback_gas_limits entry
+	// (0 only bounds them by the tx's gas)
+	DefaultCallbackGasLimit uint64 `protobuf:"varint,2,opt,name=default_callback_gas_limit,json=defaultCallbackGasLimit,proto3" json:"default_callback_gas_limit,omitempty" yaml:"default_callback_gas_limit"`
+	// per module overrides of default_callback_gas_limit
+	CallbackGasLimits []CallbackGasLimit `protobuf:"bytes,3,rep,name=callback_gas_limits,json=callbackGasLimits,proto3" json:"callback_gas_limits" yaml:"callback_gas_limits"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce450e4887a033a5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxResponseAge() uint64 {
+	if m != nil {
+		return m.MaxResponseAge
+	}
+	return 0
+}
+
+func (m *Params) GetDefaultCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.DefaultCallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetCallbackGasLimits() []CallbackGasLimit {
+	if m != nil {
+		return m.CallbackGasLimits
+	}
+	return nil
+}
+
+// CallbackGasLimit caps the gas used by the callbacks of a module per query response
+type CallbackGasLimit struct {
+	Module   string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+}
+
+func (m *CallbackGasLimit) Reset()         { *m = CallbackGasLimit{} }
+func (m *CallbackGasLimit) String() string { return proto.CompactTextString(m) }
+func (*CallbackGasLimit) ProtoMessage()    {}
+func (*CallbackGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce450e4887a033a5, []int{1}
+}
+func (m *CallbackGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CallbackGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CallbackGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CallbackGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackGasLimit.Merge(m, src)
+}
+func (m *CallbackGasLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CallbackGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackGasLimit proto.InternalMessageInfo
+
+func (m *CallbackGasLimit) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *CallbackGasLimit) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "stride.interchainquery.Params")
+	proto.RegisterType((*CallbackGasLimit)(nil), "stride.interchainquery.CallbackGasLimit")
+}
+
+func init() { proto.RegisterFile("interchainquery/v1/params.proto", fileDescriptor_ce450e4887a033a5) }
+
+var fileDescriptor_ce450e4887a033a5 = []byte{
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x4b, 0xf3, 0x30,
+	0x18, 0xc7, 0xdb, 0x6d, 0x8c, 0x77, 0x79, 0xe1, 0x65, 0x6f, 0x1d, 0xdb, 0x98, 0xd0, 0xce, 0x80,
+	0xb0, 0x8b, 0x0d, 0x53, 0xbc, 0xec, 0xe6, 0x44, 0xbc, 0x0c, 0x94, 0x7a, 0x13, 0xa4, 0xa4, 0x5d,
+	0xcc, 0x8a, 0xcd, 0x52, 0x9b, 0x54, 0x36, 0xf0, 0x43, 0x78, 0xf4, 0xe8, 0xb7, 0xf0, 0x2b, 0xec,
+	0xb8, 0xa3, 0xa7, 0x22, 0xdb, 0x37, 0xe8, 0x27, 0x90, 0xb5, 0x15, 0xa4, 0x9b, 0xb7, 0x24, 0xcf,
+	0xef, 0xf9, 0xe7, 0xf9, 0xff, 0x13, 0x60, 0x78, 0x53, 0x49, 0x42, 0x77, 0x82, 0xbd, 0xe9, 0x63,
+	0x44, 0xc2, 0x39, 0x7a, 0xea, 0xa3, 0x00, 0x87, 0x98, 0x09, 0x33, 0x08, 0xb9, 0xe4, 0x5a, 0x53,
+	0xc8, 0xd0, 0x1b, 0x13, 0xb3, 0xc0, 0x75, 0x1a, 0x94, 0x53, 0x9e, 0x22, 0x68, 0xb3, 0xca, 0x68,
+	0xf8, 0x5e, 0x02, 0xd5, 0xeb, 0xb4, 0x5d, 0xbb, 0x00, 0x75, 0x86, 0x67, 0x76, 0x48, 0x44, 0xc0,
+	0xa7, 0x82, 0xd8, 0x98, 0x92, 0xb6, 0xda, 0x55, 0x7b, 0x95, 0xe1, 0x7e, 0x12, 0x1b, 0xad, 0x39,
+	0x66, 0xfe, 0x00, 0x16, 0x09, 0x68, 0xfd, 0x63, 0x78, 0x66, 0xe5, 0x27, 0x67, 0x94, 0x68, 0x0e,
+	0xe8, 0x8c, 0xc9, 0x3d, 0x8e, 0x7c, 0x69, 0xbb, 0xd8, 0xf7, 0x1d, 0xec, 0x3e, 0xd8, 0x14, 0x0b,
+	0xdb, 0xf7, 0x98, 0x27, 0xdb, 0xa5, 0x54, 0xf0, 0x30, 0x89, 0x8d, 0x83, 0x4c, 0xf0, 0x77, 0x16,
+	0x5a, 0xad, 0xbc, 0x78, 0x9e, 0xd7, 0x2e, 0xb1, 0x18, 0x6d, 0x2a, 0xda, 0x33, 0xd8, 0xdb, 0xe6,
+	0x45, 0xbb, 0xdc, 0x2d, 0xf7, 0xfe, 0x1e, 0xf7, 0xcc, 0xdd, 0x09, 0x98, 0x45, 0x99, 0x21, 0x5c,
+	0xc4, 0x86, 0x92, 0xc4, 0x46, 0x27, 0x1b, 0x65, 0x87, 0x24, 0xb4, 0xfe, 0xbb, 0x85, 0x2e, 0x31,
+	0xa8, 0xbc, 0xbe, 0x19, 0x0a, 0xbc, 0x03, 0xf5, 0xad, 0xb9, 0x9a, 0xa0, 0xca, 0xf8, 0x38, 0xf2,
+	0xb3, 0xe0, 0x6a, 0x56, 0xbe, 0xd3, 0xfa, 0xa0, 0x56, 0x8c, 0xa0, 0x91, 0xc4, 0x46, 0x3d, 0xbb,
+	0xf7, 0x87, 0xe3, 0x3f, 0xf4, 0x7b, 0xb6, 0xab, 0xc5, 0x4a, 0x57, 0x97, 0x2b, 0x5d, 0xfd, 0x5c,
+	0xe9, 0xea, 0xcb, 0x5a, 0x57, 0x96, 0x6b, 0x5d, 0xf9, 0x58, 0xeb, 0xca, 0xed, 0x29, 0xf5, 0xe4,
+	0x24, 0x72, 0x4c, 0x97, 0x33, 0x74, 0x93, 0x3a, 0x3d, 0x1a, 0x61, 0x47, 0xa0, 0xcc, 0x35, 0x9a,
+	0xa1, 0xe2, 0x0f, 0x91, 0xf3, 0x80, 0x08, 0xa7, 0x9a, 0x3e, 0xf8, 0xc9, 0xd7, 0x00, 0x16, 0x87,
+	0x04, 0x30, 0x41, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackGasLimits) > 0 {
+		for iNdEx := len(m.CallbackGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DefaultCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DefaultCallbackGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxResponseAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxResponseAge))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CallbackGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallbackGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CallbackGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxResponseAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxResponseAge))
+	}
+	if m.DefaultCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.DefaultCallbackGasLimit))
+	}
+	if len(m.CallbackGasLimits) > 0 {
+		for _, e := range m.CallbackGasLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CallbackGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovParams(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResponseAge", wireType)
+			}
+			m.MaxResponseAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxResponseAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultCallbackGasLimit", wireType)
+			}
+			m.DefaultCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackGasLimits = append(m.CallbackGasLimits, CallbackGasLimit{})
+			if err := m.CallbackGasLimits[len(m.CallbackGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallbackGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallbackGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallbackGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
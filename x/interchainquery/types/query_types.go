@@ -0,0 +1,52 @@
+package types
+
+import (
+	"bytes"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// QueryTypeRegistry maps each query type that can be requested to the host store key prefixes that requests of
+// that type may start with. A query type registered without prefixes accepts any non-empty request
+type QueryTypeRegistry map[string][][]byte
+
+// DefaultQueryTypeRegistry returns the registry of the host store queries built by this module's request builders
+func DefaultQueryTypeRegistry() QueryTypeRegistry {
+	return QueryTypeRegistry{
+		BANK_STORE_QUERY_WITH_PROOF:         {banktypes.BalancesPrefix},
+		STAKING_STORE_QUERY_WITH_PROOF:      {stakingtypes.ValidatorsKey, stakingtypes.DelegationKey},
+		SLASHING_STORE_QUERY_WITH_PROOF:     {slashingtypes.ValidatorSigningInfoKeyPrefix},
+		DISTRIBUTION_STORE_QUERY_WITH_PROOF: {distrtypes.ValidatorOutstandingRewardsPrefix},
+		AUTH_STORE_QUERY_WITH_PROOF:         {authtypes.AddressStoreKeyPrefix},
+	}
+}
+
+// Register allows a query type, restricted to requests starting with one of the given key prefixes
+func (r QueryTypeRegistry) Register(queryType string, prefixes ...[]byte) {
+	r[queryType] = append(r[queryType], prefixes...)
+}
+
+// Validate checks that the query type is registered and that the request is one of the keys allowed for it
+func (r QueryTypeRegistry) Validate(queryType string, request []byte) error {
+	prefixes, found := r[queryType]
+	if !found {
+		return sdkerrors.Wrapf(ErrInvalidQueryType, "query type %s is not registered", queryType)
+	}
+	if len(request) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidQueryType, "empty request for query type %s", queryType)
+	}
+	if len(prefixes) == 0 {
+		return nil
+	}
+	for _, prefix := range prefixes {
+		if len(request) > len(prefix) && bytes.HasPrefix(request, prefix) {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrInvalidQueryType, "request %X is not an allowed key for query type %s", request, queryType)
+}
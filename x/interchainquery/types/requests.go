@@ -0,0 +1,131 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Request builders return the host store key to query, and are meant to be used with the query type noted on each.
+// Addresses are the raw bytes of the host chain addresses, which use the host's bech32 prefix
+
+// NewBalanceRequest returns the key of an account's balance of a denom (BANK_STORE_QUERY_WITH_PROOF)
+func NewBalanceRequest(addr sdk.AccAddress, denom string) []byte {
+	return append(banktypes.CreateAccountBalancesPrefix(addr), []byte(denom)...)
+}
+
+// ParseBalanceRequest returns the account and denom of a balance request
+func ParseBalanceRequest(request []byte) (sdk.AccAddress, string, error) {
+	if !bytes.HasPrefix(request, banktypes.BalancesPrefix) {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidQueryType, "request %X is not a balance request", request)
+	}
+	balancesStore := request[len(banktypes.BalancesPrefix):]
+	addr, err := banktypes.AddressFromBalancesStore(balancesStore)
+	if err != nil {
+		return nil, "", sdkerrors.Wrapf(ErrInvalidQueryType, "invalid balance request %X: %s", request, err.Error())
+	}
+	// the address is length prefixed
+	denom := string(balancesStore[1+len(addr):])
+	return addr, denom, nil
+}
+
+// NewDelegationRequest returns the key of a delegator's delegation to a validator (STAKING_STORE_QUERY_WITH_PROOF)
+func NewDelegationRequest(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return stakingtypes.GetDelegationKey(delAddr, valAddr)
+}
+
+// NewValidatorRequest returns the key of a validator (STAKING_STORE_QUERY_WITH_PROOF)
+func NewValidatorRequest(valAddr sdk.ValAddress) []byte {
+	return stakingtypes.GetValidatorKey(valAddr)
+}
+
+// NewSigningInfoRequest returns the key of a validator's signing info (SLASHING_STORE_QUERY_WITH_PROOF)
+func NewSigningInfoRequest(consAddr sdk.ConsAddress) []byte {
+	return slashingtypes.ValidatorSigningInfoKey(consAddr)
+}
+
+// NewOutstandingRewardsRequest returns the key of a validator's outstanding rewards (DISTRIBUTION_STORE_QUERY_WITH_PROOF)
+func NewOutstandingRewardsRequest(valAddr sdk.ValAddress) []byte {
+	return distrtypes.GetValidatorOutstandingRewardsKey(valAddr)
+}
+
+// NewAccountRequest returns the key of an account (AUTH_STORE_QUERY_WITH_PROOF)
+func NewAccountRequest(addr sdk.AccAddress) []byte {
+	return authtypes.AddressStoreKey(addr)
+}
+
+// Response decoders unmarshal the result of a query built with the matching request builder. The host returns an
+// empty result when the key isn't set, which decodes to the zero value
+
+// DecodeBalanceResponse returns the balance queried by a balance request. Balances that aren't set are zero coins
+// of the requested denom
+func DecodeBalanceResponse(cdc codec.BinaryCodec, request []byte, result []byte) (sdk.Coin, error) {
+	coin := sdk.Coin{}
+	if err := cdc.Unmarshal(result, &coin); err != nil {
+		return sdk.Coin{}, sdkerrors.Wrap(err, "unable to unmarshal balance")
+	}
+	if coin.IsNil() {
+		_, denom, err := ParseBalanceRequest(request)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		coin = sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	return coin, nil
+}
+
+// DecodeDelegationResponse returns the delegation queried by a delegation request
+func DecodeDelegationResponse(cdc codec.BinaryCodec, result []byte) (stakingtypes.Delegation, error) {
+	delegation := stakingtypes.Delegation{}
+	if err := cdc.Unmarshal(result, &delegation); err != nil {
+		return stakingtypes.Delegation{}, sdkerrors.Wrap(err, "unable to unmarshal delegation")
+	}
+	return delegation, nil
+}
+
+// DecodeValidatorResponse returns the validator queried by a validator request
+func DecodeValidatorResponse(cdc codec.BinaryCodec, result []byte) (stakingtypes.Validator, error) {
+	validator := stakingtypes.Validator{}
+	if err := cdc.Unmarshal(result, &validator); err != nil {
+		return stakingtypes.Validator{}, sdkerrors.Wrap(err, "unable to unmarshal validator")
+	}
+	return validator, nil
+}
+
+// DecodeSigningInfoResponse returns the signing info queried by a signing info request
+func DecodeSigningInfoResponse(cdc codec.BinaryCodec, result []byte) (slashingtypes.ValidatorSigningInfo, error) {
+	signingInfo := slashingtypes.ValidatorSigningInfo{}
+	if err := cdc.Unmarshal(result, &signingInfo); err != nil {
+		return slashingtypes.ValidatorSigningInfo{}, sdkerrors.Wrap(err, "unable to unmarshal signing info")
+	}
+	return signingInfo, nil
+}
+
+// DecodeOutstandingRewardsResponse returns the rewards queried by an outstanding rewards request
+func DecodeOutstandingRewardsResponse(cdc codec.BinaryCodec, result []byte) (distrtypes.ValidatorOutstandingRewards, error) {
+	rewards := distrtypes.ValidatorOutstandingRewards{}
+	if err := cdc.Unmarshal(result, &rewards); err != nil {
+		return distrtypes.ValidatorOutstandingRewards{}, sdkerrors.Wrap(err, "unable to unmarshal outstanding rewards")
+	}
+	return rewards, nil
+}
+
+// DecodeAccountResponse returns the account queried by an account request, or nil if the account doesn't exist on
+// the host. The account's type must be registered in the codec's interface registry
+func DecodeAccountResponse(cdc codec.BinaryCodec, result []byte) (authtypes.AccountI, error) {
+	if len(result) == 0 {
+		return nil, nil
+	}
+	var account authtypes.AccountI
+	if err := cdc.UnmarshalInterface(result, &account); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to unmarshal account")
+	}
+	return account, nil
+}
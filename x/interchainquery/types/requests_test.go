@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/Stride-Labs/stride/app"
+	"github.com/Stride-Labs/stride/x/interchainquery/types"
+)
+
+var (
+	accAddr  = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr  = sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	consAddr = sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestBalanceRequest(t *testing.T) {
+	request := types.NewBalanceRequest(accAddr, "uatom")
+	addr, denom, err := types.ParseBalanceRequest(request)
+	require.NoError(t, err)
+	require.Equal(t, accAddr, addr)
+	require.Equal(t, "uatom", denom)
+
+	_, _, err = types.ParseBalanceRequest(types.NewValidatorRequest(valAddr))
+	require.ErrorIs(t, err, types.ErrInvalidQueryType)
+}
+
+func TestDecodeResponses(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	request := types.NewBalanceRequest(accAddr, "uatom")
+
+	// balances that aren't set on the host are zero coins of the requested denom
+	coin, err := types.DecodeBalanceResponse(cdc, request, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("uatom", 0), coin)
+
+	expectedCoin := sdk.NewInt64Coin("uatom", 42)
+	coin, err = types.DecodeBalanceResponse(cdc, request, cdc.MustMarshal(&expectedCoin))
+	require.NoError(t, err)
+	require.Equal(t, expectedCoin, coin)
+
+	expectedDelegation := stakingtypes.NewDelegation(accAddr, valAddr, sdk.NewDec(10))
+	delegation, err := types.DecodeDelegationResponse(cdc, cdc.MustMarshal(&expectedDelegation))
+	require.NoError(t, err)
+	require.Equal(t, expectedDelegation, delegation)
+
+	_, err = types.DecodeValidatorResponse(cdc, []byte("invalid"))
+	require.ErrorContains(t, err, "unable to unmarshal validator")
+
+	account, err := types.DecodeAccountResponse(cdc, nil)
+	require.NoError(t, err)
+	require.Nil(t, account)
+}
+
+func TestQueryTypeRegistry(t *testing.T) {
+	registry := types.DefaultQueryTypeRegistry()
+	registry.Register("custom")
+
+	tests := []struct {
+		name      string
+		queryType string
+		request   []byte
+		valid     bool
+	}{
+		{name: "balance", queryType: types.BANK_STORE_QUERY_WITH_PROOF, request: types.NewBalanceRequest(accAddr, "uatom"), valid: true},
+		{name: "delegation", queryType: types.STAKING_STORE_QUERY_WITH_PROOF, request: types.NewDelegationRequest(accAddr, valAddr), valid: true},
+		{name: "validator", queryType: types.STAKING_STORE_QUERY_WITH_PROOF, request: types.NewValidatorRequest(valAddr), valid: true},
+		{name: "signing info", queryType: types.SLASHING_STORE_QUERY_WITH_PROOF, request: types.NewSigningInfoRequest(consAddr), valid: true},
+		{name: "outstanding rewards", queryType: types.DISTRIBUTION_STORE_QUERY_WITH_PROOF, request: types.NewOutstandingRewardsRequest(valAddr), valid: true},
+		{name: "account", queryType: types.AUTH_STORE_QUERY_WITH_PROOF, request: types.NewAccountRequest(accAddr), valid: true},
+		{name: "custom type without prefixes", queryType: "custom", request: []byte{1}, valid: true},
+		{name: "key of another store", queryType: types.BANK_STORE_QUERY_WITH_PROOF, request: types.NewValidatorRequest(valAddr)},
+		{name: "bare prefix", queryType: types.STAKING_STORE_QUERY_WITH_PROOF, request: stakingtypes.ValidatorsKey},
+		{name: "empty request", queryType: "custom", request: []byte{}},
+		{name: "unregistered type", queryType: "store/gov/key", request: []byte{1}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := registry.Validate(tc.queryType, tc.request)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidQueryType)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+// Telemetry metric keys, emitted under the interchainquery module prefix
+const (
+	MetricKeyQueryEmitted   = "query_emitted"
+	MetricKeyQueryAnswered  = "query_answered"
+	MetricKeyQueryRetried   = "query_retried"
+	MetricKeyQueryFailed    = "query_failed"
+	MetricKeyCallbackFailed = "callback_failed"
+	MetricKeyDatapointAge   = "datapoint_age_blocks"
+
+	MetricLabelChainId    = "chain_id"
+	MetricLabelCallbackId = "callback_id"
+)
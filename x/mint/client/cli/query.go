@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryEpochProvisions(),
+		GetCmdQueryDistributionRecipients(),
 	)
 
 	return mintingQueryCmd
@@ -87,3 +88,32 @@ func GetCmdQueryEpochProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryDistributionRecipients implements a command to return the addresses
+// that receive the pool incentives and participation rewards.
+func GetCmdQueryDistributionRecipients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-recipients",
+		Short: "Query the addresses that receive the pool incentives and participation rewards",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDistributionRecipientsRequest{}
+			res, err := queryClient.DistributionRecipients(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -11,6 +11,9 @@ import (
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, data *types.GenesisState) {
 	data.Minter.EpochProvisions = data.Params.GenesisEpochProvisions
 	keeper.SetMinter(ctx, data.Minter)
+	if err := keeper.ValidateDistributionRecipients(data.Params); err != nil {
+		panic(err)
+	}
 	keeper.SetParams(ctx, data.Params)
 
 	if !ak.HasAccount(ctx, ak.GetModuleAddress(types.ModuleName)) {
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Stride-Labs/stride/x/mint/types"
 )
@@ -35,3 +37,20 @@ func (q Querier) EpochProvisions(c context.Context, _ *types.QueryEpochProvision
 
 	return &types.QueryEpochProvisionsResponse{EpochProvisions: minter.EpochProvisions}, nil
 }
+
+// DistributionRecipients returns the addresses the pool incentives and participation rewards are distributed to.
+func (q Querier) DistributionRecipients(c context.Context, _ *types.QueryDistributionRecipientsRequest) (*types.QueryDistributionRecipientsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.Keeper.GetParams(ctx)
+
+	poolIncentives, err := q.Keeper.GetDistributionRecipientAddresses(ctx, params.PoolIncentivesRecipient)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	participationRewards, err := q.Keeper.GetDistributionRecipientAddresses(ctx, params.ParticipationRewardsRecipient)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDistributionRecipientsResponse{PoolIncentives: poolIncentives, ParticipationRewards: participationRewards}, nil
+}
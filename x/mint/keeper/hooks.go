@@ -8,12 +8,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	return nil
 }
 
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
 	epochIdentifier := epochInfo.Identifier
 	epochNumber := epochInfo.CurrentEpoch
 	params := k.GetParams(ctx)
@@ -21,7 +23,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo)
 	if epochIdentifier == params.EpochIdentifier {
 		// not distribute rewards if it's not time yet for rewards distribution
 		if epochNumber < params.MintingRewardsDistributionStartEpoch {
-			return
+			return nil
 		} else if epochNumber == params.MintingRewardsDistributionStartEpoch {
 			k.SetLastReductionEpochNum(ctx, epochNumber)
 		}
@@ -46,13 +48,13 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo)
 		// We over-allocate by the developer vesting portion, and burn this later
 		err := k.MintCoins(ctx, mintedCoins)
 		if err != nil {
-			panic(err)
+			return sdkerrors.Wrapf(err, "unable to mint %s", mintedCoins)
 		}
 
 		// send the minted coins to the fee collector account
 		err = k.DistributeMintedCoin(ctx, mintedCoin)
 		if err != nil {
-			panic(err)
+			return sdkerrors.Wrapf(err, "unable to distribute %s", mintedCoin)
 		}
 
 		if mintedCoin.Amount.IsInt64() {
@@ -68,6 +70,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo)
 			),
 		)
 	}
+	return nil
 }
 
 // ___________________________________________________________________________________________________
@@ -85,10 +88,17 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // epochs hooks.
-func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
-	h.k.BeforeEpochStart(ctx, epochInfo)
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	return h.k.BeforeEpochStart(ctx, epochInfo)
 }
 
-func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
-	h.k.AfterEpochEnd(ctx, epochInfo)
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	return h.k.AfterEpochEnd(ctx, epochInfo)
+}
+
+var _ epochstypes.EpochDependency = Keeper{}
+
+// EpochIdentifiersInUse returns the epoch that coins are minted on
+func (k Keeper) EpochIdentifiersInUse(ctx sdk.Context) []string {
+	return []string{k.GetParams(ctx).EpochIdentifier}
 }
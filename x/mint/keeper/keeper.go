@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -149,24 +150,15 @@ func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error
 		return err
 	}
 
-	// allocate pool allocation ratio to pool-incentives module account account
-	poolIncentivesCoins := sdk.NewCoins(k.GetProportions(ctx, mintedCoin, proportions.PoolIncentives))
-	// temporary until we have incentives pool sorted :)
-	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, poolIncentivesCoins)
+	// allocate pool incentives and participation rewards to their recipients
+	poolIncentivesCoins, err := k.distributeToRecipient(ctx, k.GetProportions(ctx, mintedCoin, proportions.PoolIncentives), params.PoolIncentivesRecipient)
 	if err != nil {
-		return err
+		return sdkerrors.Wrap(err, "unable to allocate pool incentives")
 	}
 
-	// err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, poolincentivestypes.ModuleName, poolIncentivesCoins)
-	// if err != nil {
-	// 	return err
-	// }
-
-	participationRewardCoin := k.GetProportions(ctx, mintedCoin, proportions.ParticipationRewards)
-	participationRewardCoins := sdk.NewCoins(participationRewardCoin)
-	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, participationRewardCoins)
+	participationRewardCoins, err := k.distributeToRecipient(ctx, k.GetProportions(ctx, mintedCoin, proportions.ParticipationRewards), params.ParticipationRewardsRecipient)
 	if err != nil {
-		return err
+		return sdkerrors.Wrap(err, "unable to allocate participation rewards")
 	}
 
 	// Take the current balance of the developer rewards pool and remove it from the supply offset
@@ -178,7 +170,8 @@ func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error
 	// developerAccountBalance = k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName), mintedCoin.Denom)
 	// k.bankKeeper.AddSupplyOffset(ctx, mintedCoin.Denom, developerAccountBalance.Amount.Neg())
 
-	// subtract from original provision to ensure no coins left over after the allocations
+	// subtract from original provision to ensure no coins left over after the allocations, including the rounding of
+	// weighted addresses' shares
 	communityPoolCoins := sdk.NewCoins(mintedCoin).Sub(stakingIncentivesCoins).Sub(poolIncentivesCoins).Sub(participationRewardCoins)
 	err = k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, k.accountKeeper.GetModuleAddress(types.ModuleName))
 	if err != nil {
@@ -186,7 +179,71 @@ func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error
 	}
 
 	// call an hook after the minting and distribution of new coins
-	k.hooks.AfterDistributeMintedCoin(ctx, mintedCoin)
+	if k.hooks != nil {
+		k.hooks.AfterDistributeMintedCoin(ctx, mintedCoin)
+	}
 
 	return err
 }
+
+// ValidateDistributionRecipients checks that the weighted addresses of the pool incentives and participation rewards
+// recipients can receive coins, as the bank keeper refuses to send to its blocked addresses (e.g. module accounts)
+func (k Keeper) ValidateDistributionRecipients(params types.Params) error {
+	for _, recipient := range []types.DistributionRecipient{params.PoolIncentivesRecipient, params.ParticipationRewardsRecipient} {
+		for _, weightedAddress := range recipient.WeightedAddresses {
+			address, err := sdk.AccAddressFromBech32(weightedAddress.Address)
+			if err != nil {
+				return err
+			}
+			if k.bankKeeper.BlockedAddr(address) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", weightedAddress.Address)
+			}
+		}
+	}
+	return nil
+}
+
+// GetDistributionRecipientAddresses returns the addresses a distribution recipient stands for, with their weights. A
+// module account receives the whole allocation.
+func (k Keeper) GetDistributionRecipientAddresses(ctx sdk.Context, recipient types.DistributionRecipient) ([]types.WeightedAddress, error) {
+	if recipient.ModuleAccount == "" {
+		return recipient.WeightedAddresses, nil
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(recipient.ModuleAccount)
+	if moduleAddress == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipient.ModuleAccount)
+	}
+	return []types.WeightedAddress{{Address: moduleAddress.String(), Weight: sdk.OneDec()}}, nil
+}
+
+// distributeToRecipient sends an allocation of the minted coin to its recipient, and returns the coins sent, which are
+// less than the allocation if rounding down the weighted addresses' shares leaves some over.
+func (k Keeper) distributeToRecipient(ctx sdk.Context, allocation sdk.Coin, recipient types.DistributionRecipient) (sdk.Coins, error) {
+	if recipient.ModuleAccount != "" {
+		// module accounts can't receive coins from SendCoinsFromModuleToAccount, and
+		// SendCoinsFromModuleToModule panics if the account isn't registered
+		if k.accountKeeper.GetModuleAddress(recipient.ModuleAccount) == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipient.ModuleAccount)
+		}
+		coins := sdk.NewCoins(allocation)
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.ModuleAccount, coins); err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to send %s to module account %s", coins, recipient.ModuleAccount)
+		}
+		return coins, nil
+	}
+
+	sent := sdk.NewCoins()
+	for _, weightedAddress := range recipient.WeightedAddresses {
+		address, err := sdk.AccAddressFromBech32(weightedAddress.Address)
+		if err != nil {
+			return nil, err
+		}
+		coins := sdk.NewCoins(k.GetProportions(ctx, allocation, weightedAddress.Weight))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins); err != nil {
+			return nil, sdkerrors.Wrapf(err, "unable to send %s to %s", coins, weightedAddress.Address)
+		}
+		sent = sent.Add(coins...)
+	}
+	return sent, nil
+}
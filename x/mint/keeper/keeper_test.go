@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramsmodule "github.com/cosmos/cosmos-sdk/x/params"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/app/apptesting"
+	"github.com/Stride-Labs/stride/x/mint"
+	"github.com/Stride-Labs/stride/x/mint/keeper"
+	"github.com/Stride-Labs/stride/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.AppTestHelper
+	queryClient types.QueryClient
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.queryClient = types.NewQueryClient(s.QueryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) moduleBalance(moduleName string) sdk.Int {
+	address := s.App.AccountKeeper.GetModuleAddress(moduleName)
+	return s.App.BankKeeper.GetBalance(s.Ctx, address, sdk.DefaultBondDenom).Amount
+}
+
+func (s *KeeperTestSuite) TestDistributeMintedCoin() {
+	params := types.DefaultParams()
+	params.DistributionProportions = types.DistributionProportions{
+		Staking:              sdk.MustNewDecFromStr("0.4"),
+		PoolIncentives:       sdk.MustNewDecFromStr("0.3"),
+		ParticipationRewards: sdk.MustNewDecFromStr("0.2"),
+		CommunityPool:        sdk.MustNewDecFromStr("0.1"),
+	}
+	params.ParticipationRewardsRecipient = types.DistributionRecipient{
+		WeightedAddresses: []types.WeightedAddress{
+			{Address: s.TestAccs[0].String(), Weight: sdk.MustNewDecFromStr("0.333333333333333333")},
+			{Address: s.TestAccs[1].String(), Weight: sdk.MustNewDecFromStr("0.666666666666666667")},
+		},
+	}
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+
+	feeCollectorBalance := s.moduleBalance(authtypes.FeeCollectorName)
+	communityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(sdk.DefaultBondDenom)
+	mintedCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)
+	s.FundModuleAccount(types.ModuleName, mintedCoin)
+	err := s.App.MintKeeper.DistributeMintedCoin(s.Ctx, mintedCoin)
+	s.Require().NoError(err)
+
+	// pool incentives go to their module account, and participation rewards to the weighted addresses, rounded down,
+	// with the leftover sent to the community pool
+	s.Require().Equal(int64(400), s.moduleBalance(authtypes.FeeCollectorName).Sub(feeCollectorBalance).Int64())
+	s.Require().Equal(int64(300), s.moduleBalance(types.PoolIncentivesModuleAcctName).Int64())
+	s.Require().Equal(int64(66), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], sdk.DefaultBondDenom).Amount.Int64())
+	s.Require().Equal(int64(133), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], sdk.DefaultBondDenom).Amount.Int64())
+	newCommunityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(sdk.DefaultBondDenom)
+	s.Require().Equal("101", newCommunityPool.Sub(communityPool).TruncateInt().String())
+	s.Require().True(s.moduleBalance(types.ModuleName).IsZero())
+
+	// an address that is blocked from receiving coins fails the distribution
+	params.PoolIncentivesRecipient = types.DistributionRecipient{
+		WeightedAddresses: []types.WeightedAddress{{Address: s.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String(), Weight: sdk.OneDec()}},
+	}
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+	s.FundModuleAccount(types.ModuleName, mintedCoin)
+	err = s.App.MintKeeper.DistributeMintedCoin(s.Ctx, mintedCoin)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestValidateDistributionRecipients() {
+	params := types.DefaultParams()
+	params.ParticipationRewardsRecipient = types.DistributionRecipient{
+		WeightedAddresses: []types.WeightedAddress{{Address: s.TestAccs[0].String(), Weight: sdk.OneDec()}},
+	}
+	s.Require().NoError(s.App.MintKeeper.ValidateDistributionRecipients(params))
+
+	// module accounts are blocked from receiving coins sent to an address
+	blocked := s.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+	params.ParticipationRewardsRecipient = types.DistributionRecipient{
+		WeightedAddresses: []types.WeightedAddress{{Address: blocked, Weight: sdk.OneDec()}},
+	}
+	err := s.App.MintKeeper.ValidateDistributionRecipients(params)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// and a param change proposal setting one fails
+	handler := mint.NewParamChangeProposalHandler(paramsmodule.NewParamChangeProposalHandler(s.App.ParamsKeeper), s.App.MintKeeper)
+	proposal := paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{{
+		Subspace: types.ModuleName,
+		Key:      string(types.KeyParticipationRewardsRecipient),
+		Value:    fmt.Sprintf(`{"module_account":"","weighted_addresses":[{"address":"%s","weight":"1.000000000000000000"}]}`, blocked),
+	}})
+	err = handler(s.Ctx, proposal)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestDistributionRecipientsQuery() {
+	params := types.DefaultParams()
+	params.ParticipationRewardsRecipient = types.DistributionRecipient{
+		WeightedAddresses: []types.WeightedAddress{{Address: s.TestAccs[0].String(), Weight: sdk.OneDec()}},
+	}
+	s.App.MintKeeper.SetParams(s.Ctx, params)
+
+	res, err := s.queryClient.DistributionRecipients(sdk.WrapSDKContext(s.Ctx), &types.QueryDistributionRecipientsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryDistributionRecipientsResponse{
+		PoolIncentives: []types.WeightedAddress{{
+			Address: s.App.AccountKeeper.GetModuleAddress(types.PoolIncentivesModuleAcctName).String(),
+			Weight:  sdk.OneDec(),
+		}},
+		ParticipationRewards: params.ParticipationRewardsRecipient.WeightedAddresses,
+	}, res)
+}
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	// remove the recipients from the params, as they were before the migration
+	paramsStore := s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey))
+	paramsStore.Delete(append([]byte(types.ModuleName+"/"), types.KeyPoolIncentivesRecipient...))
+	paramsStore.Delete(append([]byte(types.ModuleName+"/"), types.KeyParticipationRewardsRecipient...))
+	s.Require().Panics(func() { s.App.MintKeeper.GetParams(s.Ctx) })
+
+	err := keeper.NewMigrator(s.App.MintKeeper).Migrate1to2(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), s.App.MintKeeper.GetParams(s.Ctx))
+}
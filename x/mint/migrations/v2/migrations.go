@@ -0,0 +1,20 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Stride-Labs/stride/x/mint/types"
+)
+
+// MigrateParams adds the recipients of the pool incentives and participation rewards allocations, which were burned
+// before, and sends them to the module accounts that hold them by default
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeyPoolIncentivesRecipient, defaultParams.PoolIncentivesRecipient)
+	paramSpace.Set(ctx, types.KeyParticipationRewardsRecipient, defaultParams.ParticipationRewardsRecipient)
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	return params.Validate()
+}
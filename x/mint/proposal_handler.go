@@ -0,0 +1,30 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/Stride-Labs/stride/x/mint/keeper"
+	"github.com/Stride-Labs/stride/x/mint/types"
+)
+
+// NewParamChangeProposalHandler wraps the params module's proposal handler, so that a change of the mint params fails
+// if it leaves a distribution recipient that can't receive coins, which the param validation can't check on its own
+func NewParamChangeProposalHandler(paramsHandler govtypes.Handler, k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := paramsHandler(ctx, content); err != nil {
+			return err
+		}
+		proposal, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range proposal.Changes {
+			if change.Subspace == types.ModuleName {
+				return k.ValidateDistributionRecipients(k.GetParams(ctx))
+			}
+		}
+		return nil
+	}
+}
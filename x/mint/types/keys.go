@@ -11,6 +11,12 @@ const (
 	ModuleName = "mint"
 	// module acct name for developer vesting.
 	DeveloperVestingModuleAcctName = "developer_vesting_unvested"
+	// module acct name holding the pool incentives allocation by default. No module spends from it yet, the coins
+	// accrue until a spender is added in an upgrade.
+	PoolIncentivesModuleAcctName = "pool_incentives"
+	// module acct name holding the participation rewards allocation by default. No module spends from it yet, the
+	// coins accrue until a spender is added in an upgrade.
+	ParticipationRewardsModuleAcctName = "participation_rewards"
 
 	// StoreKey is the default store key for mint.
 	StoreKey = ModuleName
@@ -22,3 +28,8 @@ const (
 	QueryParameters      = "parameters"
 	QueryEpochProvisions = "epoch_provisions"
 )
+
+// AllowedRecipientModuleAccounts are the module accounts that can receive the pool incentives and participation
+// rewards. Other module accounts track their balances in their own state, which coins sent from the mint module
+// would bypass.
+var AllowedRecipientModuleAccounts = []string{PoolIncentivesModuleAcctName, ParticipationRewardsModuleAcctName}
@@ -114,6 +114,111 @@ func (m *DistributionProportions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
 
+// WeightedAddress is an address that receives a weighted share of an
+// allocation of the minted minted_denom.
+type WeightedAddress struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedAddress) Reset()         { *m = WeightedAddress{} }
+func (m *WeightedAddress) String() string { return proto.CompactTextString(m) }
+func (*WeightedAddress) ProtoMessage()    {}
+func (*WeightedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06339c129491fd39, []int{2}
+}
+func (m *WeightedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedAddress.Merge(m, src)
+}
+func (m *WeightedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
+
+func (m *WeightedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// DistributionRecipient defines who receives an allocation of the minted
+// minted_denom: either a module account, or a list of weighted addresses.
+type DistributionRecipient struct {
+	// module_account is the name of the module account receiving the whole
+	// allocation.
+	ModuleAccount string `protobuf:"bytes,1,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	// weighted_addresses split the allocation by weight, when module_account is
+	// empty. Their weights add up to 1.
+	WeightedAddresses []WeightedAddress `protobuf:"bytes,2,rep,name=weighted_addresses,json=weightedAddresses,proto3" json:"weighted_addresses" yaml:"weighted_addresses"`
+}
+
+func (m *DistributionRecipient) Reset()         { *m = DistributionRecipient{} }
+func (m *DistributionRecipient) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecipient) ProtoMessage()    {}
+func (*DistributionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06339c129491fd39, []int{3}
+}
+func (m *DistributionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecipient.Merge(m, src)
+}
+func (m *DistributionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRecipient proto.InternalMessageInfo
+
+func (m *DistributionRecipient) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *DistributionRecipient) GetWeightedAddresses() []WeightedAddress {
+	if m != nil {
+		return m.WeightedAddresses
+	}
+	return nil
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
@@ -130,12 +235,17 @@ type Params struct {
 	DistributionProportions DistributionProportions `protobuf:"bytes,6,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
 	// start epoch to distribute minting rewards
 	MintingRewardsDistributionStartEpoch int64 `protobuf:"varint,7,opt,name=minting_rewards_distribution_start_epoch,json=mintingRewardsDistributionStartEpoch,proto3" json:"minting_rewards_distribution_start_epoch,omitempty" yaml:"minting_rewards_distribution_start_epoch"`
+	// pool_incentives_recipient receives the pool_incentives allocation
+	PoolIncentivesRecipient DistributionRecipient `protobuf:"bytes,8,opt,name=pool_incentives_recipient,json=poolIncentivesRecipient,proto3" json:"pool_incentives_recipient" yaml:"pool_incentives_recipient"`
+	// participation_rewards_recipient receives the participation_rewards
+	// allocation
+	ParticipationRewardsRecipient DistributionRecipient `protobuf:"bytes,9,opt,name=participation_rewards_recipient,json=participationRewardsRecipient,proto3" json:"participation_rewards_recipient" yaml:"participation_rewards_recipient"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_06339c129491fd39, []int{2}
+	return fileDescriptor_06339c129491fd39, []int{4}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -199,58 +309,86 @@ func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
 	return 0
 }
 
+func (m *Params) GetPoolIncentivesRecipient() DistributionRecipient {
+	if m != nil {
+		return m.PoolIncentivesRecipient
+	}
+	return DistributionRecipient{}
+}
+
+func (m *Params) GetParticipationRewardsRecipient() DistributionRecipient {
+	if m != nil {
+		return m.ParticipationRewardsRecipient
+	}
+	return DistributionRecipient{}
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "stride.mint.v1beta1.Minter")
 	proto.RegisterType((*DistributionProportions)(nil), "stride.mint.v1beta1.DistributionProportions")
+	proto.RegisterType((*WeightedAddress)(nil), "stride.mint.v1beta1.WeightedAddress")
+	proto.RegisterType((*DistributionRecipient)(nil), "stride.mint.v1beta1.DistributionRecipient")
 	proto.RegisterType((*Params)(nil), "stride.mint.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("mint/v1beta1/mint.proto", fileDescriptor_06339c129491fd39) }
 
 var fileDescriptor_06339c129491fd39 = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x0a, 0x2c, 0x61, 0x8c, 0x60, 0x2a, 0xb0, 0x15, 0xb5, 0xc5, 0x46, 0x0d, 0x31, 0xb2,
-	0x0d, 0x72, 0xe3, 0x64, 0x36, 0x2b, 0xba, 0x89, 0x9a, 0x75, 0xb8, 0x71, 0x69, 0xa6, 0xed, 0x50,
-	0x26, 0xd0, 0x99, 0x3a, 0x33, 0x8b, 0xee, 0xc5, 0x8b, 0x47, 0x2e, 0x1e, 0x3d, 0xfa, 0x73, 0x38,
-	0x72, 0x34, 0x1c, 0x1a, 0x03, 0xff, 0x80, 0x5f, 0x60, 0x66, 0xa6, 0xbb, 0xb0, 0x95, 0x4d, 0xdc,
-	0x78, 0xda, 0xce, 0xf7, 0x5e, 0xdf, 0xf7, 0xed, 0xf7, 0xde, 0x9b, 0x82, 0x46, 0x46, 0xa8, 0x0c,
-	0x8e, 0x36, 0x22, 0x2c, 0xd1, 0x46, 0xa0, 0x0e, 0xcd, 0x9c, 0x33, 0xc9, 0xec, 0x7b, 0x42, 0x72,
-	0x92, 0xe0, 0xa6, 0x86, 0xca, 0xf8, 0xca, 0x62, 0xca, 0x52, 0xa6, 0xe3, 0x81, 0x7a, 0x32, 0xa9,
-	0x2b, 0x5e, 0xca, 0x58, 0x7a, 0x88, 0x03, 0x7d, 0x8a, 0x7a, 0x7b, 0x81, 0x24, 0x19, 0x16, 0x12,
-	0x65, 0x79, 0x99, 0x70, 0xbf, 0x9a, 0x80, 0x68, 0xbf, 0x0c, 0xb9, 0xd5, 0x50, 0xd2, 0xe3, 0x48,
-	0x12, 0x46, 0x4d, 0xdc, 0xff, 0x0a, 0xea, 0xef, 0x09, 0x95, 0x98, 0xdb, 0x12, 0xdc, 0xc5, 0x39,
-	0x8b, 0xf7, 0xc3, 0x9c, 0xb3, 0x23, 0x22, 0x08, 0xa3, 0xc2, 0xb1, 0x56, 0xad, 0xb5, 0xb9, 0x56,
-	0xe7, 0xa4, 0xf0, 0x6a, 0x67, 0x85, 0xf7, 0x2c, 0x25, 0x72, 0xbf, 0x17, 0x35, 0x63, 0x96, 0x05,
-	0x31, 0x13, 0x19, 0x13, 0xe5, 0xcf, 0xba, 0x48, 0x0e, 0x02, 0xd9, 0xcf, 0xb1, 0x68, 0xb6, 0x71,
-	0x7c, 0x59, 0x78, 0x8d, 0x3e, 0xca, 0x0e, 0xb7, 0xfc, 0x6a, 0x3d, 0x1f, 0x2e, 0x68, 0xa8, 0x7b,
-	0x85, 0x14, 0x53, 0xa0, 0xd1, 0x26, 0xca, 0x8b, 0xa8, 0xa7, 0x64, 0x75, 0x39, 0xcb, 0x19, 0x57,
-	0x4f, 0xc2, 0xde, 0x05, 0xb3, 0x42, 0xa2, 0x03, 0x42, 0xd3, 0x52, 0xc8, 0xab, 0x89, 0x85, 0xcc,
-	0x1b, 0x21, 0x65, 0x19, 0x1f, 0x0e, 0x0a, 0xda, 0x9f, 0xc0, 0x42, 0xce, 0xd8, 0x61, 0x48, 0x68,
-	0x8c, 0xa9, 0x24, 0x47, 0x58, 0x38, 0xb7, 0x34, 0xc7, 0xdb, 0x89, 0x39, 0x96, 0x0d, 0x47, 0xa5,
-	0x9c, 0x0f, 0xe7, 0x15, 0xd2, 0x19, 0x02, 0xf6, 0x37, 0x0b, 0x2c, 0xe5, 0x88, 0x4b, 0x12, 0x93,
-	0x5c, 0xb7, 0x20, 0xe4, 0xf8, 0x33, 0xe2, 0x89, 0x70, 0xa6, 0x34, 0xf3, 0x87, 0x89, 0x99, 0x1f,
-	0x96, 0xcc, 0x37, 0x15, 0xf5, 0xe1, 0xe2, 0x08, 0x0e, 0x0d, 0x6c, 0x53, 0x30, 0x1f, 0xb3, 0x2c,
-	0xeb, 0x51, 0x22, 0xfb, 0xa1, 0x52, 0xe8, 0x4c, 0x6b, 0xf6, 0x37, 0x13, 0xb3, 0x2f, 0x19, 0xf6,
-	0xd1, 0x6a, 0x3e, 0xbc, 0x33, 0x04, 0xba, 0xea, 0x7c, 0x36, 0x03, 0xea, 0x5d, 0xc4, 0x51, 0x26,
-	0xec, 0x47, 0x00, 0xa8, 0x69, 0x0f, 0x13, 0x4c, 0x59, 0x66, 0x5a, 0x0a, 0xe7, 0x14, 0xd2, 0x56,
-	0x80, 0x7d, 0x6c, 0x01, 0x27, 0xc5, 0x14, 0x0b, 0x22, 0xc2, 0xbf, 0x26, 0xd1, 0x34, 0xe7, 0xe3,
-	0xc4, 0x22, 0x3d, 0x23, 0x72, 0x5c, 0x5d, 0x1f, 0x2e, 0x97, 0xa1, 0xd7, 0xa3, 0x83, 0x69, 0x6f,
-	0x0f, 0xd6, 0x81, 0x24, 0xaa, 0x81, 0x7b, 0x04, 0xf3, 0xb2, 0x4f, 0x0f, 0xaa, 0x03, 0x7e, 0x95,
-	0x31, 0x18, 0xf0, 0xce, 0x10, 0xb1, 0x23, 0xb0, 0xc2, 0x71, 0xd2, 0x8b, 0x75, 0x6f, 0x72, 0xcc,
-	0x09, 0x4b, 0x42, 0x42, 0x8d, 0x10, 0xa1, 0xbd, 0x9f, 0x6a, 0x3d, 0xbd, 0x2c, 0xbc, 0xc7, 0xa6,
-	0xe2, 0xf8, 0x5c, 0x1f, 0x36, 0x86, 0xc1, 0xae, 0x8e, 0x75, 0xa8, 0x16, 0x2d, 0xd4, 0xea, 0x5e,
-	0xbd, 0xb7, 0x87, 0x62, 0xc9, 0xb8, 0x33, 0xf3, 0x7f, 0xab, 0x5b, 0xad, 0xe7, 0xc3, 0x85, 0x21,
-	0xb4, 0xad, 0x11, 0x3b, 0x03, 0x4e, 0x72, 0x6d, 0x73, 0x95, 0xab, 0x83, 0xd5, 0x75, 0xea, 0xab,
-	0xd6, 0xda, 0xed, 0x97, 0x2f, 0x9a, 0x37, 0x5c, 0x72, 0xcd, 0x31, 0xeb, 0xde, 0x9a, 0x56, 0x5a,
-	0x61, 0x23, 0x19, 0x73, 0x1b, 0x1c, 0x5b, 0x60, 0x4d, 0xd5, 0x21, 0x34, 0x1d, 0xcc, 0x78, 0x38,
-	0xc2, 0x2f, 0x24, 0xe2, 0xd2, 0x98, 0xe5, 0xcc, 0x6a, 0x5f, 0x37, 0x2f, 0x0b, 0x2f, 0x30, 0xff,
-	0xe7, 0x5f, 0xdf, 0xf4, 0xe1, 0x93, 0x32, 0xb5, 0x5c, 0x98, 0xeb, 0x6a, 0x77, 0x54, 0x9e, 0xf6,
-	0x7c, 0x6b, 0xfa, 0xc7, 0x4f, 0xaf, 0xd6, 0x6a, 0x9f, 0x9c, 0xbb, 0xd6, 0xe9, 0xb9, 0x6b, 0xfd,
-	0x3e, 0x77, 0xad, 0xef, 0x17, 0x6e, 0xed, 0xf4, 0xc2, 0xad, 0xfd, 0xba, 0x70, 0x6b, 0xbb, 0xcf,
-	0xaf, 0x19, 0xbe, 0xa3, 0x4d, 0x58, 0x7f, 0x87, 0x22, 0x11, 0x18, 0x43, 0x82, 0x2f, 0xfa, 0x53,
-	0x60, 0x8c, 0x8f, 0xea, 0xfa, 0x2a, 0xde, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x66, 0xc9,
-	0xdd, 0x2c, 0x06, 0x00, 0x00,
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x92, 0xe0, 0x34, 0x53, 0x25, 0x81, 0xa1, 0xa9, 0x37, 0x81, 0x7a, 0xd3, 0x51, 0xa9,
+	0xac, 0xaa, 0xf5, 0xaa, 0xed, 0xad, 0x17, 0x5a, 0x2b, 0x14, 0x22, 0x01, 0x32, 0xd3, 0x43, 0xa5,
+	0x5e, 0x56, 0xeb, 0xdd, 0xc9, 0x66, 0xd4, 0xec, 0xcc, 0x32, 0x33, 0x9b, 0x90, 0x0b, 0x17, 0x8e,
+	0xbd, 0x54, 0x9c, 0x7a, 0x44, 0xfc, 0x35, 0x3d, 0x96, 0x1b, 0xe2, 0x60, 0xa1, 0xe4, 0x3f, 0xc8,
+	0x5f, 0x80, 0xe6, 0x87, 0xbd, 0xeb, 0xc5, 0x06, 0xac, 0x9e, 0xbc, 0xfb, 0xbd, 0xb7, 0xdf, 0xfb,
+	0xe6, 0xcd, 0x7b, 0x9f, 0x0c, 0x3a, 0x39, 0x65, 0x2a, 0x3c, 0xb9, 0x3f, 0x22, 0x2a, 0xbe, 0x1f,
+	0xea, 0x97, 0x7e, 0x21, 0xb8, 0xe2, 0xf0, 0x13, 0xa9, 0x04, 0x4d, 0x49, 0xdf, 0x40, 0x2e, 0xbe,
+	0x7b, 0x2d, 0xe3, 0x19, 0x37, 0xf1, 0x50, 0x3f, 0xd9, 0xd4, 0xdd, 0x20, 0xe3, 0x3c, 0x3b, 0x26,
+	0xa1, 0x79, 0x1b, 0x95, 0x87, 0xa1, 0xa2, 0x39, 0x91, 0x2a, 0xce, 0x0b, 0x97, 0xb0, 0xd3, 0x4c,
+	0x88, 0xd9, 0x99, 0x0b, 0x75, 0x9b, 0xa1, 0xb4, 0x14, 0xb1, 0xa2, 0x9c, 0xd9, 0x38, 0xfa, 0x09,
+	0xb4, 0xbf, 0xa5, 0x4c, 0x11, 0x01, 0x15, 0xf8, 0x88, 0x14, 0x3c, 0x39, 0x8a, 0x0a, 0xc1, 0x4f,
+	0xa8, 0xa4, 0x9c, 0x49, 0xdf, 0xdb, 0xf3, 0x7a, 0xeb, 0x83, 0x83, 0xb7, 0xe3, 0xa0, 0xf5, 0xe7,
+	0x38, 0xb8, 0x9d, 0x51, 0x75, 0x54, 0x8e, 0xfa, 0x09, 0xcf, 0xc3, 0x84, 0xcb, 0x9c, 0x4b, 0xf7,
+	0x73, 0x4f, 0xa6, 0x2f, 0x43, 0x75, 0x56, 0x10, 0xd9, 0xdf, 0x27, 0xc9, 0xe5, 0x38, 0xe8, 0x9c,
+	0xc5, 0xf9, 0xf1, 0x23, 0xd4, 0xe4, 0x43, 0x78, 0xcb, 0x40, 0xc3, 0x0a, 0x19, 0xaf, 0x80, 0xce,
+	0x3e, 0xd5, 0xbd, 0x18, 0x95, 0x5a, 0xd6, 0x50, 0xf0, 0x82, 0x0b, 0xfd, 0x24, 0xe1, 0x0b, 0xb0,
+	0x26, 0x55, 0xfc, 0x92, 0xb2, 0xcc, 0x09, 0x79, 0xbc, 0xb4, 0x90, 0x4d, 0x2b, 0xc4, 0xd1, 0x20,
+	0x3c, 0x21, 0x84, 0x3f, 0x80, 0xad, 0x82, 0xf3, 0xe3, 0x88, 0xb2, 0x84, 0x30, 0x45, 0x4f, 0x88,
+	0xf4, 0x3f, 0x30, 0x35, 0xbe, 0x5e, 0xba, 0xc6, 0x75, 0x5b, 0xa3, 0x41, 0x87, 0xf0, 0xa6, 0x46,
+	0x0e, 0xa6, 0x00, 0xfc, 0xd9, 0x03, 0xdb, 0x45, 0x2c, 0x14, 0x4d, 0x68, 0x61, 0xae, 0x20, 0x12,
+	0xe4, 0x34, 0x16, 0xa9, 0xf4, 0x57, 0x4c, 0xe5, 0xef, 0x96, 0xae, 0xfc, 0x99, 0xab, 0x3c, 0x8f,
+	0x14, 0xe1, 0x6b, 0x33, 0x38, 0xb6, 0x30, 0x64, 0x60, 0x33, 0xe1, 0x79, 0x5e, 0x32, 0xaa, 0xce,
+	0x22, 0xad, 0xd0, 0x5f, 0x35, 0xd5, 0xbf, 0x5a, 0xba, 0xfa, 0xb6, 0xad, 0x3e, 0xcb, 0x86, 0xf0,
+	0xc6, 0x14, 0x18, 0xea, 0xf7, 0x37, 0x1e, 0xd8, 0x7a, 0x4e, 0x68, 0x76, 0xa4, 0x48, 0xfa, 0x24,
+	0x4d, 0x05, 0x91, 0x12, 0xde, 0x05, 0x6b, 0xb1, 0x7d, 0x74, 0x17, 0x0b, 0xab, 0xab, 0x72, 0x01,
+	0x84, 0x27, 0x29, 0xf0, 0x39, 0x68, 0x9f, 0x1a, 0x02, 0x77, 0x43, 0x5f, 0x2c, 0xad, 0x74, 0xc3,
+	0x52, 0x5b, 0x16, 0x84, 0x1d, 0x1d, 0xfa, 0xdd, 0x03, 0xdb, 0xf5, 0xd9, 0xc3, 0x24, 0xa1, 0x05,
+	0x25, 0x4c, 0xc1, 0xc7, 0x60, 0x33, 0xe7, 0x69, 0x79, 0x4c, 0xa2, 0x38, 0x49, 0x78, 0xc9, 0x94,
+	0xd3, 0xb9, 0x53, 0x1d, 0x7b, 0x36, 0x8e, 0xf0, 0x86, 0x05, 0x9e, 0xd8, 0x77, 0x78, 0x02, 0xe0,
+	0xa9, 0x3b, 0x75, 0xe4, 0x0e, 0x62, 0x46, 0x6c, 0xa5, 0x77, 0xf5, 0xc1, 0xad, 0xfe, 0x9c, 0xdd,
+	0xef, 0x37, 0x9a, 0x34, 0xb8, 0xa9, 0x8f, 0x79, 0x39, 0x0e, 0x76, 0xea, 0xe2, 0xeb, 0x6c, 0x08,
+	0x7f, 0x7c, 0x3a, 0xfb, 0x0d, 0x91, 0xe8, 0x97, 0x2b, 0xa0, 0x3d, 0x8c, 0x45, 0x9c, 0x4b, 0x78,
+	0x03, 0x00, 0x5d, 0x20, 0x4a, 0x09, 0xe3, 0xb9, 0x3d, 0x00, 0x5e, 0xd7, 0xc8, 0xbe, 0x06, 0xe0,
+	0x2b, 0x0f, 0xf8, 0x19, 0x61, 0x44, 0x52, 0x19, 0xfd, 0x63, 0xf1, 0x6d, 0xa7, 0xbf, 0x5f, 0xba,
+	0xd3, 0x81, 0x15, 0xbb, 0x88, 0x17, 0xe1, 0xeb, 0x2e, 0xf4, 0xe5, 0xac, 0x0f, 0xc0, 0xa7, 0x13,
+	0xf7, 0xa1, 0xa9, 0xde, 0x97, 0x43, 0x4a, 0x84, 0x5b, 0x8b, 0x4f, 0x9b, 0x7e, 0x52, 0x65, 0x4c,
+	0xfc, 0xe4, 0x60, 0x8a, 0xc0, 0x11, 0xd8, 0x15, 0x24, 0x2d, 0x13, 0xb3, 0x0a, 0x05, 0x11, 0x94,
+	0xa7, 0x11, 0x65, 0x56, 0x88, 0x34, 0xa3, 0xbe, 0x32, 0xf8, 0xfc, 0x72, 0x1c, 0xdc, 0xb4, 0x8c,
+	0x8b, 0x73, 0x11, 0xee, 0x4c, 0x83, 0x43, 0x13, 0x3b, 0x60, 0x46, 0xb4, 0xd4, 0x4e, 0x59, 0x7d,
+	0x77, 0x18, 0x27, 0x8a, 0x0b, 0xff, 0xc3, 0xf7, 0x73, 0xca, 0x26, 0x1f, 0xc2, 0x5b, 0x53, 0xe8,
+	0xa9, 0x41, 0x60, 0x0e, 0xfc, 0xb4, 0x36, 0xac, 0x51, 0x51, 0x39, 0xa5, 0xdf, 0xde, 0xf3, 0x7a,
+	0x57, 0x1f, 0xdc, 0x9d, 0x3b, 0x57, 0x0b, 0xdc, 0x75, 0xb0, 0xaa, 0xb5, 0xe2, 0x4e, 0xba, 0xc0,
+	0x7c, 0x5f, 0x79, 0xa0, 0xa7, 0x79, 0x28, 0xcb, 0x26, 0x96, 0x12, 0xcd, 0xd4, 0x97, 0x2a, 0x16,
+	0xca, 0x36, 0xcb, 0x5f, 0x33, 0x7d, 0x7d, 0x78, 0x39, 0x0e, 0x42, 0xb7, 0x1d, 0xff, 0xf3, 0x4b,
+	0x84, 0x6f, 0xb9, 0x54, 0xe7, 0x4f, 0x75, 0xb5, 0xcf, 0x74, 0x9e, 0xe9, 0x39, 0x7c, 0xed, 0x81,
+	0x9d, 0x86, 0xc1, 0x46, 0x62, 0xb2, 0xae, 0xfe, 0x15, 0x73, 0xfc, 0x3b, 0xff, 0x79, 0xfc, 0xe9,
+	0x82, 0x0f, 0x7a, 0x6e, 0xb9, 0xf6, 0xe6, 0x7a, 0x77, 0x45, 0x8d, 0x70, 0x67, 0xd6, 0xc5, 0x2b,
+	0x8f, 0xf8, 0xcd, 0x03, 0xc1, 0x5c, 0xe7, 0xad, 0x09, 0x5b, 0x5f, 0x5a, 0x58, 0xdf, 0x09, 0xbb,
+	0xfd, 0x2f, 0xd6, 0x5e, 0x97, 0x77, 0x63, 0x9e, 0xc9, 0x4f, 0xe9, 0x1e, 0xad, 0xbe, 0xf9, 0x35,
+	0x68, 0x0d, 0xf6, 0xdf, 0x9e, 0x77, 0xbd, 0x77, 0xe7, 0x5d, 0xef, 0xaf, 0xf3, 0xae, 0xf7, 0xfa,
+	0xa2, 0xdb, 0x7a, 0x77, 0xd1, 0x6d, 0xfd, 0x71, 0xd1, 0x6d, 0xbd, 0xb8, 0x53, 0x1b, 0xd4, 0x67,
+	0x46, 0xe4, 0xbd, 0x6f, 0xe2, 0x91, 0x0c, 0xad, 0xe0, 0xf0, 0x47, 0xf3, 0x8f, 0xc5, 0x0e, 0xec,
+	0xa8, 0x6d, 0xfe, 0x31, 0x3c, 0xfc, 0x7b, 0x00, 0xbb, 0x58, 0x59, 0x89, 0xd3, 0x08, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -349,6 +487,90 @@ func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WeightedAddresses) > 0 {
+		for iNdEx := len(m.WeightedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -369,6 +591,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ParticipationRewardsRecipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.PoolIncentivesRecipient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartEpoch))
 		i--
@@ -465,6 +707,40 @@ func (m *DistributionProportions) Size() (n int) {
 	return n
 }
 
+func (m *WeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *DistributionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.WeightedAddresses) > 0 {
+		for _, e := range m.WeightedAddresses {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -491,6 +767,10 @@ func (m *Params) Size() (n int) {
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartEpoch))
 	}
+	l = m.PoolIncentivesRecipient.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.ParticipationRewardsRecipient.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -770,6 +1050,238 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedAddresses = append(m.WeightedAddresses, WeightedAddress{})
+			if err := m.WeightedAddresses[len(m.WeightedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1002,6 +1514,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIncentivesRecipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolIncentivesRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRewardsRecipient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipationRewardsRecipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -23,6 +23,8 @@ var (
 	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
 	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
 	KeyMintingRewardsDistributionStartEpoch = []byte("MintingRewardsDistributionStartEpoch")
+	KeyPoolIncentivesRecipient              = []byte("PoolIncentivesRecipient")
+	KeyParticipationRewardsRecipient        = []byte("ParticipationRewardsRecipient")
 )
 
 // ParamTable for minting module.
@@ -33,7 +35,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	mintDenom string, genesisEpochProvisions sdk.Dec, epochIdentifier string,
 	ReductionFactor sdk.Dec, reductionPeriodInEpochs int64, distrProportions DistributionProportions,
-	mintingRewardsDistributionStartEpoch int64,
+	mintingRewardsDistributionStartEpoch int64, poolIncentivesRecipient, participationRewardsRecipient DistributionRecipient,
 ) Params {
 	return Params{
 		MintDenom:                            mintDenom,
@@ -43,6 +45,8 @@ func NewParams(
 		ReductionFactor:                      ReductionFactor,
 		DistributionProportions:              distrProportions,
 		MintingRewardsDistributionStartEpoch: mintingRewardsDistributionStartEpoch,
+		PoolIncentivesRecipient:              poolIncentivesRecipient,
+		ParticipationRewardsRecipient:        participationRewardsRecipient,
 	}
 }
 
@@ -61,6 +65,8 @@ func DefaultParams() Params {
 			CommunityPool:        sdk.MustNewDecFromStr("0.1"), // 0
 		},
 		MintingRewardsDistributionStartEpoch: 0,
+		PoolIncentivesRecipient:              DistributionRecipient{ModuleAccount: PoolIncentivesModuleAcctName},
+		ParticipationRewardsRecipient:        DistributionRecipient{ModuleAccount: ParticipationRewardsModuleAcctName},
 	}
 }
 
@@ -87,6 +93,12 @@ func (p Params) Validate() error {
 	if err := validateMintingRewardsDistributionStartEpoch(p.MintingRewardsDistributionStartEpoch); err != nil {
 		return err
 	}
+	if err := validateDistributionRecipient(p.PoolIncentivesRecipient); err != nil {
+		return err
+	}
+	if err := validateDistributionRecipient(p.ParticipationRewardsRecipient); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -107,6 +119,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyReductionFactor, &p.ReductionFactor, validateReductionFactor),
 		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartEpoch, &p.MintingRewardsDistributionStartEpoch, validateMintingRewardsDistributionStartEpoch),
+		paramtypes.NewParamSetPair(KeyPoolIncentivesRecipient, &p.PoolIncentivesRecipient, validateDistributionRecipient),
+		paramtypes.NewParamSetPair(KeyParticipationRewardsRecipient, &p.ParticipationRewardsRecipient, validateDistributionRecipient),
 	}
 }
 
@@ -183,6 +197,10 @@ func validateDistributionProportions(i interface{}) error {
 		return errors.New("pool incentives distribution ratio should not be negative")
 	}
 
+	if v.ParticipationRewards.IsNegative() {
+		return errors.New("participation rewards distribution ratio should not be negative")
+	}
+
 	// TODO: Maybe we should allow this :joy:, lets you burn osmo from community pool
 	// for new chains
 	if v.CommunityPool.IsNegative() {
@@ -210,3 +228,53 @@ func validateMintingRewardsDistributionStartEpoch(i interface{}) error {
 
 	return nil
 }
+
+func validateDistributionRecipient(i interface{}) error {
+	v, ok := i.(DistributionRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+// Validate checks that a distribution recipient is either one of the allowed module accounts, or a list of weighted
+// addresses whose weights are positive and add up to 1. Whether the addresses can receive coins depends on the bank
+// keeper, and is checked by the mint keeper.
+func (r DistributionRecipient) Validate() error {
+	if r.ModuleAccount != "" {
+		if len(r.WeightedAddresses) > 0 {
+			return errors.New("distribution recipient cannot have both a module account and weighted addresses")
+		}
+		for _, allowed := range AllowedRecipientModuleAccounts {
+			if r.ModuleAccount == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("module account %q is not an allowed distribution recipient, expected one of %v", r.ModuleAccount, AllowedRecipientModuleAccounts)
+	}
+
+	if len(r.WeightedAddresses) == 0 {
+		return errors.New("distribution recipient must have a module account or weighted addresses")
+	}
+	addresses := map[string]bool{}
+	totalWeight := sdk.ZeroDec()
+	for _, w := range r.WeightedAddresses {
+		if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+			return fmt.Errorf("invalid weighted address %s: %w", w.Address, err)
+		}
+		if addresses[w.Address] {
+			return fmt.Errorf("duplicated weighted address %s", w.Address)
+		}
+		addresses[w.Address] = true
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
+			return fmt.Errorf("weight of %s should be positive", w.Address)
+		}
+		totalWeight = totalWeight.Add(w.Weight)
+	}
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("total weight of weighted addresses should be 1, got %s", totalWeight)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistributionRecipientValidate(t *testing.T) {
+	address1 := sdk.AccAddress([]byte("address1____________")).String()
+	address2 := sdk.AccAddress([]byte("address2____________")).String()
+	half := sdk.MustNewDecFromStr("0.5")
+
+	testCases := []struct {
+		name      string
+		recipient DistributionRecipient
+		err       string
+	}{
+		{"module account", DistributionRecipient{ModuleAccount: PoolIncentivesModuleAcctName}, ""},
+		{"weighted addresses", DistributionRecipient{WeightedAddresses: []WeightedAddress{
+			{Address: address1, Weight: half}, {Address: address2, Weight: half},
+		}}, ""},
+		{"empty", DistributionRecipient{}, "must have a module account or weighted addresses"},
+		{"both", DistributionRecipient{ModuleAccount: PoolIncentivesModuleAcctName, WeightedAddresses: []WeightedAddress{
+			{Address: address1, Weight: sdk.OneDec()},
+		}}, "cannot have both"},
+		{"mint module account", DistributionRecipient{ModuleAccount: ModuleName}, "not an allowed distribution recipient"},
+		{"other module account", DistributionRecipient{ModuleAccount: "distribution"}, "not an allowed distribution recipient"},
+		{"invalid address", DistributionRecipient{WeightedAddresses: []WeightedAddress{
+			{Address: "invalid", Weight: sdk.OneDec()},
+		}}, "invalid weighted address"},
+		{"duplicated address", DistributionRecipient{WeightedAddresses: []WeightedAddress{
+			{Address: address1, Weight: half}, {Address: address1, Weight: half},
+		}}, "duplicated weighted address"},
+		{"zero weight", DistributionRecipient{WeightedAddresses: []WeightedAddress{
+			{Address: address1, Weight: sdk.OneDec()}, {Address: address2, Weight: sdk.ZeroDec()},
+		}}, "should be positive"},
+		{"weights don't add up to 1", DistributionRecipient{WeightedAddresses: []WeightedAddress{
+			{Address: address1, Weight: half},
+		}}, "total weight of weighted addresses should be 1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recipient.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
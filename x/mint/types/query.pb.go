@@ -191,42 +191,145 @@ func (m *QueryEpochProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEpochProvisionsResponse proto.InternalMessageInfo
 
+// QueryDistributionRecipientsRequest is the request type for the
+// Query/DistributionRecipients RPC method.
+type QueryDistributionRecipientsRequest struct {
+}
+
+func (m *QueryDistributionRecipientsRequest) Reset()         { *m = QueryDistributionRecipientsRequest{} }
+func (m *QueryDistributionRecipientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionRecipientsRequest) ProtoMessage()    {}
+func (*QueryDistributionRecipientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{4}
+}
+func (m *QueryDistributionRecipientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionRecipientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionRecipientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionRecipientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionRecipientsRequest.Merge(m, src)
+}
+func (m *QueryDistributionRecipientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionRecipientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionRecipientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionRecipientsRequest proto.InternalMessageInfo
+
+// QueryDistributionRecipientsResponse is the response type for the
+// Query/DistributionRecipients RPC method. A module account recipient is
+// returned as its address, with a weight of 1.
+type QueryDistributionRecipientsResponse struct {
+	PoolIncentives       []WeightedAddress `protobuf:"bytes,1,rep,name=pool_incentives,json=poolIncentives,proto3" json:"pool_incentives"`
+	ParticipationRewards []WeightedAddress `protobuf:"bytes,2,rep,name=participation_rewards,json=participationRewards,proto3" json:"participation_rewards"`
+}
+
+func (m *QueryDistributionRecipientsResponse) Reset()         { *m = QueryDistributionRecipientsResponse{} }
+func (m *QueryDistributionRecipientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionRecipientsResponse) ProtoMessage()    {}
+func (*QueryDistributionRecipientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0718dda172d2cb4, []int{5}
+}
+func (m *QueryDistributionRecipientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionRecipientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionRecipientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributionRecipientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionRecipientsResponse.Merge(m, src)
+}
+func (m *QueryDistributionRecipientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionRecipientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionRecipientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributionRecipientsResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionRecipientsResponse) GetPoolIncentives() []WeightedAddress {
+	if m != nil {
+		return m.PoolIncentives
+	}
+	return nil
+}
+
+func (m *QueryDistributionRecipientsResponse) GetParticipationRewards() []WeightedAddress {
+	if m != nil {
+		return m.ParticipationRewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "stride.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "stride.mint.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryEpochProvisionsRequest)(nil), "stride.mint.v1beta1.QueryEpochProvisionsRequest")
 	proto.RegisterType((*QueryEpochProvisionsResponse)(nil), "stride.mint.v1beta1.QueryEpochProvisionsResponse")
+	proto.RegisterType((*QueryDistributionRecipientsRequest)(nil), "stride.mint.v1beta1.QueryDistributionRecipientsRequest")
+	proto.RegisterType((*QueryDistributionRecipientsResponse)(nil), "stride.mint.v1beta1.QueryDistributionRecipientsResponse")
 }
 
 func init() { proto.RegisterFile("mint/v1beta1/query.proto", fileDescriptor_b0718dda172d2cb4) }
 
 var fileDescriptor_b0718dda172d2cb4 = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x4f, 0xfa, 0x40,
-	0x18, 0xc6, 0x5b, 0xf2, 0xff, 0x33, 0x9c, 0x26, 0x98, 0x83, 0x28, 0x29, 0x58, 0x48, 0x07, 0x24,
-	0x26, 0xdc, 0x09, 0x4e, 0xae, 0x04, 0x37, 0x07, 0xc4, 0x49, 0x17, 0xd3, 0x96, 0x4b, 0x69, 0xb4,
-	0xbd, 0xd2, 0x3b, 0x88, 0x5d, 0xfd, 0x04, 0x26, 0xee, 0x2e, 0x7e, 0x19, 0x46, 0x12, 0x17, 0xe3,
-	0x40, 0x0c, 0xf8, 0x41, 0x4c, 0xef, 0xaa, 0xb1, 0x58, 0x63, 0x9c, 0xda, 0xdc, 0xf3, 0xbe, 0xef,
-	0xf3, 0x7b, 0x9f, 0x3b, 0x50, 0xf6, 0x5c, 0x9f, 0xe3, 0x69, 0xdb, 0x22, 0xdc, 0x6c, 0xe3, 0xf1,
-	0x84, 0x84, 0x11, 0x0a, 0x42, 0xca, 0x29, 0x2c, 0x32, 0x1e, 0xba, 0x43, 0x82, 0xe2, 0x02, 0x94,
-	0x14, 0x68, 0x25, 0x87, 0x3a, 0x54, 0xe8, 0x38, 0xfe, 0x93, 0xa5, 0x5a, 0xd5, 0xa1, 0xd4, 0xb9,
-	0x26, 0xd8, 0x0c, 0x5c, 0x6c, 0xfa, 0x3e, 0xe5, 0x26, 0x77, 0xa9, 0xcf, 0x12, 0x75, 0x27, 0x65,
-	0x21, 0xc6, 0x09, 0xc1, 0x28, 0x01, 0x78, 0x1a, 0x1b, 0xf6, 0xcd, 0xd0, 0xf4, 0xd8, 0x80, 0x8c,
-	0x27, 0x84, 0x71, 0xa3, 0x0f, 0x8a, 0xa9, 0x53, 0x16, 0x50, 0x9f, 0x11, 0x78, 0x04, 0xf2, 0x81,
-	0x38, 0x29, 0xab, 0x75, 0xb5, 0xb9, 0xd1, 0xa9, 0xa0, 0x0c, 0x3e, 0x24, 0x9b, 0xba, 0xff, 0x66,
-	0x8b, 0x9a, 0x32, 0x48, 0x1a, 0x8c, 0x5d, 0x50, 0x11, 0x13, 0x8f, 0x03, 0x6a, 0x8f, 0xfa, 0x21,
-	0x9d, 0xba, 0x2c, 0xc6, 0xfb, 0x30, 0x8c, 0x40, 0x35, 0x5b, 0x4e, 0x9c, 0xcf, 0xc1, 0x16, 0x89,
-	0xa5, 0xcb, 0xe0, 0x53, 0x13, 0x0c, 0x9b, 0x5d, 0x14, 0xdb, 0xbc, 0x2c, 0x6a, 0x0d, 0xc7, 0xe5,
-	0xa3, 0x89, 0x85, 0x6c, 0xea, 0x61, 0x9b, 0x32, 0x8f, 0xb2, 0xe4, 0xd3, 0x62, 0xc3, 0x2b, 0xcc,
-	0xa3, 0x80, 0x30, 0xd4, 0x23, 0xf6, 0xa0, 0x40, 0xd2, 0x16, 0x9d, 0xc7, 0x1c, 0xf8, 0x2f, 0xbc,
-	0x61, 0x04, 0xf2, 0x92, 0x1d, 0xee, 0x65, 0x2e, 0xf6, 0x3d, 0x28, 0xad, 0xf9, 0x7b, 0xa1, 0xdc,
-	0xc0, 0xa8, 0xde, 0x3e, 0xbd, 0xdd, 0xe7, 0xb6, 0x61, 0x09, 0xa7, 0xae, 0x42, 0xc6, 0x03, 0x1f,
-	0x54, 0x50, 0x58, 0xdb, 0x1d, 0x1e, 0xfc, 0x3c, 0x3b, 0x3b, 0x45, 0xad, 0xfd, 0x87, 0x8e, 0x04,
-	0xab, 0x21, 0xb0, 0xea, 0x50, 0x4f, 0x63, 0xad, 0x87, 0xdd, 0xed, 0xcd, 0x96, 0xba, 0x3a, 0x5f,
-	0xea, 0xea, 0xeb, 0x52, 0x57, 0xef, 0x56, 0xba, 0x32, 0x5f, 0xe9, 0xca, 0xf3, 0x4a, 0x57, 0x2e,
-	0xf6, 0xbf, 0x04, 0x7f, 0x26, 0xec, 0x5b, 0x27, 0xa6, 0xc5, 0xb0, 0x44, 0xc1, 0x37, 0x72, 0xb0,
-	0xb8, 0x00, 0x2b, 0x2f, 0x1e, 0xdd, 0xe1, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x92, 0x52,
-	0x58, 0xf2, 0x02, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x16, 0x7a, 0xd8, 0x22, 0x82, 0xb6, 0xa1, 0x44, 0x69, 0x70, 0x2b, 0x53, 0xda,
+	0x08, 0x29, 0x5e, 0x12, 0x0e, 0xc0, 0x91, 0x28, 0x1c, 0x90, 0x38, 0x04, 0xf7, 0x80, 0xe0, 0x62,
+	0xf9, 0xcf, 0xc8, 0x59, 0xd1, 0x78, 0xb7, 0xbb, 0x9b, 0x40, 0xae, 0x3c, 0x01, 0x12, 0x77, 0x1e,
+	0x84, 0x27, 0xe8, 0xb1, 0x12, 0x17, 0x04, 0x52, 0x55, 0x25, 0x3c, 0x08, 0xda, 0xb5, 0x1b, 0xd5,
+	0xc5, 0x2d, 0xca, 0x29, 0xd1, 0xce, 0xcc, 0xf7, 0xfd, 0x76, 0x66, 0xd6, 0xa8, 0x3e, 0xa2, 0xa9,
+	0x22, 0x93, 0x4e, 0x08, 0x2a, 0xe8, 0x90, 0xa3, 0x31, 0x88, 0xa9, 0xcb, 0x05, 0x53, 0x0c, 0x6f,
+	0x48, 0x25, 0x68, 0x0c, 0xae, 0x4e, 0x70, 0xf3, 0x84, 0x46, 0x2d, 0x61, 0x09, 0x33, 0x71, 0xa2,
+	0xff, 0x65, 0xa9, 0x8d, 0x66, 0xc2, 0x58, 0x72, 0x08, 0x24, 0xe0, 0x94, 0x04, 0x69, 0xca, 0x54,
+	0xa0, 0x28, 0x4b, 0x65, 0x1e, 0xbd, 0x57, 0xb0, 0x30, 0x72, 0x26, 0xe0, 0xd4, 0x10, 0x7e, 0xa3,
+	0x0d, 0x07, 0x81, 0x08, 0x46, 0xd2, 0x83, 0xa3, 0x31, 0x48, 0xe5, 0x0c, 0xd0, 0x46, 0xe1, 0x54,
+	0x72, 0x96, 0x4a, 0xc0, 0xcf, 0xd1, 0x1a, 0x37, 0x27, 0x75, 0x6b, 0xc7, 0x6a, 0xad, 0x77, 0xb7,
+	0xdc, 0x12, 0x3e, 0x37, 0x2b, 0xea, 0xdd, 0x38, 0x3e, 0xdd, 0xae, 0x78, 0x79, 0x81, 0x73, 0x1f,
+	0x6d, 0x19, 0xc5, 0x97, 0x9c, 0x45, 0xc3, 0x81, 0x60, 0x13, 0x2a, 0x35, 0xde, 0xb9, 0xe1, 0x14,
+	0x35, 0xcb, 0xc3, 0xb9, 0xf3, 0x3b, 0x74, 0x07, 0x74, 0xc8, 0xe7, 0x8b, 0x98, 0x61, 0xb8, 0xd5,
+	0x73, 0xb5, 0xcd, 0xaf, 0xd3, 0xed, 0xbd, 0x84, 0xaa, 0xe1, 0x38, 0x74, 0x23, 0x36, 0x22, 0x11,
+	0x93, 0x23, 0x26, 0xf3, 0x9f, 0xb6, 0x8c, 0x3f, 0x10, 0x35, 0xe5, 0x20, 0xdd, 0x3e, 0x44, 0x5e,
+	0x15, 0x8a, 0x16, 0xce, 0x2e, 0x72, 0x8c, 0x75, 0x9f, 0xea, 0xcb, 0x84, 0x63, 0xdd, 0x36, 0x0f,
+	0x22, 0xca, 0x29, 0xa4, 0x6a, 0x01, 0xf8, 0xdb, 0x42, 0x0f, 0xae, 0x4d, 0xcb, 0x41, 0x0f, 0x50,
+	0x95, 0x33, 0x76, 0xe8, 0xd3, 0x34, 0x82, 0x54, 0xd1, 0x09, 0x68, 0xce, 0xd5, 0xd6, 0x7a, 0x77,
+	0xb7, 0xb4, 0x57, 0x6f, 0x81, 0x26, 0x43, 0x05, 0xf1, 0x8b, 0x38, 0x16, 0x20, 0xcf, 0x9b, 0x76,
+	0x5b, 0x4b, 0xbc, 0x5a, 0x28, 0x60, 0x1f, 0xdd, 0xe5, 0x81, 0x50, 0x34, 0xa2, 0xdc, 0x4c, 0xd5,
+	0x17, 0xf0, 0x31, 0x10, 0xb1, 0xac, 0xaf, 0x2c, 0x2d, 0x5d, 0x2b, 0x08, 0x79, 0x99, 0x4e, 0xf7,
+	0x6c, 0x15, 0xdd, 0x34, 0xb7, 0xc3, 0x53, 0xb4, 0x96, 0xcd, 0x0f, 0xef, 0x97, 0xaa, 0xfe, 0xbb,
+	0x2c, 0x8d, 0xd6, 0xff, 0x13, 0xb3, 0xe6, 0x38, 0xcd, 0xcf, 0x3f, 0xfe, 0x7c, 0x5d, 0xd9, 0xc4,
+	0x35, 0x52, 0x58, 0xc7, 0x6c, 0x45, 0xf0, 0x37, 0x0b, 0x55, 0x2f, 0xcd, 0x1f, 0x3f, 0xbe, 0x5a,
+	0xbb, 0x7c, 0x93, 0x1a, 0x9d, 0x25, 0x2a, 0x72, 0xac, 0x3d, 0x83, 0xb5, 0x83, 0xed, 0x22, 0xd6,
+	0xe5, 0x85, 0xc3, 0xdf, 0x2d, 0xb4, 0x59, 0x3e, 0x7e, 0xfc, 0xf4, 0x6a, 0xd7, 0x6b, 0xf7, 0xaa,
+	0xf1, 0x6c, 0xf9, 0xc2, 0x9c, 0xba, 0x6d, 0xa8, 0xf7, 0xf1, 0xc3, 0x22, 0x75, 0x7c, 0xa1, 0xca,
+	0x17, 0x8b, 0xb2, 0x5e, 0xff, 0x78, 0x66, 0x5b, 0x27, 0x33, 0xdb, 0x3a, 0x9b, 0xd9, 0xd6, 0x97,
+	0xb9, 0x5d, 0x39, 0x99, 0xdb, 0x95, 0x9f, 0x73, 0xbb, 0xf2, 0xfe, 0xd1, 0x85, 0x97, 0x73, 0x60,
+	0x60, 0xda, 0xaf, 0x83, 0x50, 0x92, 0x0c, 0x8c, 0x7c, 0xca, 0xf4, 0xcd, 0x0b, 0x0a, 0xd7, 0xcc,
+	0x57, 0xe3, 0xc9, 0xdf, 0x01, 0x00, 0x8a, 0x15, 0x9e, 0x0b, 0xb3, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -245,6 +348,9 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EpochProvisions current minting epoch provisions value.
 	EpochProvisions(ctx context.Context, in *QueryEpochProvisionsRequest, opts ...grpc.CallOption) (*QueryEpochProvisionsResponse, error)
+	// DistributionRecipients returns the addresses that receive the pool
+	// incentives and participation rewards allocations, with their weights.
+	DistributionRecipients(ctx context.Context, in *QueryDistributionRecipientsRequest, opts ...grpc.CallOption) (*QueryDistributionRecipientsResponse, error)
 }
 
 type queryClient struct {
@@ -273,12 +379,24 @@ func (c *queryClient) EpochProvisions(ctx context.Context, in *QueryEpochProvisi
 	return out, nil
 }
 
+func (c *queryClient) DistributionRecipients(ctx context.Context, in *QueryDistributionRecipientsRequest, opts ...grpc.CallOption) (*QueryDistributionRecipientsResponse, error) {
+	out := new(QueryDistributionRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/stride.mint.v1beta1.Query/DistributionRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EpochProvisions current minting epoch provisions value.
 	EpochProvisions(context.Context, *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error)
+	// DistributionRecipients returns the addresses that receive the pool
+	// incentives and participation rewards allocations, with their weights.
+	DistributionRecipients(context.Context, *QueryDistributionRecipientsRequest) (*QueryDistributionRecipientsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -291,6 +409,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) EpochProvisions(ctx context.Context, req *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochProvisions not implemented")
 }
+func (*UnimplementedQueryServer) DistributionRecipients(ctx context.Context, req *QueryDistributionRecipientsRequest) (*QueryDistributionRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionRecipients not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -332,6 +453,24 @@ func _Query_EpochProvisions_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DistributionRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.mint.v1beta1.Query/DistributionRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionRecipients(ctx, req.(*QueryDistributionRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "stride.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -344,6 +483,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochProvisions",
 			Handler:    _Query_EpochProvisions_Handler,
 		},
+		{
+			MethodName: "DistributionRecipients",
+			Handler:    _Query_DistributionRecipients_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mint/v1beta1/query.proto",
@@ -461,6 +604,80 @@ func (m *QueryEpochProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDistributionRecipientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionRecipientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionRecipientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParticipationRewards) > 0 {
+		for iNdEx := len(m.ParticipationRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipationRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PoolIncentives) > 0 {
+		for iNdEx := len(m.PoolIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -512,6 +729,36 @@ func (m *QueryEpochProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDistributionRecipientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDistributionRecipientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIncentives) > 0 {
+		for _, e := range m.PoolIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ParticipationRewards) > 0 {
+		for _, e := range m.ParticipationRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -784,6 +1031,174 @@ func (m *QueryEpochProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDistributionRecipientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionRecipientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionRecipientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionRecipientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionRecipientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolIncentives = append(m.PoolIncentives, WeightedAddress{})
+			if err := m.PoolIncentives[len(m.PoolIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipationRewards = append(m.ParticipationRewards, WeightedAddress{})
+			if err := m.ParticipationRewards[len(m.ParticipationRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (k Keeper) MarshalTransferCallbackArgs(ctx sdk.Context, transferCallback types.TransferCallback) ([]byte, error) {
+	out, err := proto.Marshal(&transferCallback)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("MarshalTransferCallbackArgs %v", err.Error()))
+		return nil, err
+	}
+	return out, nil
+}
+
+func (k Keeper) UnmarshalTransferCallbackArgs(ctx sdk.Context, transferCallback []byte) (types.TransferCallback, error) {
+	unmarshalledTransferCallback := types.TransferCallback{}
+	if err := proto.Unmarshal(transferCallback, &unmarshalledTransferCallback); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("UnmarshalTransferCallbackArgs %v", err.Error()))
+		return unmarshalledTransferCallback, err
+	}
+	return unmarshalledTransferCallback, nil
+}
+
+// TransferCallback moves a deposit record to STAKE once its tokens have arrived on the host zone. If the transfer
+// failed, the tokens are refunded to the stakeibc module account and the record stays in TRANSFER, so that it is
+// sent again in the next epoch
+func TransferCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult, args []byte) error {
+	k.Logger(ctx).Info("TransferCallback executing", "packet", packet.Sequence, "status", ackResult.Status.String())
+
+	if ackResult.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
+		k.Logger(ctx).Error(fmt.Sprintf("[IBC-TRANSFER] timeout, packet %v", packet))
+		return nil
+	} else if ackResult.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		k.Logger(ctx).Error(fmt.Sprintf("[IBC-TRANSFER] Acknowledgement_Error {%s}", ackResult.Error))
+		return nil
+	}
+
+	transferCallback, err := k.UnmarshalTransferCallbackArgs(ctx, args)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrUnmarshalFailure, "unable to unmarshal transfer callback args | %s", err.Error())
+	}
+	depositRecord, found := k.GetDepositRecord(ctx, transferCallback.DepositRecordId)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("[IBC-TRANSFER] deposit record not found %d", transferCallback.DepositRecordId))
+		return sdkerrors.Wrapf(types.ErrDepositRecordNotFound, "deposit record not found %d", transferCallback.DepositRecordId)
+	}
+
+	oldStatus := depositRecord.Status
+	depositRecord.Status = types.DepositRecord_STAKE
+	k.SetDepositRecord(ctx, depositRecord)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDepositRecordStatusChange{
+		DepositRecordId: depositRecord.Id,
+		HostZone:        depositRecord.HostZoneId,
+		Amount:          depositRecord.Amount,
+		OldStatus:       oldStatus,
+		NewStatus:       depositRecord.Status,
+	})
+	if err != nil {
+		return err
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("[IBC-TRANSFER] Deposit record updated to STAKE: {%v}", depositRecord))
+	return nil
+}
+
+// LegacyTransferCallback handles the ack of a transfer sent before callbacks were registered for deposit record
+// transfers, so that transfers in flight at the upgrade still move their record to STAKE. As before the upgrade, the
+// deposit record is matched by the amount transferred
+// TODO: remove once the transfers in flight at the upgrade have been acknowledged
+func LegacyTransferCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult) error {
+	if ackResult.Status != icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Error(fmt.Sprintf("[IBC-TRANSFER] legacy transfer failed (%s), packet %v", ackResult.Error, packet))
+		return nil
+	}
+
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(types.ErrUnmarshalFailure, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+	// only deposit records are transferred from the stakeibc module account
+	if data.Sender != k.AccountKeeper.GetModuleAddress(stakeibctypes.ModuleName).String() {
+		return nil
+	}
+	amount, err := strconv.ParseInt(data.Amount, 10, 64)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Error parsing int %s", data.Amount)
+	}
+	depositRecord, found := k.GetTransferDepositRecordByAmount(ctx, amount)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("[IBC-TRANSFER] No deposit record found for legacy transfer of amount %d", amount))
+		return nil
+	}
+
+	oldStatus := depositRecord.Status
+	depositRecord.Status = types.DepositRecord_STAKE
+	k.SetDepositRecord(ctx, *depositRecord)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDepositRecordStatusChange{
+		DepositRecordId: depositRecord.Id,
+		HostZone:        depositRecord.HostZoneId,
+		Amount:          depositRecord.Amount,
+		OldStatus:       oldStatus,
+		NewStatus:       depositRecord.Status,
+	})
+	if err != nil {
+		return err
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("[IBC-TRANSFER] Deposit record of legacy transfer updated to STAKE: {%v}", depositRecord))
+	return nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+const TRANSFER = "transfer"
+
+// ICACallbacks wrapper struct for records keeper
+type ICACallback func(Keeper, sdk.Context, channeltypes.Packet, *icacallbackstypes.AcknowledgementResult, []byte) error
+
+type ICACallbacks struct {
+	k            Keeper
+	icacallbacks map[string]ICACallback
+}
+
+var _ icacallbackstypes.ICACallbackHandler = ICACallbacks{}
+
+func (k Keeper) ICACallbackHandler() ICACallbacks {
+	return ICACallbacks{k, make(map[string]ICACallback)}
+}
+
+func (c ICACallbacks) CallICACallback(ctx sdk.Context, id string, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult, args []byte) error {
+	return c.icacallbacks[id](c.k, ctx, packet, ackResult, args)
+}
+
+func (c ICACallbacks) HasICACallback(id string) bool {
+	_, found := c.icacallbacks[id]
+	return found
+}
+
+func (c ICACallbacks) AddICACallback(id string, fn interface{}) icacallbackstypes.ICACallbackHandler {
+	c.icacallbacks[id] = fn.(ICACallback)
+	return c
+}
+
+func (c ICACallbacks) RegisterICACallbacks() icacallbackstypes.ICACallbackHandler {
+	a := c.
+		AddICACallback(TRANSFER, ICACallback(TransferCallback))
+	return a.(ICACallbacks)
+}
+
+// DecodeCallbackArgs unmarshals the args of a records callback into their proto type
+func (c ICACallbacks) DecodeCallbackArgs(id string, args []byte) (proto.Message, error) {
+	if id != TRANSFER {
+		return nil, sdkerrors.Wrapf(icacallbackstypes.ErrCallbackIdNotFound, "records has no callback %s", id)
+	}
+	var transferCallback types.TransferCallback
+	if err := proto.Unmarshal(args, &transferCallback); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnmarshalFailure, "unable to unmarshal %s callback args: %s", id, err.Error())
+	}
+	return &transferCallback, nil
+}
+
+// ResubmitICATx is not supported, records only sends ICS-20 transfers, which are never queued for retry
+func (c ICACallbacks) ResubmitICATx(ctx sdk.Context, portId string, msgs []sdk.Msg, id string, args []byte) (uint64, error) {
+	return 0, sdkerrors.Wrapf(types.ErrUnsupportedCallback, "records does not send ICA txs (callback %s)", id)
+}
+
+// ReleaseICATx is a no-op, records only sends ICS-20 transfers, which are never queued for retry
+func (c ICACallbacks) ReleaseICATx(ctx sdk.Context, id string, args []byte) error {
+	return nil
+}
@@ -111,6 +111,9 @@ func (k Keeper) GetDepositRecordByEpochAndChain(ctx sdk.Context, epochNumber uin
 	return nil, false
 }
 
+// GetTransferDepositRecordByAmount matches a deposit record in TRANSFER to a transfer by its amount. It's only used
+// for transfers sent before callbacks were registered for them
+// TODO: remove once the transfers in flight at the upgrade have been acknowledged
 func (k Keeper) GetTransferDepositRecordByAmount(ctx sdk.Context, amount int64) (val *types.DepositRecord, found bool) {
 	records := k.GetAllDepositRecord(ctx)
 	for _, depositRecord := range records {
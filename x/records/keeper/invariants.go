@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+// RegisterInvariants registers all records invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "unique-deposit-records", UniqueDepositRecordsInvariant(k))
+}
+
+// UniqueDepositRecordsInvariant checks that there is at most one deposit record for user deposits per host zone and epoch.
+// Reinvestment records (source WITHDRAWAL_ICA) are appended on top of it and are not checked
+func UniqueDepositRecordsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		seen := map[string]uint64{}
+		for _, depositRecord := range k.GetAllDepositRecord(ctx) {
+			if depositRecord.Source != types.DepositRecord_STRIDE {
+				continue
+			}
+			key := fmt.Sprintf("%s.%d", depositRecord.HostZoneId, depositRecord.DepositEpochNumber)
+			if firstId, found := seen[key]; found {
+				broken = true
+				msg += fmt.Sprintf("\tdeposit records %d and %d are both for host zone %s and epoch %d\n",
+					firstId, depositRecord.Id, depositRecord.HostZoneId, depositRecord.DepositEpochNumber)
+				continue
+			}
+			seen[key] = depositRecord.Id
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "unique-deposit-records",
+			fmt.Sprintf("found duplicate deposit records\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
+	"github.com/Stride-Labs/stride/x/records/keeper"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+func TestUniqueDepositRecordsInvariant(t *testing.T) {
+	k, ctx := keepertest.RecordsKeeper(t)
+	k.SetDepositRecord(ctx, types.DepositRecord{Id: 1, HostZoneId: "GAIA", DepositEpochNumber: 1})
+	k.SetDepositRecord(ctx, types.DepositRecord{Id: 2, HostZoneId: "GAIA", DepositEpochNumber: 2})
+	k.SetDepositRecord(ctx, types.DepositRecord{Id: 3, HostZoneId: "OSMO", DepositEpochNumber: 1})
+	// reinvestment records share the zone and epoch of the user deposit record
+	k.SetDepositRecord(ctx, types.DepositRecord{Id: 4, HostZoneId: "GAIA", DepositEpochNumber: 1, Source: types.DepositRecord_WITHDRAWAL_ICA})
+
+	msg, broken := keeper.UniqueDepositRecordsInvariant(*k)(ctx)
+	require.False(t, broken, msg)
+
+	k.SetDepositRecord(ctx, types.DepositRecord{Id: 5, HostZoneId: "GAIA", DepositEpochNumber: 2})
+	msg, broken = keeper.UniqueDepositRecordsInvariant(*k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "deposit records 2 and 5 are both for host zone GAIA and epoch 2")
+}
@@ -11,17 +11,24 @@ import (
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+
+	icacallbackskeeper "github.com/Stride-Labs/stride/x/icacallbacks/keeper"
 )
 
 type (
 	Keeper struct {
 		// *cosmosibckeeper.Keeper
-		Cdc           codec.BinaryCodec
-		storeKey      sdk.StoreKey
-		memKey        sdk.StoreKey
-		paramstore    paramtypes.Subspace
-		scopedKeeper  capabilitykeeper.ScopedKeeper
-		AccountKeeper types.AccountKeeper
+		Cdc                codec.BinaryCodec
+		storeKey           sdk.StoreKey
+		memKey             sdk.StoreKey
+		paramstore         paramtypes.Subspace
+		scopedKeeper       capabilitykeeper.ScopedKeeper
+		AccountKeeper      types.AccountKeeper
+		TransferKeeper     ibctransferkeeper.Keeper
+		IBCKeeper          ibckeeper.Keeper
+		ICACallbacksKeeper icacallbackskeeper.Keeper
 	}
 )
 
@@ -32,7 +39,9 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 	AccountKeeper types.AccountKeeper,
-
+	TransferKeeper ibctransferkeeper.Keeper,
+	ibcKeeper ibckeeper.Keeper,
+	ICACallbacksKeeper icacallbackskeeper.Keeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -40,12 +49,15 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		Cdc:           Cdc,
-		storeKey:      storeKey,
-		memKey:        memKey,
-		paramstore:    ps,
-		scopedKeeper:  scopedKeeper,
-		AccountKeeper: AccountKeeper,
+		Cdc:                Cdc,
+		storeKey:           storeKey,
+		memKey:             memKey,
+		paramstore:         ps,
+		scopedKeeper:       scopedKeeper,
+		AccountKeeper:      AccountKeeper,
+		TransferKeeper:     TransferKeeper,
+		IBCKeeper:          ibcKeeper,
+		ICACallbacksKeeper: ICACallbacksKeeper,
 	}
 }
 
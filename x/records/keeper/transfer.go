@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/records/types"
+)
+
+// Transfer sends a deposit record's tokens to the host zone over ICS-20, and registers a callback so that the
+// record is updated when the transfer is acknowledged
+func (k Keeper) Transfer(ctx sdk.Context, msg *ibctransfertypes.MsgTransfer, depositRecordId uint64) error {
+	// the transfer module doesn't return the sequence of the packet it sends, so it's read beforehand
+	sequence, found := k.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, msg.SourcePort, msg.SourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrSequenceSendNotFound, "source port: %s, source channel: %s", msg.SourcePort, msg.SourceChannel)
+	}
+	if _, err := k.TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Failed to transfer deposit record %d | %s", depositRecordId, err.Error()))
+		return sdkerrors.Wrapf(types.ErrTransferFailed, err.Error())
+	}
+
+	args, err := k.MarshalTransferCallbackArgs(ctx, types.TransferCallback{DepositRecordId: depositRecordId})
+	if err != nil {
+		return err
+	}
+	k.ICACallbacksKeeper.SetCallbackData(ctx, icacallbackstypes.CallbackData{
+		CallbackKey:  icacallbackstypes.PacketID(msg.SourcePort, msg.SourceChannel, sequence),
+		PortId:       msg.SourcePort,
+		ChannelId:    msg.SourceChannel,
+		Sequence:     sequence,
+		CallbackId:   TRANSFER,
+		CallbackArgs: args,
+		Module:       types.ModuleName,
+		// packets with only a timeout height expire relative to the current block time
+		ExpirationTimestamp: k.ICACallbacksKeeper.GetCallbackExpiration(ctx, msg.TimeoutTimestamp),
+	})
+	k.Logger(ctx).Info(fmt.Sprintf("Transferred deposit record %d, sequence %d", depositRecordId, sequence))
+	return nil
+}
+
+// GetTransferInProgressDepositRecordIds returns the ids of the deposit records whose transfer to the host zone hasn't
+// been acknowledged or timed out yet, i.e. those with a transfer callback still registered
+func (k Keeper) GetTransferInProgressDepositRecordIds(ctx sdk.Context) (map[uint64]bool, error) {
+	depositRecordIds := make(map[uint64]bool)
+	for _, callbackData := range k.ICACallbacksKeeper.GetAllCallbackData(ctx) {
+		if callbackData.Module != types.ModuleName || callbackData.CallbackId != TRANSFER {
+			continue
+		}
+		transferCallback, err := k.UnmarshalTransferCallbackArgs(ctx, callbackData.CallbackArgs)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrUnmarshalFailure, "unable to unmarshal transfer callback args | %s", err.Error())
+		}
+		depositRecordIds[transferCallback.DepositRecordId] = true
+	}
+	return depositRecordIds, nil
+}
@@ -0,0 +1,62 @@
+package records
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/records/keeper"
+)
+
+// LegacyTransferMiddleware wraps the transfer stack for one release. Deposit record transfers sent before the upgrade
+// have no callback registered, so their acks are matched to a deposit record by amount, as they were before
+// TODO: remove once the transfers in flight at the upgrade have been acknowledged
+type LegacyTransferMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+var _ porttypes.IBCModule = LegacyTransferMiddleware{}
+
+// NewLegacyTransferMiddleware creates a new LegacyTransferMiddleware given the keeper and the transfer stack
+func NewLegacyTransferMiddleware(k keeper.Keeper, app porttypes.IBCModule) LegacyTransferMiddleware {
+	return LegacyTransferMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im LegacyTransferMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	// the callback data is removed once the wrapped stack has called the callback
+	callbackKey := icacallbackstypes.PacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	_, hasCallback := im.keeper.ICACallbacksKeeper.GetCallbackData(ctx, callbackKey)
+
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	if hasCallback {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("Error unmarshalling transfer ack %v", err.Error()))
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+	ackResult, err := icacallbackstypes.UnpackTransferAcknowledgementResult(ack)
+	if err != nil {
+		return err
+	}
+	return keeper.LegacyTransferCallback(im.keeper, ctx, packet, ackResult)
+}
@@ -11,4 +11,8 @@ var (
 	ErrInvalidVersion               = sdkerrors.Register(ModuleName, 1501, "invalid version")
 	ErrRedemptionAlreadyExists      = sdkerrors.Register(ModuleName, 1502, "redemption record already exists")
 	ErrEpochUnbondingRecordNotFound = sdkerrors.Register(ModuleName, 1503, "epoch unbonding record not found")
+	ErrUnsupportedCallback          = sdkerrors.Register(ModuleName, 1504, "callback not supported")
+	ErrDepositRecordNotFound        = sdkerrors.Register(ModuleName, 1505, "deposit record not found")
+	ErrUnmarshalFailure             = sdkerrors.Register(ModuleName, 1506, "unable to unmarshal data structure")
+	ErrTransferFailed               = sdkerrors.Register(ModuleName, 1507, "transfer failed")
 )
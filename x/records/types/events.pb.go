@@ -0,0 +1,508 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: records/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventDepositRecordStatusChange is emitted when a deposit record moves between statuses, or is removed once its
+// tokens are delegated
+type EventDepositRecordStatusChange struct {
+	DepositRecordId uint64               `protobuf:"varint,1,opt,name=deposit_record_id,json=depositRecordId,proto3" json:"deposit_record_id,omitempty"`
+	HostZone        string               `protobuf:"bytes,2,opt,name=host_zone,json=hostZone,proto3" json:"host_zone,omitempty"`
+	Amount          int64                `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OldStatus       DepositRecord_Status `protobuf:"varint,4,opt,name=old_status,json=oldStatus,proto3,enum=Stridelabs.stride.records.DepositRecord_Status" json:"old_status,omitempty"`
+	NewStatus       DepositRecord_Status `protobuf:"varint,5,opt,name=new_status,json=newStatus,proto3,enum=Stridelabs.stride.records.DepositRecord_Status" json:"new_status,omitempty"`
+	// the record was removed, new_status is then the status it had
+	Removed bool `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *EventDepositRecordStatusChange) Reset()         { *m = EventDepositRecordStatusChange{} }
+func (m *EventDepositRecordStatusChange) String() string { return proto.CompactTextString(m) }
+func (*EventDepositRecordStatusChange) ProtoMessage()    {}
+func (*EventDepositRecordStatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f7ad2fcac79f551, []int{0}
+}
+func (m *EventDepositRecordStatusChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDepositRecordStatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDepositRecordStatusChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDepositRecordStatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDepositRecordStatusChange.Merge(m, src)
+}
+func (m *EventDepositRecordStatusChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDepositRecordStatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDepositRecordStatusChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDepositRecordStatusChange proto.InternalMessageInfo
+
+func (m *EventDepositRecordStatusChange) GetDepositRecordId() uint64 {
+	if m != nil {
+		return m.DepositRecordId
+	}
+	return 0
+}
+
+func (m *EventDepositRecordStatusChange) GetHostZone() string {
+	if m != nil {
+		return m.HostZone
+	}
+	return ""
+}
+
+func (m *EventDepositRecordStatusChange) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *EventDepositRecordStatusChange) GetOldStatus() DepositRecord_Status {
+	if m != nil {
+		return m.OldStatus
+	}
+	return DepositRecord_TRANSFER
+}
+
+func (m *EventDepositRecordStatusChange) GetNewStatus() DepositRecord_Status {
+	if m != nil {
+		return m.NewStatus
+	}
+	return DepositRecord_TRANSFER
+}
+
+func (m *EventDepositRecordStatusChange) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EventDepositRecordStatusChange)(nil), "Stridelabs.stride.records.EventDepositRecordStatusChange")
+}
+
+func init() { proto.RegisterFile("records/events.proto", fileDescriptor_3f7ad2fcac79f551) }
+
+var fileDescriptor_3f7ad2fcac79f551 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcd, 0x4a, 0x03, 0x31,
+	0x14, 0x85, 0x9b, 0xb6, 0xd6, 0x36, 0x0b, 0xc5, 0x41, 0x25, 0x2a, 0x84, 0xc1, 0xd5, 0x20, 0x34,
+	0x01, 0x7d, 0x03, 0x7f, 0x10, 0x41, 0x5c, 0xa4, 0xbb, 0x6e, 0x86, 0x99, 0xe6, 0xd2, 0x0e, 0xb4,
+	0xb9, 0x65, 0x92, 0xb6, 0xea, 0x53, 0xf8, 0x3e, 0xbe, 0x80, 0xcb, 0x2e, 0x5d, 0xca, 0xcc, 0x8b,
+	0xc8, 0xfc, 0x81, 0x2e, 0xdc, 0xb8, 0xcb, 0x4d, 0xee, 0xf9, 0xce, 0x09, 0x87, 0x1e, 0xa6, 0x30,
+	0xc1, 0x54, 0x5b, 0x09, 0x6b, 0x30, 0xce, 0x8a, 0x65, 0x8a, 0x0e, 0xbd, 0x93, 0x91, 0x4b, 0x13,
+	0x0d, 0xf3, 0x28, 0xb6, 0xc2, 0x96, 0x47, 0x51, 0xef, 0x9d, 0x1e, 0x35, 0x82, 0x29, 0x18, 0xb0,
+	0x49, 0xad, 0x38, 0x7f, 0x6f, 0x53, 0x7e, 0x57, 0x20, 0x6e, 0x61, 0x89, 0x36, 0x71, 0xaa, 0xdc,
+	0x1a, 0xb9, 0xc8, 0xad, 0xec, 0xcd, 0x2c, 0x32, 0x53, 0xf0, 0x2e, 0xe8, 0x81, 0xae, 0x1e, 0xc3,
+	0x8a, 0x11, 0x26, 0x9a, 0x11, 0x9f, 0x04, 0x5d, 0xb5, 0xaf, 0x7f, 0xaa, 0x1e, 0xb4, 0x77, 0x46,
+	0x07, 0x33, 0xb4, 0x2e, 0x7c, 0x45, 0x03, 0xac, 0xed, 0x93, 0x60, 0xa0, 0xfa, 0xc5, 0xc5, 0x18,
+	0x0d, 0x78, 0xc7, 0xb4, 0x17, 0x2d, 0x70, 0x65, 0x1c, 0xeb, 0xf8, 0x24, 0xe8, 0xa8, 0x7a, 0xf2,
+	0x9e, 0x28, 0xc5, 0xb9, 0x0e, 0x6d, 0x69, 0xca, 0xba, 0x3e, 0x09, 0xf6, 0x2e, 0xa5, 0xf8, 0xf3,
+	0x2b, 0xe2, 0x57, 0x54, 0x51, 0x65, 0x55, 0x03, 0x9c, 0xd7, 0xb1, 0x0b, 0x9e, 0x81, 0x4d, 0xc3,
+	0xdb, 0xf9, 0x27, 0xcf, 0xc0, 0xa6, 0xe6, 0x31, 0xba, 0x9b, 0xc2, 0x02, 0xd7, 0xa0, 0x59, 0xcf,
+	0x27, 0x41, 0x5f, 0x35, 0xe3, 0xf5, 0xfd, 0x47, 0xc6, 0xc9, 0x36, 0xe3, 0xe4, 0x2b, 0xe3, 0xe4,
+	0x2d, 0xe7, 0xad, 0x6d, 0xce, 0x5b, 0x9f, 0x39, 0x6f, 0x8d, 0x87, 0xd3, 0xc4, 0xcd, 0x56, 0xb1,
+	0x98, 0xe0, 0x42, 0x56, 0xce, 0xc3, 0xc7, 0x28, 0xb6, 0xb2, 0xb2, 0x96, 0xcf, 0xb2, 0xa9, 0xc3,
+	0xbd, 0x2c, 0xc1, 0xc6, 0xbd, 0xb2, 0x8d, 0xab, 0xef, 0x01, 0x00, 0x39, 0x99, 0xa0, 0x2e, 0xd7,
+	0x01, 0x00, 0x00,
+}
+
+func (m *EventDepositRecordStatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDepositRecordStatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDepositRecordStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NewStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OldStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostZone) > 0 {
+		i -= len(m.HostZone)
+		copy(dAtA[i:], m.HostZone)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.HostZone)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DepositRecordId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DepositRecordId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventDepositRecordStatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositRecordId != 0 {
+		n += 1 + sovEvents(uint64(m.DepositRecordId))
+	}
+	l = len(m.HostZone)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvents(uint64(m.Amount))
+	}
+	if m.OldStatus != 0 {
+		n += 1 + sovEvents(uint64(m.OldStatus))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovEvents(uint64(m.NewStatus))
+	}
+	if m.Removed {
+		n += 2
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventDepositRecordStatusChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDepositRecordStatusChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDepositRecordStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecordId", wireType)
+			}
+			m.DepositRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			m.OldStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldStatus |= DepositRecord_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= DepositRecord_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -34,16 +34,20 @@ const (
 	DepositRecord_TRANSFER DepositRecord_Status = 0
 	// pending staking on delegate account
 	DepositRecord_STAKE DepositRecord_Status = 1
+	// delegation submitted to the host, waiting on the ack or on a retry
+	DepositRecord_DELEGATION_IN_PROGRESS DepositRecord_Status = 2
 )
 
 var DepositRecord_Status_name = map[int32]string{
 	0: "TRANSFER",
 	1: "STAKE",
+	2: "DELEGATION_IN_PROGRESS",
 }
 
 var DepositRecord_Status_value = map[string]int32{
-	"TRANSFER": 0,
-	"STAKE":    1,
+	"TRANSFER":               0,
+	"STAKE":                  1,
+	"DELEGATION_IN_PROGRESS": 2,
 }
 
 func (x DepositRecord_Status) String() string {
@@ -88,18 +92,22 @@ const (
 	HostZoneUnbonding_UNBONDED HostZoneUnbonding_Status = 1
 	// transfer success
 	HostZoneUnbonding_TRANSFERRED HostZoneUnbonding_Status = 2
+	// undelegation submitted to the host, waiting on the ack or on a retry
+	HostZoneUnbonding_UNBONDING_IN_PROGRESS HostZoneUnbonding_Status = 3
 )
 
 var HostZoneUnbonding_Status_name = map[int32]string{
 	0: "BONDED",
 	1: "UNBONDED",
 	2: "TRANSFERRED",
+	3: "UNBONDING_IN_PROGRESS",
 }
 
 var HostZoneUnbonding_Status_value = map[string]int32{
-	"BONDED":      0,
-	"UNBONDED":    1,
-	"TRANSFERRED": 2,
+	"BONDED":                0,
+	"UNBONDED":              1,
+	"TRANSFERRED":           2,
+	"UNBONDING_IN_PROGRESS": 3,
 }
 
 func (x HostZoneUnbonding_Status) String() string {
@@ -701,59 +709,61 @@ func init() {
 func init() { proto.RegisterFile("records/genesis.proto", fileDescriptor_03dd178cbf8084c6) }
 
 var fileDescriptor_03dd178cbf8084c6 = []byte{
-	// 832 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x3f, 0x6f, 0xdb, 0x46,
-	0x14, 0x27, 0x45, 0x8a, 0xa6, 0x9f, 0x63, 0x57, 0x3e, 0x38, 0x2d, 0xe3, 0x41, 0x56, 0x88, 0x0c,
-	0x1a, 0x12, 0x12, 0xb0, 0x3b, 0xb5, 0x05, 0x0a, 0xd9, 0x52, 0x6d, 0x25, 0xae, 0x1a, 0x9c, 0x64,
-	0x04, 0x30, 0x02, 0x04, 0xa4, 0x78, 0xa5, 0x0f, 0x31, 0x79, 0x2c, 0xef, 0x18, 0xb4, 0x6b, 0x3f,
-	0x41, 0xc7, 0xa2, 0xe8, 0xd0, 0x8f, 0x93, 0x31, 0x63, 0xa7, 0xa2, 0xb0, 0xd7, 0x7e, 0x83, 0x2e,
-	0x05, 0x8f, 0x94, 0x41, 0x8b, 0x94, 0x9b, 0x64, 0xbb, 0xf7, 0xff, 0xbd, 0xdf, 0xe3, 0xfb, 0x11,
-	0xee, 0xa7, 0x64, 0xce, 0xd2, 0x80, 0xbb, 0x21, 0x89, 0x09, 0xa7, 0xdc, 0x49, 0x52, 0x26, 0x18,
-	0x7a, 0x30, 0x15, 0x29, 0x0d, 0xc8, 0xa5, 0xe7, 0x73, 0x87, 0xcb, 0xa7, 0x53, 0x3a, 0xee, 0xee,
-	0x84, 0x2c, 0x64, 0xd2, 0xcb, 0xcd, 0x5f, 0x45, 0xc0, 0xee, 0x5e, 0xc8, 0x58, 0x78, 0x49, 0x5c,
-	0x29, 0xf9, 0xd9, 0xf7, 0xae, 0xa0, 0x11, 0xe1, 0xc2, 0x8b, 0x92, 0xc2, 0xc1, 0xfe, 0x47, 0x85,
-	0x9d, 0x33, 0x4e, 0x52, 0x4c, 0x02, 0x12, 0x25, 0x82, 0xb2, 0x18, 0xcb, 0x84, 0x68, 0x0b, 0x5a,
-	0x34, 0xb0, 0xd4, 0x9e, 0xda, 0x5f, 0xc7, 0x2d, 0x1a, 0xa0, 0x4f, 0xc1, 0xe0, 0x24, 0x0e, 0x48,
-	0x6a, 0xb5, 0xa4, 0xae, 0x94, 0xd0, 0x2e, 0x98, 0x29, 0x99, 0x13, 0xfa, 0x86, 0xa4, 0x96, 0x26,
-	0x2d, 0x37, 0x72, 0x1e, 0xe3, 0x45, 0x2c, 0x8b, 0x85, 0xa5, 0xf7, 0xd4, 0xbe, 0x8e, 0x4b, 0x09,
-	0xed, 0x40, 0x3b, 0x20, 0x31, 0x8b, 0xac, 0xb6, 0x0c, 0x28, 0x04, 0xd4, 0x05, 0xb8, 0x60, 0x5c,
-	0x9c, 0xb3, 0x98, 0x8c, 0x03, 0xcb, 0x90, 0xa6, 0x8a, 0x06, 0xf5, 0x60, 0x83, 0x24, 0x6c, 0x7e,
-	0x31, 0xc9, 0x22, 0x9f, 0xa4, 0xd6, 0x9a, 0x4c, 0x59, 0x55, 0xe5, 0x1e, 0x94, 0x1f, 0x5d, 0x7a,
-	0x34, 0xf2, 0xfc, 0x4b, 0x62, 0x99, 0x3d, 0xb5, 0x6f, 0xe2, 0xaa, 0xca, 0xde, 0x02, 0xe3, 0xb9,
-	0x97, 0x7a, 0x11, 0xff, 0x42, 0xff, 0xf5, 0x8f, 0x3d, 0xc5, 0x3e, 0x87, 0xed, 0x62, 0x5e, 0xfe,
-	0xdc, 0x9b, 0xbf, 0x26, 0x62, 0xe8, 0x09, 0x0f, 0x7d, 0x09, 0x46, 0xcc, 0xf2, 0x97, 0x1c, 0x7f,
-	0x63, 0xff, 0xa1, 0xb3, 0x12, 0x76, 0x67, 0x22, 0x1d, 0x4f, 0x14, 0x5c, 0x86, 0x1c, 0x9a, 0x60,
-	0x24, 0x32, 0x95, 0x6d, 0x82, 0x51, 0x58, 0xed, 0x9f, 0x35, 0xd8, 0x1c, 0x92, 0x84, 0x71, 0x2a,
-	0x6a, 0xe8, 0xea, 0x0b, 0x74, 0x4b, 0xa4, 0x72, 0x74, 0xb5, 0x3a, 0x52, 0xda, 0x6a, 0xa4, 0xf4,
-	0x1a, 0x52, 0xc7, 0x60, 0x70, 0xe1, 0x89, 0x8c, 0x4b, 0x14, 0xb7, 0xf6, 0xdd, 0x3b, 0x06, 0xb8,
-	0xd5, 0x97, 0x33, 0x95, 0x61, 0xb8, 0x0c, 0x47, 0x0e, 0xa0, 0xa0, 0xb0, 0x8f, 0x6a, 0xc8, 0x37,
-	0x58, 0x64, 0x61, 0x96, 0xa5, 0xf3, 0x02, 0xfb, 0x0f, 0x2a, 0x2c, 0xc3, 0x70, 0x19, 0x6e, 0x3f,
-	0x04, 0xa3, 0x68, 0x05, 0xdd, 0x03, 0x73, 0x86, 0x07, 0x93, 0xe9, 0x37, 0x23, 0xdc, 0x51, 0xd0,
-	0x3a, 0xb4, 0xa7, 0xb3, 0xc1, 0xb3, 0x51, 0x47, 0xb5, 0xfb, 0x60, 0x14, 0x41, 0x08, 0xc0, 0x98,
-	0xce, 0xf0, 0x78, 0x38, 0xea, 0x28, 0x08, 0xc1, 0xd6, 0x8b, 0xf1, 0xec, 0x64, 0x88, 0x07, 0x2f,
-	0x06, 0xa7, 0xaf, 0xc6, 0x47, 0x83, 0x8e, 0xfa, 0x54, 0x37, 0xdb, 0x1d, 0xc3, 0xfe, 0xb7, 0x05,
-	0xdb, 0x27, 0x25, 0x46, 0x67, 0xb1, 0xcf, 0xe2, 0x80, 0xc6, 0x21, 0x7a, 0x04, 0x9b, 0x5c, 0xcc,
-	0xd8, 0x6b, 0x12, 0x0f, 0x0a, 0xfc, 0x8b, 0x9d, 0xdc, 0x56, 0xa2, 0xc7, 0xb0, 0x1d, 0x7b, 0x82,
-	0xbe, 0x21, 0x55, 0xcf, 0x96, 0xf4, 0xac, 0x1b, 0x3e, 0x72, 0x69, 0x8f, 0x60, 0x33, 0x5b, 0xb4,
-	0x35, 0xa3, 0x11, 0x91, 0xc7, 0xa1, 0xe3, 0xdb, 0x4a, 0xf4, 0x6c, 0x69, 0xb5, 0x07, 0x77, 0x20,
-	0x5c, 0x9b, 0x76, 0x79, 0xbd, 0x9f, 0xc3, 0xfd, 0xac, 0xe1, 0xf6, 0xb9, 0xb5, 0xd6, 0xd3, 0xfa,
-	0xeb, 0xb8, 0xd9, 0x68, 0x1f, 0xdc, 0xec, 0x06, 0xc0, 0x38, 0xfc, 0x6e, 0x32, 0x1c, 0x0d, 0x3b,
-	0x4a, 0xbe, 0xa7, 0xb3, 0x49, 0x29, 0xa9, 0xe8, 0x13, 0xd8, 0x58, 0x6c, 0x0d, 0x8f, 0x86, 0x9d,
-	0x96, 0xfd, 0xbb, 0x0a, 0x3b, 0xf2, 0x4b, 0xb9, 0x69, 0xa6, 0xbc, 0x84, 0xa5, 0xab, 0x56, 0xeb,
-	0x57, 0xfd, 0x12, 0xd0, 0xc5, 0xf2, 0x24, 0xdc, 0xd2, 0x7a, 0x5a, 0x7f, 0x63, 0xff, 0xf1, 0x87,
-	0x8c, 0x8f, 0x1b, 0xf2, 0x3c, 0xd5, 0xcd, 0x56, 0x47, 0xb3, 0x7f, 0xd3, 0xe1, 0xde, 0x71, 0x41,
-	0xb5, 0xf9, 0x6c, 0x04, 0x7d, 0x9d, 0x9f, 0x71, 0x4e, 0x14, 0xef, 0xc1, 0x01, 0x05, 0xa3, 0x1c,
-	0xea, 0x6f, 0xff, 0xda, 0x53, 0x70, 0x19, 0x86, 0x3e, 0x83, 0xb5, 0x84, 0xa5, 0xe2, 0x15, 0x0d,
-	0x16, 0x84, 0x99, 0x8b, 0xe3, 0x00, 0xfd, 0x00, 0x56, 0x13, 0xae, 0xa7, 0x94, 0x8b, 0x72, 0xa8,
-	0xbb, 0xae, 0xa6, 0x89, 0xab, 0xcb, 0xca, 0x2b, 0xd3, 0xa2, 0xaf, 0xe0, 0x41, 0x93, 0xed, 0xa8,
-	0x42, 0xcd, 0xab, 0x1d, 0xf2, 0x86, 0x49, 0xc3, 0xe6, 0x64, 0xc3, 0xed, 0xff, 0x6d, 0xb8, 0x69,
-	0xe9, 0x8b, 0x86, 0x57, 0xa5, 0x45, 0x2f, 0x61, 0x3b, 0xa8, 0xd2, 0x83, 0xac, 0xb5, 0x26, 0x6b,
-	0xf5, 0xdf, 0x97, 0x52, 0xca, 0x22, 0xf5, 0x44, 0x15, 0x56, 0xab, 0xe2, 0x60, 0xde, 0x62, 0xb5,
-	0x8a, 0x65, 0xbf, 0x0d, 0xda, 0xb7, 0x3c, 0x3c, 0x3c, 0x7e, 0x7b, 0xd5, 0x55, 0xdf, 0x5d, 0x75,
-	0xd5, 0xbf, 0xaf, 0xba, 0xea, 0x2f, 0xd7, 0x5d, 0xe5, 0xdd, 0x75, 0x57, 0xf9, 0xf3, 0xba, 0xab,
-	0x9c, 0x3f, 0x09, 0xa9, 0xb8, 0xc8, 0x7c, 0x67, 0xce, 0x22, 0xb7, 0xe8, 0xee, 0xc9, 0xa9, 0xe7,
-	0x73, 0xb7, 0x68, 0xcf, 0xfd, 0xd1, 0x5d, 0xfc, 0xcd, 0xc5, 0x4f, 0x09, 0xe1, 0xbe, 0x21, 0x7f,
-	0xbd, 0x07, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x18, 0xf8, 0x3e, 0x34, 0xe5, 0x07, 0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x4f, 0xe3, 0x46,
+	0x18, 0xb6, 0x63, 0xc7, 0x98, 0x97, 0x85, 0x9a, 0x11, 0x6c, 0x0d, 0x87, 0x90, 0x5a, 0x7b, 0xc8,
+	0x61, 0x37, 0x96, 0xd8, 0x9e, 0xfa, 0xa1, 0x2a, 0x10, 0x37, 0x64, 0x97, 0x1a, 0x34, 0x09, 0x5a,
+	0x09, 0xad, 0x84, 0x9c, 0x78, 0x1a, 0xac, 0xc5, 0x9e, 0xd4, 0x33, 0x59, 0xb5, 0xff, 0xa2, 0xa7,
+	0xaa, 0xaa, 0xaa, 0xaa, 0x3f, 0x67, 0x8f, 0x7b, 0xec, 0xa9, 0xaa, 0xe0, 0xda, 0x1f, 0x51, 0x79,
+	0xc6, 0x41, 0x0e, 0x76, 0xe8, 0xb2, 0x37, 0xbf, 0xdf, 0xef, 0xfb, 0x3c, 0xcc, 0x43, 0x60, 0x3b,
+	0x25, 0x63, 0x9a, 0x86, 0xcc, 0x9d, 0x90, 0x84, 0xb0, 0x88, 0xb5, 0xa7, 0x29, 0xe5, 0x14, 0xed,
+	0x0c, 0x78, 0x1a, 0x85, 0xe4, 0x2a, 0x18, 0xb1, 0x36, 0x13, 0x9f, 0xed, 0x3c, 0x71, 0x77, 0x6b,
+	0x42, 0x27, 0x54, 0x64, 0xb9, 0xd9, 0x97, 0x2c, 0xd8, 0xdd, 0x9b, 0x50, 0x3a, 0xb9, 0x22, 0xae,
+	0xb0, 0x46, 0xb3, 0xef, 0x5d, 0x1e, 0xc5, 0x84, 0xf1, 0x20, 0x9e, 0xca, 0x04, 0xe7, 0x5f, 0x15,
+	0xb6, 0xce, 0x18, 0x49, 0x31, 0x09, 0x49, 0x3c, 0xe5, 0x11, 0x4d, 0xb0, 0x68, 0x88, 0x36, 0xa0,
+	0x16, 0x85, 0xb6, 0xda, 0x54, 0x5b, 0xab, 0xb8, 0x16, 0x85, 0xe8, 0x31, 0x18, 0x8c, 0x24, 0x21,
+	0x49, 0xed, 0x9a, 0xf0, 0xe5, 0x16, 0xda, 0x05, 0x33, 0x25, 0x63, 0x12, 0xbd, 0x25, 0xa9, 0xad,
+	0x89, 0xc8, 0xad, 0x9d, 0xd5, 0x04, 0x31, 0x9d, 0x25, 0xdc, 0xd6, 0x9b, 0x6a, 0x4b, 0xc7, 0xb9,
+	0x85, 0xb6, 0xa0, 0x1e, 0x92, 0x84, 0xc6, 0x76, 0x5d, 0x14, 0x48, 0x03, 0x35, 0x00, 0x2e, 0x29,
+	0xe3, 0xe7, 0x34, 0x21, 0xfd, 0xd0, 0x36, 0x44, 0xa8, 0xe0, 0x41, 0x4d, 0x58, 0x23, 0x53, 0x3a,
+	0xbe, 0xf4, 0x67, 0xf1, 0x88, 0xa4, 0xf6, 0x8a, 0x68, 0x59, 0x74, 0x65, 0x19, 0x11, 0x3b, 0xbc,
+	0x0a, 0xa2, 0x38, 0x18, 0x5d, 0x11, 0xdb, 0x6c, 0xaa, 0x2d, 0x13, 0x17, 0x5d, 0xce, 0x06, 0x18,
+	0xa7, 0x41, 0x1a, 0xc4, 0xec, 0x0b, 0xfd, 0xd7, 0x3f, 0xf7, 0x14, 0xe7, 0x1c, 0x36, 0xe5, 0xbd,
+	0xec, 0x34, 0x18, 0xbf, 0x21, 0xbc, 0x1b, 0xf0, 0x00, 0x7d, 0x09, 0x46, 0x42, 0xb3, 0x2f, 0x71,
+	0xfe, 0xda, 0xfe, 0x67, 0xed, 0xa5, 0xb0, 0xb7, 0x7d, 0x91, 0x78, 0xa4, 0xe0, 0xbc, 0xe4, 0xc0,
+	0x04, 0x63, 0x2a, 0x5a, 0x39, 0x26, 0x18, 0x32, 0xea, 0xfc, 0xa1, 0xc1, 0x7a, 0x97, 0x4c, 0x29,
+	0x8b, 0x78, 0x09, 0x5d, 0x7d, 0x8e, 0x6e, 0x8e, 0x54, 0x86, 0xae, 0x56, 0x46, 0x4a, 0x5b, 0x8e,
+	0x94, 0x5e, 0x42, 0xaa, 0x07, 0x06, 0xe3, 0x01, 0x9f, 0x31, 0x81, 0xe2, 0xc6, 0xbe, 0x7b, 0xcf,
+	0x01, 0x0b, 0x7b, 0xb5, 0x07, 0xa2, 0x0c, 0xe7, 0xe5, 0xa8, 0x0d, 0x28, 0x94, 0x71, 0xaf, 0x84,
+	0x7c, 0x45, 0x44, 0x0c, 0xa6, 0xb3, 0x74, 0x2c, 0xb1, 0x7f, 0xd0, 0x60, 0x51, 0x86, 0xf3, 0x72,
+	0xe7, 0x6b, 0x30, 0xe4, 0x2a, 0xe8, 0x11, 0x98, 0x43, 0xdc, 0xf1, 0x07, 0xdf, 0x7a, 0xd8, 0x52,
+	0xd0, 0x2a, 0xd4, 0x07, 0xc3, 0xce, 0x4b, 0xcf, 0x52, 0xd1, 0x2e, 0x3c, 0xee, 0x7a, 0xc7, 0x5e,
+	0xaf, 0x33, 0xec, 0x9f, 0xf8, 0x17, 0x7d, 0xff, 0xe2, 0x14, 0x9f, 0xf4, 0xb0, 0x37, 0x18, 0x58,
+	0x35, 0xa7, 0x05, 0x86, 0x6c, 0x88, 0x00, 0x8c, 0xc1, 0x10, 0xf7, 0xbb, 0x9e, 0xa5, 0x20, 0x04,
+	0x1b, 0xaf, 0xfa, 0xc3, 0xa3, 0x2e, 0xee, 0xbc, 0xea, 0x1c, 0x5f, 0xf4, 0x0f, 0x3b, 0x96, 0xfa,
+	0x42, 0x37, 0xeb, 0x96, 0xe1, 0xfc, 0xa2, 0xc1, 0xe6, 0x51, 0x8e, 0xdf, 0x59, 0x32, 0xa2, 0x49,
+	0x18, 0x25, 0x13, 0xf4, 0x04, 0xd6, 0x19, 0x1f, 0xd2, 0x37, 0x24, 0xe9, 0x48, 0x6e, 0x24, 0x5f,
+	0x8b, 0x4e, 0xf4, 0x14, 0x36, 0x93, 0x80, 0x47, 0x6f, 0x49, 0x31, 0xb3, 0x26, 0x32, 0xcb, 0x81,
+	0x8f, 0x24, 0xf4, 0x09, 0xac, 0xcf, 0xe6, 0x6b, 0x0d, 0xa3, 0x98, 0x88, 0x87, 0xa3, 0xe3, 0x45,
+	0x27, 0x7a, 0x79, 0x87, 0xf6, 0xe7, 0xf7, 0xa0, 0x5f, 0xba, 0xf6, 0x2e, 0xf5, 0x9f, 0xc3, 0xf6,
+	0xac, 0x42, 0x17, 0x98, 0xbd, 0xd2, 0xd4, 0x5a, 0xab, 0xb8, 0x3a, 0xe8, 0xf8, 0xb7, 0xbc, 0x01,
+	0x18, 0x07, 0x27, 0x7e, 0xd7, 0xeb, 0x5a, 0x4a, 0xc6, 0xe1, 0x99, 0x9f, 0x5b, 0x2a, 0xfa, 0x04,
+	0xd6, 0xe6, 0x8c, 0x62, 0xaf, 0x6b, 0xd5, 0xd0, 0x0e, 0x6c, 0xcb, 0x70, 0xdf, 0xef, 0x2d, 0x10,
+	0xa9, 0x39, 0xbf, 0xab, 0xb0, 0x25, 0xfe, 0xc0, 0x6e, 0xf7, 0xcc, 0x1f, 0xd0, 0x1d, 0x31, 0x50,
+	0xcb, 0x62, 0xf0, 0x1a, 0xd0, 0xe5, 0xdd, 0x23, 0x99, 0xad, 0x35, 0xb5, 0xd6, 0xda, 0xfe, 0xd3,
+	0x87, 0x20, 0x83, 0x2b, 0xfa, 0xbc, 0xd0, 0xcd, 0x9a, 0xa5, 0x39, 0xbf, 0xe9, 0xf0, 0xa8, 0x27,
+	0x15, 0x3a, 0x3b, 0x9b, 0xa0, 0x6f, 0xb2, 0xd7, 0x9f, 0xe9, 0xcb, 0x07, 0x48, 0x87, 0x14, 0xa2,
+	0x03, 0xfd, 0xdd, 0xdf, 0x7b, 0x0a, 0xce, 0xcb, 0xd0, 0xa7, 0xb0, 0x32, 0xa5, 0x29, 0xbf, 0x88,
+	0xc2, 0xb9, 0xce, 0x66, 0x66, 0x3f, 0x44, 0x3f, 0x80, 0x5d, 0x05, 0xf9, 0x71, 0xc4, 0x78, 0x7e,
+	0xd4, 0x7d, 0x8f, 0xad, 0x4a, 0xe2, 0xf3, 0xc9, 0x4b, 0xdb, 0xa2, 0xaf, 0x60, 0xa7, 0x2a, 0x76,
+	0x58, 0x50, 0xf4, 0xe5, 0x09, 0xd9, 0xc2, 0xa4, 0x82, 0x39, 0xb1, 0x70, 0xfd, 0x7f, 0x17, 0xae,
+	0x22, 0x7d, 0xbe, 0xf0, 0xb2, 0xb6, 0xe8, 0x35, 0x6c, 0x86, 0x45, 0x55, 0x11, 0xb3, 0x56, 0xc4,
+	0xac, 0xd6, 0x87, 0x2a, 0x51, 0x3e, 0xa4, 0xdc, 0xa8, 0x20, 0x86, 0x45, 0x1c, 0xcc, 0x05, 0x31,
+	0x2c, 0x44, 0xf6, 0xeb, 0xa0, 0x7d, 0xc7, 0x26, 0x07, 0xbd, 0x77, 0xd7, 0x0d, 0xf5, 0xfd, 0x75,
+	0x43, 0xfd, 0xe7, 0xba, 0xa1, 0xfe, 0x7c, 0xd3, 0x50, 0xde, 0xdf, 0x34, 0x94, 0xbf, 0x6e, 0x1a,
+	0xca, 0xf9, 0xb3, 0x49, 0xc4, 0x2f, 0x67, 0xa3, 0xf6, 0x98, 0xc6, 0xae, 0xdc, 0xee, 0xd9, 0x71,
+	0x30, 0x62, 0xae, 0x5c, 0xcf, 0xfd, 0xd1, 0x9d, 0xff, 0x08, 0xe0, 0x3f, 0x4d, 0x09, 0x1b, 0x19,
+	0xe2, 0x3f, 0xf6, 0xf3, 0xff, 0x06, 0x00, 0x35, 0xb2, 0x4a, 0x68, 0x1c, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -12,6 +12,11 @@ import (
 )
 
 // BeginBlocker of stakeibc module
-func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper) {
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper, telemetryEnabled bool) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// the gauges scan every deposit record, so they're skipped when nothing exports them
+	if telemetryEnabled {
+		k.SetHostZoneGauges(ctx)
+	}
 }
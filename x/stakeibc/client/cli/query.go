@@ -29,6 +29,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowICAAccount())
 	cmd.AddCommand(CmdListHostZone())
 	cmd.AddCommand(CmdShowHostZone())
+	cmd.AddCommand(CmdShowHostZoneAccounting())
+	cmd.AddCommand(CmdShowUnbondingSchedule())
 	cmd.AddCommand(CmdModuleAddress())
 	cmd.AddCommand(CmdShowInterchainAccount())
 	cmd.AddCommand(CmdListEpochTracker())
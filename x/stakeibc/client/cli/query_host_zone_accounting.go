@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowHostZoneAccounting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-host-zone-accounting [chain-id]",
+		Short: "shows the accounting breakdown behind a HostZone's redemption rate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainId := args[0]
+
+			params := &types.QueryHostZoneAccountingRequest{
+				ChainId: chainId,
+			}
+
+			res, err := queryClient.HostZoneAccounting(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
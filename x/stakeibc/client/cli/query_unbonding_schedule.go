@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const FlagCount = "count"
+
+func CmdShowUnbondingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-unbonding-schedule [chain-id]",
+		Short: "shows when the next unbondings of each HostZone, or of one, are sent",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			count, err := cmd.Flags().GetUint64(FlagCount)
+			if err != nil {
+				return err
+			}
+			params := &types.QueryUnbondingScheduleRequest{
+				Count: count,
+			}
+			if len(args) == 1 {
+				params.ChainId = args[0]
+			}
+
+			res, err := queryClient.UnbondingSchedule(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCount, 1, "number of upcoming unbondings per host zone")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCloseIcaChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-ica-channel [chain-id] [account-type]",
+		Short: "Broadcast message close-ica-channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+			argAccountType := args[1]
+
+			accountType, found := types.ICAAccountType_value[argAccountType]
+			if !found {
+				return errors.New("Invalid account type.")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCloseIcaChannel(
+				clientCtx.GetFromAddress().String(),
+				argChainId,
+				types.ICAAccountType(accountType),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const FlagMinStAmountOut = "min-st-amount-out"
+
 func CmdLiquidStake() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "liquid-stake [amount] [hostDenom]",
@@ -24,6 +26,10 @@ func CmdLiquidStake() *cobra.Command {
 				return err
 			}
 			argHostDenom := args[1]
+			minStAmountOut, err := cmd.Flags().GetUint64(FlagMinStAmountOut)
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -34,6 +40,7 @@ func CmdLiquidStake() *cobra.Command {
 				clientCtx.GetFromAddress().String(),
 				argAmount,
 				argHostDenom,
+				minStAmountOut,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -42,6 +49,7 @@ func CmdLiquidStake() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagMinStAmountOut, 0, "minimum amount of stTokens to receive, the tx fails if fewer would be minted (0 disables the check)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -14,6 +14,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const FlagMinNativeAmountOut = "min-native-amount-out"
+
 func CmdRedeemStake() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "redeem-stake [amount] [hostZoneID] [receiver]",
@@ -32,12 +34,17 @@ func CmdRedeemStake() *cobra.Command {
 			}
 
 			argReceiver := args[2]
+			minNativeAmountOut, err := cmd.Flags().GetUint64(FlagMinNativeAmountOut)
+			if err != nil {
+				return err
+			}
 
 			msg := types.NewMsgRedeemStake(
 				clientCtx.GetFromAddress().String(),
 				argAmount,
 				hostZoneID,
 				argReceiver,
+				minNativeAmountOut,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -46,6 +53,7 @@ func CmdRedeemStake() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagMinNativeAmountOut, 0, "minimum amount of native tokens to redeem, the tx fails if fewer would be redeemed (0 disables the check)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
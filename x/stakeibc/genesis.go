@@ -22,6 +22,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set hostZone count
 	k.SetHostZoneCount(ctx, genState.HostZoneCount)
+	// Set the last queried ICA balances
+	for _, elem := range genState.IcaBalanceList {
+		k.SetIcaBalance(ctx, elem.ChainId, elem.AccountType, elem.Balance)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	// TODO(TEST-22): Set ports
 	// k.SetPort(ctx, genState.PortId)
@@ -51,6 +55,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.ICAAccount = &iCAAccount
 	}
 	genesis.EpochTrackerList = k.GetAllEpochTracker(ctx)
+	genesis.IcaBalanceList = k.GetAllIcaBalance(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -3,6 +3,8 @@ package stakeibc_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	keepertest "github.com/Stride-Labs/stride/testutil/keeper"
 	"github.com/Stride-Labs/stride/testutil/nullify"
 	"github.com/Stride-Labs/stride/x/stakeibc"
@@ -20,6 +22,11 @@ func TestGenesis(t *testing.T) {
 		EpochTrackerList: []types.EpochTracker{
 			{EpochIdentifier: "stride_epoch"},
 		},
+		IcaBalanceList: []types.HostZoneIcaBalance{
+			{ChainId: "GAIA", AccountType: types.ICAAccountType_FEE, Balance: types.IcaBalance{Address: "cosmos_FEE", Amount: sdk.NewInt(10), RemoteHeight: 5}},
+			{ChainId: "GAIA", AccountType: types.ICAAccountType_WITHDRAWAL, Balance: types.IcaBalance{Address: "cosmos_WITHDRAWAL", Amount: sdk.ZeroInt()}},
+			{ChainId: "OSMO", AccountType: types.ICAAccountType_FEE, Balance: types.IcaBalance{Address: "osmo_FEE", Amount: sdk.NewInt(20), RemoteHeight: 7}},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -35,5 +42,6 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.ICAAccount, got.ICAAccount)
 	require.Equal(t, genesisState.EpochTrackerList, got.EpochTrackerList)
 	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.IcaBalanceList, got.IcaBalanceList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -5,13 +5,13 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cast"
 
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-
 	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icqkeeper "github.com/Stride-Labs/stride/x/interchainquery/keeper"
 	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 )
@@ -19,7 +19,10 @@ import (
 // ___________________________________________________________________________________________________
 
 // Callbacks wrapper struct for interchainstaking keeper
-type Callback func(Keeper, sdk.Context, []byte, icqtypes.Query) error
+type Callback func(Keeper, sdk.Context, []byte, icqtypes.Query, int64) error
+
+// number of times an unanswered stakeibc query is emitted again before it fails
+const icqMaxRetries = 2
 
 type Callbacks struct {
 	k         Keeper
@@ -33,8 +36,21 @@ func (k Keeper) CallbackHandler() Callbacks {
 }
 
 //callback handler
-func (c Callbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
-	return c.callbacks[id](c.k, ctx, args, query)
+func (c Callbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.Query, height int64) error {
+	return c.callbacks[id](c.k, ctx, args, query, height)
+}
+
+// CallTimeout is called when a stakeibc query fails after running out of retries. The callback's state transition
+// is skipped (e.g. no reinvestment happens), so the failure is surfaced for operators and the query is re-issued
+// on the next epoch
+func (c Callbacks) CallTimeout(ctx sdk.Context, id string, query icqtypes.Query) error {
+	c.k.Logger(ctx).Error(fmt.Sprintf("ICQ %s (callback %s) on %s timed out after %d retries", query.Id, id, query.ChainId, query.Retries))
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcqTimeout{
+		ChainId:    query.ChainId,
+		QueryId:    query.Id,
+		CallbackId: id,
+		Retries:    query.Retries,
+	})
 }
 
 func (c Callbacks) Has(id string) bool {
@@ -50,51 +66,80 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	return c.
 		AddCallback("withdrawalbalance", Callback(WithdrawalBalanceCallback)).
+		AddCallback("feebalance", Callback(FeeBalanceCallback)).
 		AddCallback("delegation", Callback(DelegatorSharesCallback)).
 		AddCallback("validator", Callback(ValidatorExchangeRateCallback))
 
 }
 
+// IcqTimeoutPolicy returns the timeout policy for stakeibc queries: a query that isn't answered within the ibc
+// timeout is emitted again up to icqMaxRetries times
+func (k Keeper) IcqTimeoutPolicy(ctx sdk.Context) icqtypes.TimeoutPolicy {
+	return icqtypes.TimeoutPolicy{
+		Blocks:     k.GetParam(ctx, types.KeyIbcTimeoutBlocks),
+		MaxRetries: icqMaxRetries,
+	}
+}
+
+// fundIcqReward funds the reward for the relayer that answers a stakeibc query from the stakeibc module account, if
+// the IcqResponseReward param is set. A query that's requested again before it's answered keeps the reward it was
+// first funded with. A missing reward doesn't block the query, so failures are only logged
+func (k Keeper) fundIcqReward(ctx sdk.Context, hostZone types.HostZone, queryType string, request []byte, height int64) {
+	rewardAmount := k.GetParam(ctx, types.KeyIcqResponseReward)
+	if rewardAmount == 0 {
+		return
+	}
+	queryId := icqkeeper.GenerateQueryHash(hostZone.ConnectionId, hostZone.ChainId, queryType, request, types.ModuleName, height)
+	if query, found := k.InterchainQueryKeeper.GetQuery(ctx, queryId); found && !query.Reward.IsZero() {
+		return
+	}
+	reward := sdk.NewCoins(sdk.NewCoin(k.StakingKeeper.BondDenom(ctx), sdk.NewIntFromUint64(rewardAmount)))
+	if err := k.InterchainQueryKeeper.FundQueryReward(ctx, types.ModuleName, queryId, reward); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to fund ICQ %s reward, error: %s", queryId, err.Error()))
+	}
+}
+
+// setQueriedIcaBalance records the balance of a host zone's ICA returned by a balance query. The address is kept in the
+// host zone's bech32 prefix, as the query request only holds its bytes
+func (k Keeper) setQueriedIcaBalance(ctx sdk.Context, zone types.HostZone, accountType types.ICAAccountType, accAddr sdk.AccAddress, amount sdk.Int, height int64) {
+	address := accAddr.String()
+	if ica := GetIcaAccount(zone, accountType); ica != nil {
+		if _, icaAddr, err := bech32.DecodeAndConvert(ica.Address); err == nil && bytes.Equal(icaAddr, accAddr) {
+			address = ica.Address
+		}
+	}
+	k.SetIcaBalance(ctx, zone.ChainId, accountType, types.IcaBalance{
+		Address:      address,
+		Amount:       amount,
+		RemoteHeight: height,
+	})
+}
+
 // -----------------------------------
 // Callback Handlers
 // -----------------------------------
 
 // WithdrawalBalanceCallback is a callback handler for WithdrawalBalance queries.
-func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query, height int64) error {
 	// NOTE(TEST-112) for now, to get proofs in your ICQs, you need to query the entire store on the host zone! e.g. "store/bank/key"
-	k.Logger(ctx).Error(fmt.Sprintf("WithdrawalBalanceCallback: %v", query))
+	k.Logger(ctx).Error(fmt.Sprintf("WithdrawalBalanceCallback: %v at height %d", query, height))
 
 	zone, found := k.GetHostZone(ctx, query.GetChainId())
 	if !found {
 		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
-	balancesStore := query.Request[1:]
-	accAddr, err := banktypes.AddressFromBalancesStore(balancesStore)
+	accAddr, _, err := icqtypes.ParseBalanceRequest(query.Request)
 	if err != nil {
 		return err
 	}
 
-	//TODO(TEST-112) revisit this code, it's not vetted
-	coin := sdk.Coin{}
-	err = k.cdc.Unmarshal(args, &coin)
+	// if the balance isn't set, the response sent back is nil, so the denom is read from the request
+	coin, err := icqtypes.DecodeBalanceResponse(k.cdc, query.Request, args)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("unable to unmarshal balance info for zone: %s, err: %s", zone.ChainId, err.Error()))
 		return err
 	}
-
-	if coin.IsNil() {
-		denom := ""
-
-		for i := 0; i < len(query.Request)-len(accAddr); i++ {
-			if bytes.Equal(query.Request[i:i+len(accAddr)], accAddr) {
-				denom = string(query.Request[i+len(accAddr):])
-				break
-			}
-
-		}
-		// if balance is nil, the response sent back is nil, so we don't receive the denom. Override that now.
-		coin = sdk.NewCoin(denom, sdk.ZeroInt())
-	}
+	k.setQueriedIcaBalance(ctx, zone, types.ICAAccountType_WITHDRAWAL, accAddr, coin.Amount, height)
 
 	// sanity check, do not transfer if we have 0 balance!
 	if coin.Amount.Int64() <= 0 {
@@ -110,13 +155,6 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 	}
 	zone.WithdrawalAccount = wa
 	k.SetHostZone(ctx, zone)
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute("hostZone", zone.ChainId),
-			sdk.NewAttribute("totalWithdrawalBalance", coin.Amount.String()),
-		),
-	)
 
 	// Sweep the withdrawal account balance, to the commission and the delegation accounts
 	k.Logger(ctx).Info(fmt.Sprintf("ICA Bank Sending %d%s from withdrawalAddr to delegationAddr.", coin.Amount.Int64(), coin.Denom))
@@ -192,6 +230,33 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to SubmitTxs for %s, %s, %s", zone.ConnectionId, zone.ChainId, msgs)
 	}
 
+	return ctx.EventManager().EmitTypedEvent(&types.EventReinvest{
+		HostZone:          zone.ChainId,
+		WithdrawalBalance: coin,
+		ReinvestAmount:    reinvestCoin,
+		FeeAmount:         strideCoin,
+	})
+}
+
+// FeeBalanceCallback is a callback handler for FeeBalance queries, which records the fee ICA's balance.
+func FeeBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query, height int64) error {
+	k.Logger(ctx).Info(fmt.Sprintf("FeeBalanceCallback: %v at height %d", query, height))
+
+	zone, found := k.GetHostZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+	accAddr, _, err := icqtypes.ParseBalanceRequest(query.Request)
+	if err != nil {
+		return err
+	}
+	coin, err := icqtypes.DecodeBalanceResponse(k.cdc, query.Request, args)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("unable to unmarshal balance info for zone: %s, err: %s", zone.ChainId, err.Error()))
+		return err
+	}
+
+	k.setQueriedIcaBalance(ctx, zone, types.ICAAccountType_FEE, accAddr, coin.Amount, height)
 	return nil
 }
 
@@ -206,18 +271,17 @@ func getValidator(validators []*types.Validator, address string) (types.Validato
 }
 
 // ValidatorCallback is a callback handler for validator queries.
-func ValidatorExchangeRateCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+func ValidatorExchangeRateCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query, height int64) error {
 	zone, found := k.GetHostZone(ctx, query.GetChainId())
 	if !found {
 		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
-	queriedValidator := stakingtypes.Validator{}
-	err := k.cdc.Unmarshal(args, &queriedValidator)
+	queriedValidator, err := icqtypes.DecodeValidatorResponse(k.cdc, args)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("unable to unmarshal queriedValidator info for zone %s, err: %s", zone.ChainId, err.Error()))
 		return err
 	}
-	k.Logger(ctx).Info(fmt.Sprintf("ValidatorCallback: zone %v queriedValidator %v", zone.ChainId, queriedValidator))
+	k.Logger(ctx).Info(fmt.Sprintf("ValidatorCallback: zone %v queriedValidator %v at height %d", zone.ChainId, queriedValidator, height))
 
 	// ensure ICQ can be issued now! else fail the callback
 	valid, err := k.IsWithinBufferWindow(ctx)
@@ -248,8 +312,8 @@ func ValidatorExchangeRateCallback(k Keeper, ctx sdk.Context, args []byte, query
 	zone.Validators[i] = &v
 	k.SetHostZone(ctx, zone)
 
-	// armed with the exch rate, we can now query the (val,del) delegation
-	err = k.QueryDelegationsIcq(ctx, zone, queriedValidator.OperatorAddress)
+	// armed with the exch rate, we can now query the (val,del) delegation at the same host height
+	err = k.QueryDelegationsIcq(ctx, zone, queriedValidator.OperatorAddress, height)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("ValidatorCallback: failed to query delegation, zone %s, err: %s", zone.ChainId, err.Error()))
 		return err
@@ -258,7 +322,7 @@ func ValidatorExchangeRateCallback(k Keeper, ctx sdk.Context, args []byte, query
 }
 
 // DelegationCallback is a callback handler for UpdateValidatorSharesExchRate queries.
-func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query, height int64) error {
 	// NOTE(TEST-112) for now, to get proofs in your ICQs, you need to query the entire store on the host zone! e.g. "store/bank/key"
 
 	zone, found := k.GetHostZone(ctx, query.GetChainId())
@@ -274,13 +338,12 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 		return nil
 	}
 
-	qdel := stakingtypes.Delegation{}
-	err = k.cdc.Unmarshal(args, &qdel)
+	qdel, err := icqtypes.DecodeDelegationResponse(k.cdc, args)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("unable to unmarshal qdel info for zone %s, err: %s", zone.ChainId, err.Error()))
 		return err
 	}
-	k.Logger(ctx).Info(fmt.Sprintf("DelegationCallback: zone %s qdel %v", zone.ChainId, qdel))
+	k.Logger(ctx).Info(fmt.Sprintf("DelegationCallback: zone %s qdel %v at height %d", zone.ChainId, qdel, height))
 
 	// get tokens using the validator's conversion rate
 	for i, v := range zone.Validators {
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (k Keeper) HostZoneAccounting(c context.Context, req *types.QueryHostZoneAccountingRequest) (*types.QueryHostZoneAccountingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "host zone %s", req.ChainId)
+	}
+
+	// same inputs as UpdateRedemptionRates
+	depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
+	undelegatedBalance, err := k.GetUndelegatedBalance(hostZone, depositRecords)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	moduleAcctBalance, err := k.GetModuleAccountBalance(hostZone, depositRecords)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	stSupply := k.bankKeeper.GetSupply(ctx, types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)).Amount
+	tvl := sdk.NewInt(moduleAcctBalance).Add(sdk.NewInt(undelegatedBalance)).Add(sdk.NewIntFromUint64(hostZone.StakedBal))
+
+	// sum the host zone's unbondings by status, in enum order
+	unbondingAmounts := []types.UnbondingAmount{}
+	unbondingIndex := map[recordstypes.HostZoneUnbonding_Status]int{}
+	for _, statusValue := range []recordstypes.HostZoneUnbonding_Status{
+		recordstypes.HostZoneUnbonding_BONDED,
+		recordstypes.HostZoneUnbonding_UNBONDED,
+		recordstypes.HostZoneUnbonding_TRANSFERRED,
+		recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS,
+	} {
+		unbondingIndex[statusValue] = len(unbondingAmounts)
+		unbondingAmounts = append(unbondingAmounts, types.UnbondingAmount{Status: statusValue})
+	}
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.HostZoneId != hostZone.ChainId {
+				continue
+			}
+			i, found := unbondingIndex[hostZoneUnbonding.Status]
+			if !found {
+				continue
+			}
+			unbondingAmounts[i].NativeTokenAmount += hostZoneUnbonding.NativeTokenAmount
+			unbondingAmounts[i].StTokenAmount += hostZoneUnbonding.StTokenAmount
+		}
+	}
+
+	// sum the redemptions that users can already claim
+	claimableAmount := uint64(0)
+	for _, userRedemptionRecord := range k.RecordsKeeper.GetAllUserRedemptionRecord(ctx) {
+		if userRedemptionRecord.HostZoneId == hostZone.ChainId && userRedemptionRecord.IsClaimable {
+			claimableAmount += userRedemptionRecord.Amount
+		}
+	}
+
+	return &types.QueryHostZoneAccountingResponse{
+		ChainId:              hostZone.ChainId,
+		HostDenom:            hostZone.HostDenom,
+		ModuleAccountBalance: moduleAcctBalance,
+		UndelegatedBalance:   undelegatedBalance,
+		StakedBalance:        hostZone.StakedBal,
+		StSupply:             stSupply,
+		RedemptionRate:       hostZone.RedemptionRate,
+		UnbondingAmounts:     unbondingAmounts,
+		ClaimableAmount:      claimableAmount,
+		WithdrawalBalance:    k.GetHostZoneIcaBalance(ctx, hostZone, types.ICAAccountType_WITHDRAWAL),
+		FeeBalance:           k.GetHostZoneIcaBalance(ctx, hostZone, types.ICAAccountType_FEE),
+		Tvl:                  tvl,
+	}, nil
+}
+
+// GetHostZoneIcaBalance returns the host denom balance of an ICA reported by its last bank balance interchain query,
+// or zero if it hasn't been queried yet, or was queried for an address that has since changed
+func (k Keeper) GetHostZoneIcaBalance(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) types.IcaBalance {
+	balance := types.IcaBalance{Amount: sdk.ZeroInt()}
+	ica := GetIcaAccount(hostZone, accountType)
+	if ica == nil || ica.Address == "" {
+		return balance
+	}
+	balance.Address = ica.Address
+
+	storedBalance, found := k.GetIcaBalance(ctx, hostZone.ChainId, accountType)
+	if !found || storedBalance.Address != ica.Address {
+		return balance
+	}
+	return storedBalance
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icqkeeper "github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibc "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestHostZoneAccounting() {
+	withdrawalAddress := "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf"
+	feeAddress := "cosmos1vejk2hmpvd3k7atww3047h6lta047h6l6ky5rp"
+	hostZone := stakeibc.HostZone{
+		ChainId:           "GAIA",
+		ConnectionId:      "connection-0",
+		HostDenom:         atom,
+		IBCDenom:          ibcAtom,
+		RedemptionRate:    sdk.NewDec(1),
+		StakedBal:         5_000,
+		WithdrawalAccount: &stakeibc.ICAAccount{Address: withdrawalAddress, Target: stakeibc.ICAAccountType_WITHDRAWAL},
+		FeeAccount:        &stakeibc.ICAAccount{Address: feeAddress, Target: stakeibc.ICAAccountType_FEE},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(stAtom, 7_000))
+
+	depositRecords := []recordtypes.DepositRecord{
+		{Id: 1, HostZoneId: "GAIA", Amount: 100, Status: recordtypes.DepositRecord_TRANSFER},
+		{Id: 2, HostZoneId: "GAIA", Amount: 200, Status: recordtypes.DepositRecord_STAKE},
+		{Id: 3, HostZoneId: "GAIA", Amount: 300, Status: recordtypes.DepositRecord_STAKE},
+		{Id: 4, HostZoneId: "OSMO", Amount: 400, Status: recordtypes.DepositRecord_TRANSFER},
+	}
+	for _, depositRecord := range depositRecords {
+		s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+	}
+
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: "GAIA", NativeTokenAmount: 10, StTokenAmount: 10, Status: recordtypes.HostZoneUnbonding_UNBONDED},
+			{HostZoneId: "OSMO", NativeTokenAmount: 99, StTokenAmount: 99, Status: recordtypes.HostZoneUnbonding_UNBONDED},
+		},
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: "GAIA", NativeTokenAmount: 20, StTokenAmount: 19, Status: recordtypes.HostZoneUnbonding_BONDED},
+		},
+	})
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{Id: "GAIA.1.a", HostZoneId: "GAIA", Amount: 8, IsClaimable: true})
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{Id: "GAIA.2.a", HostZoneId: "GAIA", Amount: 9, IsClaimable: false})
+
+	// nothing has been queried yet
+	res, err := s.App.StakeibcKeeper.HostZoneAccounting(sdk.WrapSDKContext(s.Ctx), &stakeibc.QueryHostZoneAccountingRequest{ChainId: "GAIA"})
+	s.Require().NoError(err)
+	s.Require().Equal(stakeibc.IcaBalance{Address: withdrawalAddress, Amount: sdk.ZeroInt()}, res.WithdrawalBalance, "withdrawal balance before query")
+	s.Require().Equal(stakeibc.IcaBalance{Address: feeAddress, Amount: sdk.ZeroInt()}, res.FeeBalance, "fee balance before query")
+
+	// issue the balance queries and answer them through the registered callbacks: the withdrawal account is empty
+	// (the host sends back no value), so it isn't swept
+	callbacks := s.App.StakeibcKeeper.CallbackHandler().RegisterCallbacks()
+	answerBalanceQuery := func(address string, callbackId string, balance *sdk.Coin, height int64) {
+		_, addr, err := bech32.DecodeAndConvert(address)
+		s.Require().NoError(err)
+		request := icqtypes.NewBalanceRequest(addr, atom)
+		queryId := icqkeeper.GenerateQueryHash(hostZone.ConnectionId, hostZone.ChainId, icqtypes.BANK_STORE_QUERY_WITH_PROOF, request, stakeibc.ModuleName, 0)
+		query, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, queryId)
+		s.Require().True(found, "%s query issued", callbackId)
+		s.Require().Equal(callbackId, query.CallbackId)
+		var response []byte
+		if balance != nil {
+			response = s.App.AppCodec().MustMarshal(balance)
+		}
+		s.Require().NoError(callbacks.Call(s.Ctx, callbackId, response, query, height))
+	}
+	s.Require().NoError(s.App.StakeibcKeeper.UpdateWithdrawalBalance(s.Ctx, hostZone))
+	s.Require().NoError(s.App.StakeibcKeeper.UpdateFeeBalance(s.Ctx, hostZone))
+	answerBalanceQuery(withdrawalAddress, "withdrawalbalance", nil, 123)
+	feeBalance := sdk.NewInt64Coin(atom, 42)
+	answerBalanceQuery(feeAddress, "feebalance", &feeBalance, 124)
+
+	res, err = s.App.StakeibcKeeper.HostZoneAccounting(sdk.WrapSDKContext(s.Ctx), &stakeibc.QueryHostZoneAccountingRequest{ChainId: "GAIA"})
+	s.Require().NoError(err)
+
+	s.Require().Equal(int64(100), res.ModuleAccountBalance, "module account balance")
+	s.Require().Equal(int64(500), res.UndelegatedBalance, "undelegated balance")
+	s.Require().Equal(uint64(5_000), res.StakedBalance, "staked balance")
+	s.Require().Equal(sdk.NewInt(7_000), res.StSupply, "st supply")
+	s.Require().Equal(sdk.NewInt(5_600), res.Tvl, "tvl")
+	s.Require().Equal(uint64(8), res.ClaimableAmount, "claimable amount")
+	s.Require().Equal([]stakeibc.UnbondingAmount{
+		{Status: recordtypes.HostZoneUnbonding_BONDED, NativeTokenAmount: 20, StTokenAmount: 19},
+		{Status: recordtypes.HostZoneUnbonding_UNBONDED, NativeTokenAmount: 10, StTokenAmount: 10},
+		{Status: recordtypes.HostZoneUnbonding_TRANSFERRED, NativeTokenAmount: 0, StTokenAmount: 0},
+		{Status: recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS, NativeTokenAmount: 0, StTokenAmount: 0},
+	}, res.UnbondingAmounts, "unbonding amounts")
+	s.Require().Equal(stakeibc.IcaBalance{Address: withdrawalAddress, Amount: sdk.ZeroInt(), RemoteHeight: 123}, res.WithdrawalBalance, "withdrawal balance")
+	s.Require().Equal(stakeibc.IcaBalance{Address: feeAddress, Amount: sdk.NewInt(42), RemoteHeight: 124}, res.FeeBalance, "fee balance")
+
+	_, err = s.App.StakeibcKeeper.HostZoneAccounting(sdk.WrapSDKContext(s.Ctx), &stakeibc.QueryHostZoneAccountingRequest{ChainId: "OSMO"})
+	s.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (k Keeper) UnbondingSchedule(c context.Context, req *types.QueryUnbondingScheduleRequest) (*types.QueryUnbondingScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	count := req.Count
+	if count == 0 {
+		count = 1
+	}
+	if count > epochstypes.MaxEpochScheduleCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count cannot exceed %d", epochstypes.MaxEpochScheduleCount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	hostZones := []types.HostZone{}
+	if req.ChainId == "" {
+		hostZones = k.GetAllHostZone(ctx)
+	} else {
+		hostZone, found := k.GetHostZone(ctx, req.ChainId)
+		if !found {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+		hostZones = append(hostZones, hostZone)
+	}
+
+	schedules := []types.HostZoneUnbondingSchedule{}
+	for _, hostZone := range hostZones {
+		schedule := types.HostZoneUnbondingSchedule{
+			ChainId:            hostZone.ChainId,
+			UnbondingFrequency: hostZone.UnbondingFrequency,
+			UpcomingUnbondings: []epochstypes.UpcomingEpoch{},
+		}
+		upcomingUnbondings, err := k.GetUpcomingUnbondings(ctx, hostZone, count)
+		switch {
+		case err == nil:
+			schedule.UpcomingUnbondings = upcomingUnbondings
+		case req.ChainId == "" && errors.Is(err, types.ErrInvalidUnbondingFrequency):
+			// one misconfigured host zone doesn't hide the schedules of the others
+			schedule.Error = err.Error()
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return &types.QueryUnbondingScheduleResponse{Schedules: schedules}, nil
+}
+
+// GetUpcomingUnbondings returns the next day epochs a host zone's unbondings are sent in, which are the days whose
+// number is a multiple of its unbonding frequency (see InitiateAllHostZoneUnbondings)
+func (k Keeper) GetUpcomingUnbondings(ctx sdk.Context, hostZone types.HostZone, count uint64) ([]epochstypes.UpcomingEpoch, error) {
+	if hostZone.UnbondingFrequency == 0 || hostZone.UnbondingFrequency > math.MaxInt64 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidUnbondingFrequency, "unbonding frequency %d of host zone %s",
+			hostZone.UnbondingFrequency, hostZone.ChainId)
+	}
+	dayEpoch, found := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.DAY_EPOCH)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrEpochNotFound, "no epoch info for epoch (%s)", epochstypes.DAY_EPOCH)
+	}
+
+	frequency := int64(hostZone.UnbondingFrequency)
+	upcomingUnbondings := []epochstypes.UpcomingEpoch{}
+	for day := (dayEpoch.CurrentEpoch/frequency + 1) * frequency; uint64(len(upcomingUnbondings)) < count; day += frequency {
+		upcoming, err := k.epochsKeeper.EstimateEpochStart(ctx, epochstypes.DAY_EPOCH, day)
+		if err != nil {
+			return nil, err
+		}
+		upcomingUnbondings = append(upcomingUnbondings, upcoming)
+	}
+	return upcomingUnbondings, nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+	stakeibc "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestUnbondingSchedule() {
+	now := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.Ctx.WithBlockHeight(1_000).WithBlockTime(now)
+	s.App.EpochsKeeper.SetEpochInfo(ctx, epochstypes.EpochInfo{
+		Identifier:              epochstypes.DAY_EPOCH,
+		StartTime:               now.Add(-7 * 24 * time.Hour),
+		Duration:                24 * time.Hour,
+		CurrentEpoch:            7,
+		CurrentEpochStartTime:   now.Add(-10 * time.Minute),
+		CurrentEpochStartHeight: 900,
+		EpochCountingStarted:    true,
+	})
+	s.App.StakeibcKeeper.SetHostZone(ctx, stakeibc.HostZone{ChainId: "GAIA", UnbondingFrequency: 3})
+	s.App.StakeibcKeeper.SetHostZone(ctx, stakeibc.HostZone{ChainId: "OSMO", UnbondingFrequency: 1})
+
+	res, err := s.App.StakeibcKeeper.UnbondingSchedule(sdk.WrapSDKContext(ctx), &stakeibc.QueryUnbondingScheduleRequest{ChainId: "GAIA", Count: 2})
+	s.Require().NoError(err)
+	s.Require().Equal([]stakeibc.HostZoneUnbondingSchedule{{
+		ChainId:            "GAIA",
+		UnbondingFrequency: 3,
+		UpcomingUnbondings: []epochstypes.UpcomingEpoch{
+			{EpochNumber: 9, StartTime: now.Add(2*24*time.Hour - 10*time.Minute), EstimatedStartHeight: 1_000 + 28_700},
+			{EpochNumber: 12, StartTime: now.Add(5*24*time.Hour - 10*time.Minute), EstimatedStartHeight: 1_000 + 71_900},
+		},
+	}}, res.Schedules)
+
+	res, err = s.App.StakeibcKeeper.UnbondingSchedule(sdk.WrapSDKContext(ctx), &stakeibc.QueryUnbondingScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Schedules, 2)
+	s.Require().Equal("OSMO", res.Schedules[1].ChainId)
+	s.Require().Equal(int64(8), res.Schedules[1].UpcomingUnbondings[0].EpochNumber)
+
+	// a host zone without an unbonding frequency is flagged, but doesn't fail the query of every host zone
+	s.App.StakeibcKeeper.SetHostZone(ctx, stakeibc.HostZone{ChainId: "JUNO"})
+	res, err = s.App.StakeibcKeeper.UnbondingSchedule(sdk.WrapSDKContext(ctx), &stakeibc.QueryUnbondingScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Schedules, 3)
+	s.Require().Equal("JUNO", res.Schedules[1].ChainId)
+	s.Require().Empty(res.Schedules[1].UpcomingUnbondings)
+	s.Require().Contains(res.Schedules[1].Error, "invalid unbonding frequency")
+	s.Require().Empty(res.Schedules[2].Error)
+	s.App.StakeibcKeeper.RemoveHostZone(ctx, "JUNO")
+
+	_, err = s.App.StakeibcKeeper.UnbondingSchedule(sdk.WrapSDKContext(ctx), &stakeibc.QueryUnbondingScheduleRequest{ChainId: "JUNO"})
+	s.Require().Error(err)
+
+	_, err = s.App.StakeibcKeeper.GetUpcomingUnbondings(ctx, stakeibc.HostZone{ChainId: "JUNO"}, 1)
+	s.Require().ErrorIs(err, stakeibc.ErrInvalidUnbondingFrequency)
+}
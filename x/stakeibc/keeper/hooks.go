@@ -2,9 +2,9 @@ package keeper
 
 import (
 	"fmt"
-	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ibctypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/spf13/cast"
@@ -16,22 +16,13 @@ import (
 )
 
 // TODO [TEST-127]: ensure all timeouts are less than the epoch length
-// TODO [TEST-126]: add events from event manager, e.g.
-// ctx.EventManager().EmitEvents(sdk.Events{
-// 	sdk.NewEvent(
-// 		sdk.EventTypeMessage,
-// 		sdk.NewAttribute("hostZone", zoneInfo.ChainId),
-// 		sdk.NewAttribute("newAmountStaked", balance.String()),
-// 	),
-// })
-
-func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
+
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
 	// every epoch
 	epochIdentifier := epochInfo.Identifier
 	epochNumber, err := cast.ToUint64E(epochInfo.CurrentEpoch)
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Could not convert epoch number to uint64: %v", err))
-		return
+		return sdkerrors.Wrap(err, "could not convert epoch number to uint64")
 	}
 
 	k.Logger(ctx).Info(fmt.Sprintf("Handling epoch start %s %d", epochIdentifier, epochNumber))
@@ -39,13 +30,11 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 
 	ns, err := cast.ToUint64E(epochInfo.GetDuration().Nanoseconds())
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Could not convert epoch duration to uint64: %v", err))
-		return
+		return sdkerrors.Wrap(err, "could not convert epoch duration to uint64")
 	}
 	nextEpochStartTime, err := cast.ToUint64E(epochInfo.GetCurrentEpochStartTime().Add(epochInfo.GetDuration()).UnixNano())
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Could not convert epoch duration to uint64: %v", err))
-		return
+		return sdkerrors.Wrap(err, "could not convert epoch duration to uint64")
 	}
 	epochTracker := types.EpochTracker{
 		EpochIdentifier:    epochIdentifier,
@@ -62,15 +51,21 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		// here, we process everything we need to for redemptions
 		k.Logger(ctx).Info(fmt.Sprintf("Day %d Beginning", epochNumber))
 		// first we initiate unbondings from any hostZone where it's appropriate
-		k.Logger(ctx).Info("InitiateAllHostZoneUnbondings")
-		k.InitiateAllHostZoneUnbondings(ctx, epochNumber)
+		k.RunEpochStep(ctx, "InitiateAllHostZoneUnbondings", func(ctx sdk.Context) error {
+			k.InitiateAllHostZoneUnbondings(ctx, epochNumber)
+			return nil
+		})
 		// then we check previous epochs to see if unbondings finished, and sweep the tokens if so
-		k.Logger(ctx).Info("SweepAllUnbondedTokens")
-		k.SweepAllUnbondedTokens(ctx)
+		k.RunEpochStep(ctx, "SweepAllUnbondedTokens", func(ctx sdk.Context) error {
+			k.SweepAllUnbondedTokens(ctx)
+			return nil
+		})
 		// then we cleanup any records that are no longer needed
-		k.Logger(ctx).Info("CleanupEpochUnbondingRecords")
-		k.CleanupEpochUnbondingRecords(ctx)
-		// lastly we create an empty unbonding record for this epoch
+		k.RunEpochStep(ctx, "CleanupEpochUnbondingRecords", func(ctx sdk.Context) error {
+			k.CleanupEpochUnbondingRecords(ctx)
+			return nil
+		})
+		// lastly we create an empty unbonding record for this epoch, which isn't isolated as it *must* exist
 		k.Logger(ctx).Info("CreateEpochUnbondingRecord")
 		k.CreateEpochUnbondingRecord(ctx, epochNumber)
 	}
@@ -84,36 +79,46 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		// Imagine it will be slightly cleaner to track state by epoch, rather than
 		// by DepositInterval
 
-		// Create a new deposit record for each host zone for the upcoming epoch
+		// Create a new deposit record for each host zone for the upcoming epoch, which isn't isolated as it *must* exist
 		k.Logger(ctx).Info("CreateDepositRecordsForEpoch")
 		k.CreateDepositRecordsForEpoch(ctx, epochNumber)
 
-		k.Logger(ctx).Info("SetWithdrawalAddress")
-		k.SetWithdrawalAddress(ctx)
+		k.RunEpochStep(ctx, "RestoreClosedIcaChannels", func(ctx sdk.Context) error {
+			k.RestoreClosedIcaChannels(ctx)
+			return nil
+		})
+
+		k.RunEpochStep(ctx, "SetWithdrawalAddress", func(ctx sdk.Context) error {
+			k.SetWithdrawalAddress(ctx)
+			return nil
+		})
 
 		depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
 
 		// Update the redemption rate
-		redemptionRateInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyRedemptionRateInterval))
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not convert redemptionRateInterval to uint64: %v", err))
-			return
-		}
-		if epochNumber%redemptionRateInterval == 0 {
-			k.Logger(ctx).Info("Triggering update redemption rate")
-			k.UpdateRedemptionRates(ctx, depositRecords)
-		}
+		k.RunEpochStep(ctx, "UpdateRedemptionRates", func(ctx sdk.Context) error {
+			redemptionRateInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyRedemptionRateInterval))
+			if err != nil {
+				return sdkerrors.Wrap(err, "could not convert redemptionRateInterval to uint64")
+			}
+			if epochNumber%redemptionRateInterval == 0 {
+				k.Logger(ctx).Info("Triggering update redemption rate")
+				k.UpdateRedemptionRates(ctx, depositRecords)
+			}
+			return nil
+		})
 
-		depositInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyDepositInterval))
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not convert depositInterval to int64: %v", err))
-			return
-		}
-		if epochNumber%depositInterval == 0 {
-			// process previous deposit records
-			k.Logger(ctx).Info("TransferExistingDepositsToHostZones")
-			k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
-		}
+		k.RunEpochStep(ctx, "TransferExistingDepositsToHostZones", func(ctx sdk.Context) error {
+			depositInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyDepositInterval))
+			if err != nil {
+				return sdkerrors.Wrap(err, "could not convert depositInterval to int64")
+			}
+			if epochNumber%depositInterval == 0 {
+				// process previous deposit records
+				k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
+			}
+			return nil
+		})
 
 		// NOTE: the stake ICA timeout *must* be l.t. the staking epoch length, otherwise
 		// we could send a stake ICA call (which could succeed), without deleting the record.
@@ -126,52 +131,89 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		// records always accurately reflect the state of the controller / host chain by the next epoch.
 		// Put another way, all outstanding ICA calls / IBC transfers must be settled on the controller
 		// chain before the next epoch begins.
-		delegationInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyDelegateInterval))
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not convert delegationInterval to int64: %v", err))
-			return
-		}
-		if epochNumber%delegationInterval == 0 {
-			k.Logger(ctx).Info("StakeExistingDepositsOnHostZones")
-			k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
-		}
+		k.RunEpochStep(ctx, "StakeExistingDepositsOnHostZones", func(ctx sdk.Context) error {
+			delegationInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyDelegateInterval))
+			if err != nil {
+				return sdkerrors.Wrap(err, "could not convert delegationInterval to int64")
+			}
+			if epochNumber%delegationInterval == 0 {
+				k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
+			}
+			return nil
+		})
 
-		reinvestInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyReinvestInterval))
-		if err != nil {
-			k.Logger(ctx).Error(fmt.Sprintf("Could not convert reinvestInterval to int64: %v", err))
-			return
-		}
-		if epochNumber%reinvestInterval == 0 { // allow a few blocks from UpdateUndelegatedBal to avoid conflicts
-			k.Logger(ctx).Info("Reinvesting tokens")
-			for _, hz := range k.GetAllHostZone(ctx) {
-				// only process host zones once withdrawal accounts are registered
-				withdrawalIca := hz.GetWithdrawalAccount()
-				if withdrawalIca != nil {
-					// read clock time on host zone
-					blockTime, found := k.GetLightClientTimeSafely(ctx, hz.ConnectionId)
-					if !found {
-						k.Logger(ctx).Error(fmt.Sprintf("Could not find blockTime for host zone %s", hz.ConnectionId))
-						continue
-					} else {
-						k.Logger(ctx).Info(fmt.Sprintf("Found blockTime for host zone %s: %d", hz.ConnectionId, blockTime))
-					}
-
-					err := k.UpdateWithdrawalBalance(ctx, hz)
-					if err != nil {
-						k.Logger(ctx).Error(fmt.Sprintf("Error updating withdrawal balance for host zone %s: %s", hz.ConnectionId, err.Error()))
-						continue
-					} else {
-						k.Logger(ctx).Info(fmt.Sprintf("Updated withdrawal balance for host zone %s", hz.ConnectionId))
-					}
-				} else {
-					k.Logger(ctx).Info(fmt.Sprintf("Withdrawal account not registered for host zone %s", hz.ChainId))
+		k.RunEpochStep(ctx, "ReinvestRewards", func(ctx sdk.Context) error {
+			reinvestInterval, err := cast.ToUint64E(k.GetParam(ctx, types.KeyReinvestInterval))
+			if err != nil {
+				return sdkerrors.Wrap(err, "could not convert reinvestInterval to int64")
+			}
+			if epochNumber%reinvestInterval == 0 { // allow a few blocks from UpdateUndelegatedBal to avoid conflicts
+				k.ReinvestRewards(ctx)
+			}
+			return nil
+		})
+	}
+	return nil
+}
+
+// ReinvestRewards queries the withdrawal balance of each host zone, which reinvests the rewards in its callback
+func (k Keeper) ReinvestRewards(ctx sdk.Context) {
+	k.Logger(ctx).Info("Reinvesting tokens")
+	for _, hz := range k.GetAllHostZone(ctx) {
+		// only process host zones once withdrawal accounts are registered
+		withdrawalIca := hz.GetWithdrawalAccount()
+		if withdrawalIca != nil {
+			// read clock time on host zone
+			blockTime, found := k.GetLightClientTimeSafely(ctx, hz.ConnectionId)
+			if !found {
+				k.Logger(ctx).Error(fmt.Sprintf("Could not find blockTime for host zone %s", hz.ConnectionId))
+				continue
+			} else {
+				k.Logger(ctx).Info(fmt.Sprintf("Found blockTime for host zone %s: %d", hz.ConnectionId, blockTime))
+			}
+
+			err := k.UpdateWithdrawalBalance(ctx, hz)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Error updating withdrawal balance for host zone %s: %s", hz.ConnectionId, err.Error()))
+				continue
+			} else {
+				k.Logger(ctx).Info(fmt.Sprintf("Updated withdrawal balance for host zone %s", hz.ConnectionId))
+			}
+
+			// the fee balance is only queried for the host zone accounting
+			if hz.GetFeeAccount() != nil {
+				if err := k.UpdateFeeBalance(ctx, hz); err != nil {
+					k.Logger(ctx).Error(fmt.Sprintf("Error updating fee balance for host zone %s: %s", hz.ConnectionId, err.Error()))
 				}
 			}
+		} else {
+			k.Logger(ctx).Info(fmt.Sprintf("Withdrawal account not registered for host zone %s", hz.ChainId))
 		}
 	}
 }
 
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
+// RunEpochStep runs one step of the epoch hook in a cached context. If the step returns an error or panics, only its
+// own writes are discarded: the epoch tracker and records set outside of the steps are kept, and the next steps still run
+func (k Keeper) RunEpochStep(ctx sdk.Context, name string, step func(ctx sdk.Context) error) {
+	k.Logger(ctx).Info(name)
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return step(cacheCtx)
+	}()
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Epoch step %s failed | %s", name, err.Error()))
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
 	// every epoch
 	epochIdentifier := epochInfo.Identifier
 	epochNumber := epochInfo.CurrentEpoch
@@ -179,6 +221,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo)
 	if epochIdentifier == "day" {
 		k.Logger(ctx).Info(fmt.Sprintf("Day %d Ending", epochNumber))
 	}
+	return nil
 }
 
 // Hooks wrapper struct for incentives keeper
@@ -193,12 +236,19 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // epochs hooks
-func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
-	h.k.BeforeEpochStart(ctx, epochInfo)
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	return h.k.BeforeEpochStart(ctx, epochInfo)
 }
 
-func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) {
-	h.k.AfterEpochEnd(ctx, epochInfo)
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochInfo epochstypes.EpochInfo) error {
+	return h.k.AfterEpochEnd(ctx, epochInfo)
+}
+
+var _ epochstypes.EpochDependency = Keeper{}
+
+// EpochIdentifiersInUse returns the epochs that deposits, delegations and unbondings are processed on
+func (k Keeper) EpochIdentifiersInUse(ctx sdk.Context) []string {
+	return []string{epochstypes.STRIDE_EPOCH, epochstypes.DAY_EPOCH}
 }
 
 // -------------------- helper functions --------------------
@@ -260,27 +310,50 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 			amt, err := sdk.ParseCoinNormalized(processAmount)
 			if err != nil {
 				k.Logger(ctx).Error(fmt.Sprintf("Could not process coin %v: %v", hostZone.HostDenom, err.Error()))
-				return
+				continue
 			}
 			err = k.DelegateOnHost(ctx, hostZone, amt, depositRecord.Id)
 			if err != nil {
 				k.Logger(ctx).Error(fmt.Sprintf("Did not stake %s on %s | err: %s", processAmount, hostZone.ChainId, err.Error()))
-				return
+				continue
 			} else {
 				k.Logger(ctx).Info(fmt.Sprintf("Successfully submitted stake for %s on %s", processAmount, hostZone.ChainId))
 			}
-
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					sdk.EventTypeMessage,
-					sdk.NewAttribute("hostZone", hostZone.ChainId),
-					sdk.NewAttribute("newAmountStaked", strconv.FormatInt(depositRecord.Amount, 10)),
-				),
-			)
+			// the record is skipped by later epochs until the delegation callback removes it, or the retry queue releases it
+			oldStatus := depositRecord.Status
+			depositRecord.Status = recordstypes.DepositRecord_DELEGATION_IN_PROGRESS
+			k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+			k.EmitDepositRecordStatusChange(ctx, depositRecord, oldStatus, false)
+
+			err = ctx.EventManager().EmitTypedEvent(&types.EventStakeDeposit{
+				HostZone:        hostZone.ChainId,
+				DepositRecordId: depositRecord.Id,
+				Amount:          depositRecord.Amount,
+			})
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Failed to emit stake deposit event | %s", err.Error()))
+				continue
+			}
 		}
 	}
 }
 
+// EmitDepositRecordStatusChange emits the status change or removal of a deposit record. The change has already been
+// made, so an error emitting the event is only logged
+func (k Keeper) EmitDepositRecordStatusChange(ctx sdk.Context, depositRecord recordstypes.DepositRecord, oldStatus recordstypes.DepositRecord_Status, removed bool) {
+	err := ctx.EventManager().EmitTypedEvent(&recordstypes.EventDepositRecordStatusChange{
+		DepositRecordId: depositRecord.Id,
+		HostZone:        depositRecord.HostZoneId,
+		Amount:          depositRecord.Amount,
+		OldStatus:       oldStatus,
+		NewStatus:       depositRecord.Status,
+		Removed:         removed,
+	})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Failed to emit deposit record %d status change event | %s", depositRecord.Id, err.Error()))
+	}
+}
+
 func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber uint64, depositRecords []recordstypes.DepositRecord) {
 	transferDepositRecords := utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
 		return record.Status == recordstypes.DepositRecord_TRANSFER
@@ -321,15 +394,14 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 			}
 			timeoutHeight := clienttypes.NewHeight(0, blockHeight+ibcTimeoutBlocks)
 			transferCoin := sdk.NewCoin(hostZone.GetIBCDenom(), sdk.NewInt(depositRecord.Amount))
-			goCtx := sdk.WrapSDKContext(ctx)
 
 			msg := ibctypes.NewMsgTransfer("transfer", hostZone.TransferChannelId, transferCoin, addr, delegateAddress, timeoutHeight, 0)
 			k.Logger(ctx).Info(fmt.Sprintf("TransferExistingDepositsToHostZones msg %v", msg))
-			_, err := k.TransferKeeper.Transfer(goCtx, msg)
+			err := k.RecordsKeeper.Transfer(ctx, msg, depositRecord.Id)
 			if err != nil {
 				k.Logger(ctx).Error(fmt.Sprintf("\t[TransferExistingDepositsToHostZones] ERROR WITH DEPOSIT RECEIPT %s %v %s %s %v", hostZone.TransferChannelId, transferCoin, addr, delegateAddress, timeoutHeight))
 				k.Logger(ctx).Error(fmt.Sprintf("\t[TransferExistingDepositsToHostZones] err {%s}", err.Error()))
-				return
+				continue
 			}
 		}
 	}
@@ -380,6 +452,18 @@ func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordst
 		zoneInfo.RedemptionRate = redemptionRate
 		k.SetHostZone(ctx, zoneInfo)
 
+		err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionRateUpdate{
+			HostZone:             zoneInfo.ChainId,
+			OldRedemptionRate:    zoneInfo.LastRedemptionRate,
+			NewRedemptionRate:    redemptionRate,
+			UndelegatedBalance:   undelegatedBalance,
+			StakedBalance:        stakedBalance,
+			ModuleAccountBalance: moduleAcctBalance,
+			StSupply:             stSupply,
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Failed to emit redemption rate update event for %s | %s", zoneInfo.ChainId, err.Error()))
+		}
 		return nil
 	}
 	// Iterate the zones and apply icaReinvest
@@ -387,9 +471,10 @@ func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordst
 }
 
 func (k Keeper) GetUndelegatedBalance(hostZone types.HostZone, depositRecords []recordstypes.DepositRecord) (int64, error) {
-	// filter to only the deposit records for the host zone with status STAKE
+	// filter to only the deposit records for the host zone that are staking or being delegated
 	UndelegatedDepositRecords := utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
-		return record.Status == recordstypes.DepositRecord_STAKE && record.HostZoneId == hostZone.ChainId
+		isUndelegated := record.Status == recordstypes.DepositRecord_STAKE || record.Status == recordstypes.DepositRecord_DELEGATION_IN_PROGRESS
+		return isUndelegated && record.HostZoneId == hostZone.ChainId
 	})
 
 	// sum the amounts of the deposit records
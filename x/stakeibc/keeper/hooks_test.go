@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestRunEpochStep() {
+	s.App.StakeibcKeeper.RunEpochStep(s.Ctx, "failed", func(ctx sdk.Context) error {
+		s.App.StakeibcKeeper.SetHostZone(ctx, types.HostZone{ChainId: "FAILED"})
+		return errors.New("step failed")
+	})
+	s.App.StakeibcKeeper.RunEpochStep(s.Ctx, "panicked", func(ctx sdk.Context) error {
+		s.App.StakeibcKeeper.SetHostZone(ctx, types.HostZone{ChainId: "PANICKED"})
+		panic("step panicked")
+	})
+	s.App.StakeibcKeeper.RunEpochStep(s.Ctx, "succeeded", func(ctx sdk.Context) error {
+		s.App.StakeibcKeeper.SetHostZone(ctx, types.HostZone{ChainId: "SUCCEEDED"})
+		return nil
+	})
+
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, "FAILED")
+	s.Require().False(found, "writes of the failed step discarded")
+	_, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, "PANICKED")
+	s.Require().False(found, "writes of the panicked step discarded")
+	_, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, "SUCCEEDED")
+	s.Require().True(found, "writes of the succeeded step kept")
+}
+
+func (s *KeeperTestSuite) TestBeforeEpochStartKeepsTrackerWhenStepFails() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: "GAIA", HostDenom: "uatom"})
+	// a zero delegate interval makes the stake step panic
+	s.App.GetSubspace(types.ModuleName).Set(s.Ctx, types.KeyDelegateInterval, uint64(0))
+
+	s.App.EpochsKeeper.BeforeEpochStart(s.Ctx, epochstypes.EpochInfo{
+		Identifier:            epochstypes.STRIDE_EPOCH,
+		CurrentEpoch:          3,
+		CurrentEpochStartTime: time.Unix(1_000_000, 0),
+		Duration:              time.Hour,
+	})
+
+	epochTracker, found := s.App.StakeibcKeeper.GetEpochTracker(s.Ctx, epochstypes.STRIDE_EPOCH)
+	s.Require().True(found, "epoch tracker set")
+	s.Require().Equal(uint64(3), epochTracker.EpochNumber, "epoch number")
+
+	depositRecords := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
+	s.Require().Len(depositRecords, 1, "deposit record created")
+	s.Require().Equal(uint64(3), depositRecords[0].DepositEpochNumber, "deposit record epoch")
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// SetIcaBalance stores the last balance of a host zone's ICA reported by an interchain query
+func (k Keeper) SetIcaBalance(ctx sdk.Context, chainId string, accountType types.ICAAccountType, balance types.IcaBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaBalanceKeyPrefix))
+	b := k.cdc.MustMarshal(&balance)
+	store.Set(types.IcaBalanceKey(chainId, accountType), b)
+}
+
+// GetIcaBalance returns the last balance of a host zone's ICA reported by an interchain query
+func (k Keeper) GetIcaBalance(ctx sdk.Context, chainId string, accountType types.ICAAccountType) (val types.IcaBalance, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaBalanceKeyPrefix))
+	b := store.Get(types.IcaBalanceKey(chainId, accountType))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllIcaBalance returns the last balances of all host zone ICAs, along with the host zone and account type they
+// are stored under
+func (k Keeper) GetAllIcaBalance(ctx sdk.Context) (list []types.HostZoneIcaBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IcaBalanceKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is chainId/accountType/
+		key := strings.TrimSuffix(string(iterator.Key()), "/")
+		separator := strings.LastIndex(key, "/")
+		accountType, found := types.ICAAccountType_value[key[separator+1:]]
+		if separator < 0 || !found {
+			panic(fmt.Sprintf("invalid IcaBalance key %s", iterator.Key()))
+		}
+
+		var val types.IcaBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, types.HostZoneIcaBalance{
+			ChainId:     key[:separator],
+			AccountType: types.ICAAccountType(accountType),
+			Balance:     val,
+		})
+	}
+
+	return
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// IcaAccountTypes lists the ICA account types in a fixed order, as channels restored in the same block must be
+// registered in the same order on every validator
+var IcaAccountTypes = []types.ICAAccountType{
+	types.ICAAccountType_DELEGATION,
+	types.ICAAccountType_FEE,
+	types.ICAAccountType_WITHDRAWAL,
+	types.ICAAccountType_REDEMPTION,
+}
+
+// GetIcaAccount returns the host zone's ICA account of the given type, or nil if it has not been registered
+func GetIcaAccount(hostZone types.HostZone, accountType types.ICAAccountType) *types.ICAAccount {
+	switch accountType {
+	case types.ICAAccountType_DELEGATION:
+		return hostZone.DelegationAccount
+	case types.ICAAccountType_FEE:
+		return hostZone.FeeAccount
+	case types.ICAAccountType_WITHDRAWAL:
+		return hostZone.WithdrawalAccount
+	case types.ICAAccountType_REDEMPTION:
+		return hostZone.RedemptionAccount
+	}
+	return nil
+}
+
+// GetHostZoneFromIcaPort returns the host zone and ICA account type that own a controller port
+func (k Keeper) GetHostZoneFromIcaPort(ctx sdk.Context, portId string) (types.HostZone, types.ICAAccountType, bool) {
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		for _, accountType := range IcaAccountTypes {
+			icaPortId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, accountType))
+			if err == nil && icaPortId == portId {
+				return hostZone, accountType, true
+			}
+		}
+	}
+	return types.HostZone{}, 0, false
+}
+
+// GetIcaChannelState returns the state of the active channel for a host zone's ICA account
+func (k Keeper) GetIcaChannelState(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) (string, channeltypes.State, bool) {
+	portId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, accountType))
+	if err != nil {
+		return "", channeltypes.UNINITIALIZED, false
+	}
+	channelId, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, hostZone.ConnectionId, portId)
+	if !found {
+		return "", channeltypes.UNINITIALIZED, false
+	}
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portId, channelId)
+	if !found {
+		return channelId, channeltypes.UNINITIALIZED, false
+	}
+	return channelId, channel.State, true
+}
+
+// IsHostZoneDegraded returns true if any registered ICA account on the host zone is missing an open channel,
+// ignoring the port passed in (which is used when that port's channel is mid-handshake)
+func (k Keeper) IsHostZoneDegraded(ctx sdk.Context, hostZone types.HostZone, ignoredPortId string) bool {
+	for _, accountType := range IcaAccountTypes {
+		if GetIcaAccount(hostZone, accountType) == nil {
+			continue
+		}
+		portId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, accountType))
+		if err != nil || portId == ignoredPortId {
+			continue
+		}
+		_, state, found := k.GetIcaChannelState(ctx, hostZone, accountType)
+		if !found || state != channeltypes.OPEN {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIcaAccountDegraded returns true if the host zone's ICA account is being restored or is missing an open channel
+func (k Keeper) IsIcaAccountDegraded(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) bool {
+	if isRecoveringAccount(hostZone, accountType) {
+		return true
+	}
+	_, state, found := k.GetIcaChannelState(ctx, hostZone, accountType)
+	return !found || state != channeltypes.OPEN
+}
+
+// RestoreClosedIcaChannels reopens the controller channel for each registered ICA account whose active channel
+// has been closed (e.g. after a packet timeout on the ordered channel). The ICA is re-registered with the same owner
+// so the host address is preserved, the zone is marked degraded until the new channel opens, and any callbacks
+// that were still pending on the closed channel are recorded in an event.
+func (k Keeper) RestoreClosedIcaChannels(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		updated := false
+		for _, accountType := range IcaAccountTypes {
+			if GetIcaAccount(hostZone, accountType) == nil {
+				continue
+			}
+			closedChannelId, state, found := k.GetIcaChannelState(ctx, hostZone, accountType)
+			if !found || state != channeltypes.CLOSED {
+				continue
+			}
+			// a restore has already been initiated, wait for the handshake to complete
+			if isRecoveringAccount(hostZone, accountType) {
+				continue
+			}
+
+			k.Logger(ctx).Info(fmt.Sprintf("ICA channel %s for %s on %s is closed, restoring", closedChannelId, accountType.String(), hostZone.ChainId))
+			err := k.RestoreIcaChannel(ctx, hostZone, accountType, closedChannelId)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to restore %s ICA on %s | %s", accountType.String(), hostZone.ChainId, err.Error()))
+				continue
+			}
+			hostZone.RecoveringAccounts = append(hostZone.RecoveringAccounts, accountType)
+			hostZone.Degraded = true
+			updated = true
+		}
+		if updated {
+			k.SetHostZone(ctx, hostZone)
+		}
+	}
+}
+
+// RestoreIcaChannel re-registers an ICA whose channel has closed and records the callbacks lost with the channel
+func (k Keeper) RestoreIcaChannel(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType, closedChannelId string) error {
+	owner := types.FormatICAAccountOwner(hostZone.ChainId, accountType)
+	portId, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	// register in a cached context so that a failed channel open doesn't leave partial state behind
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.ICAControllerKeeper.RegisterInterchainAccount(cacheCtx, hostZone.ConnectionId, owner); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	lostCallbacks := []types.PendingIcaCallback{}
+	for _, callbackData := range k.ICACallbacksKeeper.GetAllCallbackData(ctx) {
+		if callbackData.PortId == portId && callbackData.ChannelId == closedChannelId {
+			k.Logger(ctx).Error(fmt.Sprintf("Callback %s (%s) was pending when channel %s closed", callbackData.CallbackKey, callbackData.CallbackId, closedChannelId))
+			lostCallbacks = append(lostCallbacks, types.PendingIcaCallback{
+				CallbackKey: callbackData.CallbackKey,
+				CallbackId:  callbackData.CallbackId,
+				Sequence:    callbackData.Sequence,
+			})
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIcaChannelRecovery{
+		ChainId:         hostZone.ChainId,
+		AccountType:     accountType,
+		ClosedChannelId: closedChannelId,
+		LostCallbacks:   lostCallbacks,
+	})
+}
+
+func isRecoveringAccount(hostZone types.HostZone, accountType types.ICAAccountType) bool {
+	for _, recoveringAccount := range hostZone.RecoveringAccounts {
+		if recoveringAccount == accountType {
+			return true
+		}
+	}
+	return false
+}
+
+// CompleteIcaRecovery clears the recovery state for an ICA once its new channel has been acknowledged
+func (k Keeper) CompleteIcaRecovery(ctx sdk.Context, hostZone *types.HostZone, accountType types.ICAAccountType, portId string) {
+	recoveringAccounts := []types.ICAAccountType{}
+	for _, recoveringAccount := range hostZone.RecoveringAccounts {
+		if recoveringAccount != accountType {
+			recoveringAccounts = append(recoveringAccounts, recoveringAccount)
+		}
+	}
+	hostZone.RecoveringAccounts = recoveringAccounts
+	hostZone.Degraded = k.IsHostZoneDegraded(ctx, *hostZone, portId)
+}
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/gogo/protobuf/proto"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+type IcaRecoveryTestCase struct {
+	hostZone        types.HostZone
+	portId          string
+	closedChannelId string
+}
+
+func (s *KeeperTestSuite) SetupIcaRecovery() IcaRecoveryTestCase {
+	connectionId := "connection-0"
+	closedChannelId := "channel-0"
+
+	connection := connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN,
+		"07-tendermint-0",
+		connectiontypes.NewCounterparty("07-tendermint-0", connectionId, commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		[]*connectiontypes.Version{connectiontypes.DefaultIBCVersion},
+		0,
+	)
+	s.App.IBCKeeper.ConnectionKeeper.SetConnection(s.Ctx, connectionId, connection)
+
+	hostZone := types.HostZone{
+		ChainId:           "GAIA",
+		ConnectionId:      connectionId,
+		DelegationAccount: &types.ICAAccount{Address: "cosmos_DELEGATION", Target: types.ICAAccountType_DELEGATION},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	portId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, types.ICAAccountType_DELEGATION))
+	s.Require().NoError(err)
+
+	metadata := icatypes.NewMetadata(icatypes.Version, connectionId, connectionId, "", icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+	version, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+	s.Require().NoError(err)
+
+	closedChannel := channeltypes.NewChannel(
+		channeltypes.CLOSED,
+		channeltypes.ORDERED,
+		channeltypes.NewCounterparty(icatypes.PortID, closedChannelId),
+		[]string{connectionId},
+		string(version),
+	)
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, portId, closedChannelId, closedChannel)
+	s.App.IBCKeeper.ChannelKeeper.SetNextChannelSequence(s.Ctx, 1)
+	s.App.ICAControllerKeeper.SetActiveChannelID(s.Ctx, connectionId, portId, closedChannelId)
+
+	// a delegation that was in flight when the channel closed
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, icacallbackstypes.CallbackData{
+		CallbackKey: icacallbackstypes.PacketID(portId, closedChannelId, 4),
+		PortId:      portId,
+		ChannelId:   closedChannelId,
+		Sequence:    4,
+		CallbackId:  "delegate",
+	})
+
+	return IcaRecoveryTestCase{
+		hostZone:        hostZone,
+		portId:          portId,
+		closedChannelId: closedChannelId,
+	}
+}
+
+func (s *KeeperTestSuite) TestRestoreClosedIcaChannels() {
+	tc := s.SetupIcaRecovery()
+
+	s.App.StakeibcKeeper.RestoreClosedIcaChannels(s.Ctx)
+
+	// a new channel should have been opened on the same port
+	newChannel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx, tc.portId, "channel-1")
+	s.Require().True(found, "new channel created")
+	s.Require().Equal(channeltypes.INIT, newChannel.State, "new channel state")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
+	s.Require().True(found)
+	s.Require().True(hostZone.Degraded, "host zone degraded")
+	s.Require().Equal([]types.ICAAccountType{types.ICAAccountType_DELEGATION}, hostZone.RecoveringAccounts, "recovering accounts")
+
+	// epoch txs are held off until the channel is restored
+	_, err := s.App.StakeibcKeeper.SubmitTxsStrideEpoch(s.Ctx, hostZone.ConnectionId, nil, *hostZone.DelegationAccount, "", nil)
+	s.Require().ErrorIs(err, types.ErrHostZoneDegraded, "submit while degraded")
+
+	// the in flight callback should be recorded in the recovery event
+	var event *types.EventIcaChannelRecovery
+	for _, abciEvent := range s.Ctx.EventManager().ABCIEvents() {
+		if abciEvent.Type != proto.MessageName(&types.EventIcaChannelRecovery{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abciEvent)
+		s.Require().NoError(err)
+		event = typedEvent.(*types.EventIcaChannelRecovery)
+	}
+	s.Require().NotNil(event, "recovery event emitted")
+	s.Require().Equal(tc.closedChannelId, event.ClosedChannelId, "event closed channel")
+	s.Require().Len(event.LostCallbacks, 1, "event lost callbacks")
+	s.Require().Equal("delegate", event.LostCallbacks[0].CallbackId, "event lost callback id")
+
+	// a second pass should not open another channel while the first is mid-handshake
+	s.App.StakeibcKeeper.RestoreClosedIcaChannels(s.Ctx)
+	_, found = s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx, tc.portId, "channel-2")
+	s.Require().False(found, "no duplicate channel")
+}
+
+func (s *KeeperTestSuite) TestCompleteIcaRecovery() {
+	tc := s.SetupIcaRecovery()
+	s.App.StakeibcKeeper.RestoreClosedIcaChannels(s.Ctx)
+
+	// once the new channel is acked it becomes the active channel
+	s.App.ICAControllerKeeper.SetActiveChannelID(s.Ctx, tc.hostZone.ConnectionId, tc.portId, "channel-1")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
+	s.Require().True(found)
+	s.Require().True(hostZone.Degraded, "host zone degraded before recovery")
+	s.App.StakeibcKeeper.CompleteIcaRecovery(s.Ctx, &hostZone, types.ICAAccountType_DELEGATION, tc.portId)
+
+	s.Require().False(hostZone.Degraded, "host zone no longer degraded")
+	s.Require().Empty(hostZone.RecoveringAccounts, "recovering accounts")
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneFromIcaPort() {
+	tc := s.SetupIcaRecovery()
+
+	hostZone, accountType, found := s.App.StakeibcKeeper.GetHostZoneFromIcaPort(s.Ctx, tc.portId)
+	s.Require().True(found)
+	s.Require().Equal(tc.hostZone.ChainId, hostZone.ChainId, "host zone")
+	s.Require().Equal(types.ICAAccountType_DELEGATION, accountType, "account type")
+
+	_, _, found = s.App.StakeibcKeeper.GetHostZoneFromIcaPort(s.Ctx, "icacontroller-OSMO.DELEGATION")
+	s.Require().False(found, "unknown port")
+}
+
+func (s *KeeperTestSuite) TestIsIcaAccountDegraded() {
+	tc := s.SetupIcaRecovery()
+	s.App.StakeibcKeeper.RestoreClosedIcaChannels(s.Ctx)
+
+	// the fee account's channel is still open on the degraded zone
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.hostZone.ChainId)
+	s.Require().True(found)
+	hostZone.FeeAccount = &types.ICAAccount{Address: "cosmos_FEE", Target: types.ICAAccountType_FEE}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	feePortId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(hostZone.ChainId, types.ICAAccountType_FEE))
+	s.Require().NoError(err)
+	feeChannel := channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.ORDERED,
+		channeltypes.NewCounterparty(icatypes.PortID, "channel-5"),
+		[]string{hostZone.ConnectionId},
+		icatypes.Version,
+	)
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, feePortId, "channel-5", feeChannel)
+	s.App.ICAControllerKeeper.SetActiveChannelID(s.Ctx, hostZone.ConnectionId, feePortId, "channel-5")
+
+	s.Require().True(s.App.StakeibcKeeper.IsIcaAccountDegraded(s.Ctx, hostZone, types.ICAAccountType_DELEGATION), "delegation degraded")
+	s.Require().False(s.App.StakeibcKeeper.IsIcaAccountDegraded(s.Ctx, hostZone, types.ICAAccountType_FEE), "fee not degraded")
+
+	// only the account being restored is held off
+	_, err = s.App.StakeibcKeeper.SubmitTxsStrideEpoch(s.Ctx, hostZone.ConnectionId, nil, *hostZone.FeeAccount, "", nil)
+	s.Require().NotErrorIs(err, types.ErrHostZoneDegraded, "submit on open channel")
+}
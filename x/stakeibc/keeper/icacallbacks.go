@@ -1,10 +1,17 @@
 package keeper
 
 import (
+	"fmt"
+
 	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto"
 )
 
 const DELEGATE = "delegate"
@@ -14,7 +21,7 @@ const REINVEST = "reinvest"
 const REDEMPTION = "redemption"
 
 // ICACallbacks wrapper struct for stakeibc keeper
-type ICACallback func(Keeper, sdk.Context, channeltypes.Packet, *sdk.TxMsgData, []byte) error
+type ICACallback func(Keeper, sdk.Context, channeltypes.Packet, *icacallbackstypes.AcknowledgementResult, []byte) error
 
 type ICACallbacks struct {
 	k            Keeper
@@ -22,13 +29,14 @@ type ICACallbacks struct {
 }
 
 var _ icacallbackstypes.ICACallbackHandler = ICACallbacks{}
+var _ icacallbackstypes.CallbackExpiryHandler = ICACallbacks{}
 
 func (k Keeper) ICACallbackHandler() ICACallbacks {
 	return ICACallbacks{k, make(map[string]ICACallback)}
 }
 
-func (c ICACallbacks) CallICACallback(ctx sdk.Context, id string, packet channeltypes.Packet, txMsgData *sdk.TxMsgData, args []byte) error {
-	return c.icacallbacks[id](c.k, ctx, packet, txMsgData, args)
+func (c ICACallbacks) CallICACallback(ctx sdk.Context, id string, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult, args []byte) error {
+	return c.icacallbacks[id](c.k, ctx, packet, ackResult, args)
 }
 
 func (c ICACallbacks) HasICACallback(id string) bool {
@@ -50,3 +58,99 @@ func (c ICACallbacks) RegisterICACallbacks() icacallbackstypes.ICACallbackHandle
 		AddICACallback(REDEMPTION, ICACallback(RedemptionCallback))
 	return a.(ICACallbacks)
 }
+
+// DecodeCallbackArgs unmarshals the args of a stakeibc callback into their proto type
+func (c ICACallbacks) DecodeCallbackArgs(id string, args []byte) (proto.Message, error) {
+	var callbackArgs proto.Message
+	switch id {
+	case DELEGATE:
+		callbackArgs = &types.DelegateCallback{}
+	case CLAIM:
+		callbackArgs = &types.ClaimCallback{}
+	case UNDELEGATE:
+		callbackArgs = &types.UndelegateCallback{}
+	case REINVEST:
+		callbackArgs = &types.ReinvestCallback{}
+	case REDEMPTION:
+		callbackArgs = &types.RedemptionCallback{}
+	default:
+		return nil, sdkerrors.Wrapf(icacallbackstypes.ErrCallbackIdNotFound, "stakeibc has no callback %s", id)
+	}
+	if err := proto.Unmarshal(args, callbackArgs); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnmarshalFailure, "unable to unmarshal %s callback args: %s", id, err.Error())
+	}
+	return callbackArgs, nil
+}
+
+// ResubmitICATx sends a failed tx again from the host zone ICA that owns the port, using the same epoch timeout
+// as the original submission
+func (c ICACallbacks) ResubmitICATx(ctx sdk.Context, portId string, msgs []sdk.Msg, id string, args []byte) (uint64, error) {
+	hostZone, accountType, found := c.k.GetHostZoneFromIcaPort(ctx, portId)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidHostZone, "no host zone owns ICA port %s", portId)
+	}
+	account := GetIcaAccount(hostZone, accountType)
+	if account == nil || account.Address == "" {
+		return 0, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "host zone %s has no %s account", hostZone.ChainId, accountType.String())
+	}
+	// the retrier holds the tx off without counting an attempt until the channel is restored
+	if c.k.IsIcaAccountDegraded(ctx, hostZone, accountType) {
+		return 0, sdkerrors.Wrapf(icacallbackstypes.ErrIcaChannelUnavailable, "%s ICA on %s is being restored", accountType.String(), hostZone.ChainId)
+	}
+	if id == UNDELEGATE || id == REDEMPTION {
+		return c.k.SubmitTxsDayEpoch(ctx, hostZone.ConnectionId, msgs, *account, id, args)
+	}
+	return c.k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, *account, id, args)
+}
+
+// ReleaseICATx returns the records of a dropped tx to the state they were in before submission, so that the
+// epoch flows pick them up again
+func (c ICACallbacks) ReleaseICATx(ctx sdk.Context, id string, args []byte) error {
+	k := c.k
+	switch id {
+	case DELEGATE:
+		delegateCallback, err := k.UnmarshalDelegateCallbackArgs(ctx, args)
+		if err != nil {
+			return err
+		}
+		depositRecord, found := k.RecordsKeeper.GetDepositRecord(ctx, delegateCallback.DepositRecordId)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrRecordNotFound, "deposit record not found %d", delegateCallback.DepositRecordId)
+		}
+		oldStatus := depositRecord.Status
+		depositRecord.Status = recordstypes.DepositRecord_STAKE
+		k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+		k.EmitDepositRecordStatusChange(ctx, depositRecord, oldStatus, false)
+	case UNDELEGATE:
+		undelegateCallback, err := k.UnmarshalUndelegateCallbackArgs(ctx, args)
+		if err != nil {
+			return err
+		}
+		err = k.SetHostZoneUnbondingStatus(ctx, undelegateCallback.HostZoneId, undelegateCallback.EpochUnbondingRecordIds,
+			recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS, recordstypes.HostZoneUnbonding_BONDED)
+		if err != nil {
+			return err
+		}
+	case CLAIM:
+		claimCallback, err := k.UnmarshalClaimCallbackArgs(ctx, args)
+		if err != nil {
+			return err
+		}
+		userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, claimCallback.UserRedemptionRecordId)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrRecordNotFound, "user redemption record not found %s", claimCallback.UserRedemptionRecordId)
+		}
+		userRedemptionRecord.IsClaimable = true
+		k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+	default:
+		return nil
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("Released records of dropped %s tx", id))
+	return nil
+}
+
+// OnCallbackDataExpired releases the records of a tx whose ack never arrived. The callback data only expires once
+// the packet's commitment is gone or its channel is closed, so the host can no longer execute the tx
+func (c ICACallbacks) OnCallbackDataExpired(ctx sdk.Context, callbackData icacallbackstypes.CallbackData) error {
+	return c.ReleaseICATx(ctx, callbackData.CallbackId, callbackData.CallbackArgs)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,8 +30,8 @@ func (k Keeper) UnmarshalClaimCallbackArgs(ctx sdk.Context, claimCallback []byte
 	return &unmarshalledDelegateCallback, nil
 }
 
-func ClaimCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, txMsgData *sdk.TxMsgData, args []byte) error {
-	k.Logger(ctx).Info("ClaimCallback executing", "packet", packet, "txMsgData", txMsgData, "args", args)
+func ClaimCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult, args []byte) error {
+	k.Logger(ctx).Info("ClaimCallback executing", "packet", packet, "status", ackResult.Status.String(), "args", args)
 	// deserialize the args
 	claimCallback, err := k.UnmarshalClaimCallbackArgs(ctx, args)
 	if err != nil {
@@ -42,13 +43,11 @@ func ClaimCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, txMsgD
 		return sdkerrors.Wrapf(types.ErrRecordNotFound, "user redemption record not found %s", claimCallback.GetUserRedemptionRecordId())
 	}
 
-	if txMsgData == nil || len(txMsgData.Data) == 0 {
-		k.Logger(ctx).Error(fmt.Sprintf("ClaimCallback failed or timed out, txMsgData is nil or empty, packet %v", packet))
-		// transaction on the host chain failed
-		// set UserClaimRecord as claimable
-		userClaimRecord.IsClaimable = true
-		k.RecordsKeeper.SetUserRedemptionRecord(ctx, userClaimRecord)
-		return nil
+	if ackResult.Status != icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Error(fmt.Sprintf("ClaimCallback %s (%s), packet %v", ackResult.Status.String(), ackResult.Error, packet))
+		// transaction on the host chain failed, the record stays unclaimable while the retry queue resubmits the tx
+		// and is made claimable again if the tx is dropped
+		return sdkerrors.Wrapf(icacallbackstypes.ErrRetryableTx, "claim of %s failed or timed out", userClaimRecord.Id)
 	}
 
 	// claim successfully processed
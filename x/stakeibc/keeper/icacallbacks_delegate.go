@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cast"
 
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,17 +32,16 @@ func (k Keeper) UnmarshalDelegateCallbackArgs(ctx sdk.Context, delegateCallback
 	return &unmarshalledDelegateCallback, nil
 }
 
-func DelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, txMsgData *sdk.TxMsgData, args []byte) error {
+func DelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult, args []byte) error {
 	k.Logger(ctx).Info("DelegateCallback executing", "packet", packet)
 
-	if txMsgData == nil {
-		// timeout
-		k.Logger(ctx).Error(fmt.Sprintf("DelegateCallback timeout, ack is nil, packet %v", packet))
-		return nil
-	} else if len(txMsgData.Data) == 0 {
-		// failed transaction
-		k.Logger(ctx).Error(fmt.Sprintf("DelegateCallback tx failed, txMsgData is empty (ack error), packet %v", packet))
-		return nil
+	// the deposit record stays in DELEGATION_IN_PROGRESS while the retry queue resubmits the tx
+	if ackResult.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
+		k.Logger(ctx).Error(fmt.Sprintf("DelegateCallback timeout, packet %v", packet))
+		return sdkerrors.Wrapf(icacallbackstypes.ErrRetryableTx, "delegation timed out")
+	} else if ackResult.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		k.Logger(ctx).Error(fmt.Sprintf("DelegateCallback tx failed on host (%s), packet %v", ackResult.Error, packet))
+		return sdkerrors.Wrapf(icacallbackstypes.ErrRetryableTx, "delegation failed on host: %s", ackResult.Error)
 	}
 
 	// deserialize the args
@@ -73,7 +73,11 @@ func DelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, txM
 		k.SetHostZone(ctx, zone)
 	}
 
-	k.RecordsKeeper.RemoveDepositRecord(ctx, cast.ToUint64(recordId))
+	depositRecord, found := k.RecordsKeeper.GetDepositRecord(ctx, recordId)
+	if found {
+		k.RecordsKeeper.RemoveDepositRecord(ctx, recordId)
+		k.EmitDepositRecordStatusChange(ctx, depositRecord, depositRecord.Status, true)
+	}
 	k.Logger(ctx).Info(fmt.Sprintf("[DELEGATION] success on %s", hostZone))
 	return nil
 }
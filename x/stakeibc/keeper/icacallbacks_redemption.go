@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
 	recordstypes "github.com/Stride-Labs/stride/x/records/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
@@ -30,16 +31,16 @@ func (k Keeper) UnmarshalRedemptionCallbackArgs(ctx sdk.Context, redemptionCallb
 	return unmarshalledRedemptionCallback, nil
 }
 
-func RedemptionCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, txMsgData *sdk.TxMsgData, args []byte) error {
+func RedemptionCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult, args []byte) error {
 	logMsg := fmt.Sprintf("RedemptionCallback executing on packet: %d, source: %s %s, dest: %s %s",
 		packet.Sequence, packet.SourceChannel, packet.SourcePort, packet.DestinationChannel, packet.DestinationPort)
 	k.Logger(ctx).Info(logMsg)
 
-	if txMsgData == nil {
-		k.Logger(ctx).Error(fmt.Sprintf("RedemptionCallback timeout, txMsgData is nil, packet %v", packet))
+	if ackResult.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
+		k.Logger(ctx).Error(fmt.Sprintf("RedemptionCallback timeout, packet %v", packet))
 		return nil
-	} else if len(txMsgData.Data) == 0 {
-		k.Logger(ctx).Error(fmt.Sprintf("RedemptionCallback tx failed, txMsgData is empty, ack error, packet %v", packet))
+	} else if ackResult.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		k.Logger(ctx).Error(fmt.Sprintf("RedemptionCallback tx failed on host (%s), packet %v", ackResult.Error, packet))
 		return nil
 	}
 
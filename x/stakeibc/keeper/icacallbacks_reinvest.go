@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cast"
 
 	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
 	recordstypes "github.com/Stride-Labs/stride/x/records/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
@@ -33,14 +34,16 @@ func (k Keeper) UnmarshalReinvestCallbackArgs(ctx sdk.Context, reinvestCallback
 	return &unmarshalledReinvestCallback, nil
 }
 
-func ReinvestCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, txMsgData *sdk.TxMsgData, args []byte) error {
+func ReinvestCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult, args []byte) error {
 	k.Logger(ctx).Info("ReinvestCallback executing", "packet", packet)
 
-	if txMsgData == nil {
-		k.Logger(ctx).Error(fmt.Sprintf("ReinvestCallback timeout, txMsgData is nil, packet %v", packet))
+	// failed reinvestments are not retried, the funds stay in the withdrawal account and are swept again
+	// after the next withdrawal balance query
+	if ackResult.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
+		k.Logger(ctx).Error(fmt.Sprintf("ReinvestCallback timeout, packet %v", packet))
 		return nil
-	} else if len(txMsgData.Data) == 0 {
-		k.Logger(ctx).Error(fmt.Sprintf("ReinvestCallback tx failed, txMsgData is empty, ack error, packet %v", packet))
+	} else if ackResult.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		k.Logger(ctx).Error(fmt.Sprintf("ReinvestCallback tx failed on host (%s), packet %v", ackResult.Error, packet))
 		return nil
 	}
 
@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/gogo/protobuf/proto"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) getDepositRecordStatusChangeEvent() *recordtypes.EventDepositRecordStatusChange {
+	var event *recordtypes.EventDepositRecordStatusChange
+	for _, abciEvent := range s.Ctx.EventManager().ABCIEvents() {
+		if abciEvent.Type != proto.MessageName(&recordtypes.EventDepositRecordStatusChange{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abciEvent)
+		s.Require().NoError(err)
+		event = typedEvent.(*recordtypes.EventDepositRecordStatusChange)
+	}
+	return event
+}
+
+func (s *KeeperTestSuite) TestFailedCallbacksAreRetryable() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: "GAIA"})
+	redemptionRecord := recordtypes.UserRedemptionRecord{Id: "GAIA.1.stride1", HostZoneId: "GAIA", IsClaimable: false}
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, redemptionRecord)
+
+	claimArgs, err := s.App.StakeibcKeeper.MarshalClaimCallbackArgs(s.Ctx, types.ClaimCallback{UserRedemptionRecordId: redemptionRecord.Id})
+	s.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc     string
+		callback stakeibckeeper.ICACallback
+		args     []byte
+	}{
+		{desc: "delegate", callback: stakeibckeeper.DelegateCallback},
+		{desc: "undelegate", callback: stakeibckeeper.UndelegateCallback},
+		{desc: "claim", callback: stakeibckeeper.ClaimCallback, args: claimArgs},
+	} {
+		s.Run(tc.desc, func() {
+			// timeout
+			timeout := icacallbackstypes.NewTimeoutAcknowledgementResult()
+			err := tc.callback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, timeout, tc.args)
+			s.Require().ErrorIs(err, icacallbackstypes.ErrRetryableTx)
+
+			// ack error
+			ackError := &icacallbackstypes.AcknowledgementResult{Status: icacallbackstypes.AckResponseStatus_FAILURE, Error: "failed"}
+			err = tc.callback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, ackError, tc.args)
+			s.Require().ErrorIs(err, icacallbackstypes.ErrRetryableTx)
+		})
+	}
+
+	// the redemption record stays unclaimable while the claim is queued for retry
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionRecord.Id)
+	s.Require().True(found)
+	s.Require().False(record.IsClaimable, "record not claimable")
+}
+
+func (s *KeeperTestSuite) TestDelegateCallbackRemovesDepositRecord() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: "GAIA"})
+	depositRecord := recordtypes.DepositRecord{Id: 1, HostZoneId: "GAIA", Amount: 100, Status: recordtypes.DepositRecord_DELEGATION_IN_PROGRESS}
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+	delegateArgs, err := s.App.StakeibcKeeper.MarshalDelegateCallbackArgs(s.Ctx, types.DelegateCallback{HostZoneId: "GAIA", DepositRecordId: depositRecord.Id})
+	s.Require().NoError(err)
+
+	ackSuccess := &icacallbackstypes.AcknowledgementResult{Status: icacallbackstypes.AckResponseStatus_SUCCESS}
+	err = stakeibckeeper.DelegateCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, ackSuccess, delegateArgs)
+	s.Require().NoError(err)
+	_, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, depositRecord.Id)
+	s.Require().False(found, "deposit record removed")
+
+	event := s.getDepositRecordStatusChangeEvent()
+	s.Require().NotNil(event, "deposit record status change event emitted")
+	s.Require().Equal(depositRecord.Id, event.DepositRecordId, "event deposit record id")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_IN_PROGRESS, event.OldStatus, "event old status")
+	s.Require().True(event.Removed, "event removed")
+}
+
+func (s *KeeperTestSuite) TestReleaseICATx() {
+	handler := s.App.StakeibcKeeper.ICACallbackHandler()
+
+	// delegation: the deposit record goes back to STAKE
+	depositRecord := recordtypes.DepositRecord{Id: 1, HostZoneId: "GAIA", Amount: 100, Status: recordtypes.DepositRecord_DELEGATION_IN_PROGRESS}
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+	delegateArgs, err := s.App.StakeibcKeeper.MarshalDelegateCallbackArgs(s.Ctx, types.DelegateCallback{HostZoneId: "GAIA", DepositRecordId: depositRecord.Id})
+	s.Require().NoError(err)
+
+	err = handler.ReleaseICATx(s.Ctx, stakeibckeeper.DELEGATE, delegateArgs)
+	s.Require().NoError(err)
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, depositRecord.Id)
+	s.Require().True(found)
+	s.Require().Equal(recordtypes.DepositRecord_STAKE, depositRecord.Status, "deposit record status")
+	event := s.getDepositRecordStatusChangeEvent()
+	s.Require().NotNil(event, "deposit record status change event emitted")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_IN_PROGRESS, event.OldStatus, "event old status")
+	s.Require().Equal(recordtypes.DepositRecord_STAKE, event.NewStatus, "event new status")
+	s.Require().False(event.Removed, "event removed")
+
+	// undelegation: only the in progress host zone unbondings go back to BONDED
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: "GAIA", Status: recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS},
+			{HostZoneId: "OSMO", Status: recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS},
+		},
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: "GAIA", Status: recordtypes.HostZoneUnbonding_UNBONDED},
+		},
+	})
+	undelegateArgs, err := s.App.StakeibcKeeper.MarshalUndelegateCallbackArgs(s.Ctx, types.UndelegateCallback{
+		HostZoneId:              "GAIA",
+		EpochUnbondingRecordIds: []uint64{1, 2},
+	})
+	s.Require().NoError(err)
+
+	err = handler.ReleaseICATx(s.Ctx, stakeibckeeper.UNDELEGATE, undelegateArgs)
+	s.Require().NoError(err)
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 1, "GAIA")
+	s.Require().True(found)
+	s.Require().Equal(recordtypes.HostZoneUnbonding_BONDED, hostZoneUnbonding.Status, "released unbonding status")
+	hostZoneUnbonding, found = s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 1, "OSMO")
+	s.Require().True(found)
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS, hostZoneUnbonding.Status, "other host zone status")
+	hostZoneUnbonding, found = s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 2, "GAIA")
+	s.Require().True(found)
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDED, hostZoneUnbonding.Status, "unbonded record status")
+
+	// claim: the redemption record becomes claimable again
+	redemptionRecord := recordtypes.UserRedemptionRecord{Id: "GAIA.1.stride1", HostZoneId: "GAIA", IsClaimable: false}
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, redemptionRecord)
+	claimArgs, err := s.App.StakeibcKeeper.MarshalClaimCallbackArgs(s.Ctx, types.ClaimCallback{UserRedemptionRecordId: redemptionRecord.Id})
+	s.Require().NoError(err)
+
+	err = handler.ReleaseICATx(s.Ctx, stakeibckeeper.CLAIM, claimArgs)
+	s.Require().NoError(err)
+	redemptionRecord, found = s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionRecord.Id)
+	s.Require().True(found)
+	s.Require().True(redemptionRecord.IsClaimable, "redemption record claimable")
+
+	// missing records can't be released
+	err = handler.ReleaseICATx(s.Ctx, stakeibckeeper.DELEGATE, []byte{})
+	s.Require().ErrorIs(err, types.ErrRecordNotFound)
+}
+
+func (s *KeeperTestSuite) TestRetryFailedIcaTxsWhileChannelRestored() {
+	tc := s.SetupIcaRecovery()
+	s.App.StakeibcKeeper.RestoreClosedIcaChannels(s.Ctx)
+
+	failedIcaTx := icacallbackstypes.FailedIcaTx{
+		Module:         types.ModuleName,
+		CallbackId:     stakeibckeeper.DELEGATE,
+		ConnectionId:   tc.hostZone.ConnectionId,
+		PortId:         tc.portId,
+		Status:         icacallbackstypes.FailedIcaTx_RETRYABLE,
+		NextRetryEpoch: 5,
+	}
+	failedIcaTx.Id = s.App.IcacallbacksKeeper.AppendFailedIcaTx(s.Ctx, failedIcaTx)
+
+	// the tx is held off until the new channel opens, without counting an attempt
+	s.App.IcacallbacksKeeper.RetryFailedIcaTxs(s.Ctx, 5)
+	got, found := s.App.IcacallbacksKeeper.GetFailedIcaTx(s.Ctx, failedIcaTx.Id)
+	s.Require().True(found)
+	s.Require().Equal(uint64(0), got.Attempts, "attempts")
+	s.Require().Equal(icacallbackstypes.FailedIcaTx_RETRYABLE, got.Status, "status")
+	s.Require().Equal(uint64(5), got.NextRetryEpoch, "next retry epoch")
+}
+
+func (s *KeeperTestSuite) TestPruneFailedIcaTxs() {
+	retentionEpochs := s.App.IcacallbacksKeeper.GetParams(s.Ctx).FailedTxRetentionEpochs
+
+	depositRecord := recordtypes.DepositRecord{Id: 1, HostZoneId: "GAIA", Amount: 100, Status: recordtypes.DepositRecord_DELEGATION_IN_PROGRESS}
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+	delegateArgs, err := s.App.StakeibcKeeper.MarshalDelegateCallbackArgs(s.Ctx, types.DelegateCallback{HostZoneId: "GAIA", DepositRecordId: depositRecord.Id})
+	s.Require().NoError(err)
+
+	exhausted := icacallbackstypes.FailedIcaTx{
+		Module:       types.ModuleName,
+		CallbackId:   stakeibckeeper.DELEGATE,
+		CallbackArgs: delegateArgs,
+		Status:       icacallbackstypes.FailedIcaTx_EXHAUSTED,
+		FailedEpoch:  1,
+	}
+	exhausted.Id = s.App.IcacallbacksKeeper.AppendFailedIcaTx(s.Ctx, exhausted)
+	retryable := icacallbackstypes.FailedIcaTx{
+		Module:         types.ModuleName,
+		CallbackId:     stakeibckeeper.DELEGATE,
+		Status:         icacallbackstypes.FailedIcaTx_RETRYABLE,
+		NextRetryEpoch: 1,
+	}
+	retryable.Id = s.App.IcacallbacksKeeper.AppendFailedIcaTx(s.Ctx, retryable)
+
+	// kept until the retention has passed
+	s.App.IcacallbacksKeeper.PruneFailedIcaTxs(s.Ctx, retentionEpochs)
+	_, found := s.App.IcacallbacksKeeper.GetFailedIcaTx(s.Ctx, exhausted.Id)
+	s.Require().True(found, "exhausted tx kept within the retention")
+
+	// then pruned, and the deposit record is released
+	s.App.IcacallbacksKeeper.PruneFailedIcaTxs(s.Ctx, retentionEpochs+1)
+	_, found = s.App.IcacallbacksKeeper.GetFailedIcaTx(s.Ctx, exhausted.Id)
+	s.Require().False(found, "exhausted tx pruned")
+	depositRecord, found = s.App.RecordsKeeper.GetDepositRecord(s.Ctx, depositRecord.Id)
+	s.Require().True(found)
+	s.Require().Equal(recordtypes.DepositRecord_STAKE, depositRecord.Status, "deposit record released")
+
+	_, found = s.App.IcacallbacksKeeper.GetFailedIcaTx(s.Ctx, retryable.Id)
+	s.Require().True(found, "retryable tx kept")
+}
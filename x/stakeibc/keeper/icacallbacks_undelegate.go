@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cast"
 
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
 	recordstypes "github.com/Stride-Labs/stride/x/records/types"
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
@@ -34,17 +35,18 @@ func (k Keeper) UnmarshalUndelegateCallbackArgs(ctx sdk.Context, undelegateCallb
 	return unmarshalledUndelegateCallback, nil
 }
 
-func UndelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, txMsgData *sdk.TxMsgData, args []byte) error {
+func UndelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResult *icacallbackstypes.AcknowledgementResult, args []byte) error {
 	logMsg := fmt.Sprintf("UndelegateCallback executing packet: %d, source: %s %s, dest: %s %s",
 		packet.Sequence, packet.SourceChannel, packet.SourcePort, packet.DestinationChannel, packet.DestinationPort)
 	k.Logger(ctx).Info(logMsg)
 
-	if txMsgData == nil {
-		k.Logger(ctx).Error(fmt.Sprintf("UndelegateCallback timeout, txMsgData is nil, packet %v", packet))
-		return nil
-	} else if len(txMsgData.Data) == 0 {
-		k.Logger(ctx).Error(fmt.Sprintf("UndelegateCallback tx failed, txMsgData is empty, ack error, packet %v", packet))
-		return nil
+	// the host zone unbondings stay in UNBONDING_IN_PROGRESS while the retry queue resubmits the tx
+	if ackResult.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
+		k.Logger(ctx).Error(fmt.Sprintf("UndelegateCallback timeout, packet %v", packet))
+		return sdkerrors.Wrapf(icacallbackstypes.ErrRetryableTx, "undelegation timed out")
+	} else if ackResult.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		k.Logger(ctx).Error(fmt.Sprintf("UndelegateCallback tx failed on host (%s), packet %v", ackResult.Error, packet))
+		return sdkerrors.Wrapf(icacallbackstypes.ErrRetryableTx, "undelegation failed on host: %s", ackResult.Error)
 	}
 
 	// unmarshal the callback args and get the host zone
@@ -80,7 +82,7 @@ func UndelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, t
 
 	// Update the completion time using the latest completion time across each message within the transaction
 	latestCompletionTime := time.Time{}
-	for _, msgResponseBytes := range txMsgData.Data {
+	for _, msgResponseBytes := range ackResult.MsgResponses {
 		var undelegateResponse stakingtypes.MsgUndelegateResponse
 		err := proto.Unmarshal(msgResponseBytes.Data, &undelegateResponse)
 		if err != nil {
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	icqkeeper "github.com/Stride-Labs/stride/x/interchainquery/keeper"
+	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
+	stakeibc "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestIcqRewardFundedOnce() {
+	feeAddress := "cosmos1vejk2hmpvd3k7atww3047h6lta047h6l6ky5rp"
+	hostZone := stakeibc.HostZone{
+		ChainId:      "GAIA",
+		ConnectionId: "connection-0",
+		HostDenom:    atom,
+		FeeAccount:   &stakeibc.ICAAccount{Address: feeAddress, Target: stakeibc.ICAAccountType_FEE},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.IcqResponseReward = 10
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+	bondDenom := s.App.StakingKeeper.BondDenom(s.Ctx)
+	moduleAddress := s.App.AccountKeeper.GetModuleAddress(stakeibc.ModuleName)
+	s.FundModuleAccount(stakeibc.ModuleName, sdk.NewInt64Coin(bondDenom, 100))
+
+	_, addr, err := bech32.DecodeAndConvert(feeAddress)
+	s.Require().NoError(err)
+	queryId := icqkeeper.GenerateQueryHash(hostZone.ConnectionId, hostZone.ChainId, icqtypes.BANK_STORE_QUERY_WITH_PROOF,
+		icqtypes.NewBalanceRequest(addr, atom), stakeibc.ModuleName, 0)
+
+	// the query is requested again before it's answered, but only funded the first time
+	s.Require().NoError(s.App.StakeibcKeeper.UpdateFeeBalance(s.Ctx, hostZone))
+	s.Require().NoError(s.App.StakeibcKeeper.UpdateFeeBalance(s.Ctx, hostZone))
+
+	query, found := s.App.InterchainqueryKeeper.GetQuery(s.Ctx, queryId)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10)), query.Reward, "query reward")
+	s.Require().Equal(int64(90), s.App.BankKeeper.GetBalance(s.Ctx, moduleAddress, bondDenom).Amount.Int64(), "module funded one reward")
+}
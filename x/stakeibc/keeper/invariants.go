@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// RegisterInvariants registers all stakeibc invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrowed-st-tokens", EscrowedStTokensInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "staked-balance", StakedBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the stakeibc module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			ModuleAccountBalanceInvariant(k),
+			EscrowedStTokensInvariant(k),
+			StakedBalanceInvariant(k),
+		} {
+			res, stop := invariant(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ModuleAccountBalanceInvariant checks that the stakeibc module account holds exactly the tokens of the
+// TRANSFER deposit records of each host zone. The tokens of a record whose transfer is in flight have
+// already left the module account, so that record is not counted until the transfer is acked or times out
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		transferInProgress, err := k.RecordsKeeper.GetTransferInProgressDepositRecordIds(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module-account-balance",
+				fmt.Sprintf("unable to get the deposit records being transferred: %s", err.Error())), true
+		}
+		moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
+		for _, hostZone := range k.GetAllHostZone(ctx) {
+			escrowedAmount := sdk.ZeroInt()
+			for _, depositRecord := range depositRecords {
+				if depositRecord.HostZoneId != hostZone.ChainId || depositRecord.Status != recordstypes.DepositRecord_TRANSFER {
+					continue
+				}
+				if transferInProgress[depositRecord.Id] {
+					continue
+				}
+				escrowedAmount = escrowedAmount.Add(sdk.NewInt(depositRecord.Amount))
+			}
+
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddress, hostZone.IBCDenom).Amount
+			if !balance.Equal(escrowedAmount) {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s: module account holds %v%s, TRANSFER records not being transferred sum to %v\n",
+					hostZone.ChainId, balance, hostZone.IBCDenom, escrowedAmount)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account-balance",
+			fmt.Sprintf("module account balance does not match TRANSFER deposit records\n%s", msg)), broken
+	}
+}
+
+// EscrowedStTokensInvariant checks that the stTokens escrowed on the stakeibc module account match the
+// stTokens of the HostZoneUnbondings that have not unbonded yet (stTokens are burned when the unbonding is acked)
+func EscrowedStTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		epochUnbondingRecords := k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx)
+		for _, hostZone := range k.GetAllHostZone(ctx) {
+			escrowedAmount := sdk.ZeroInt()
+			for _, epochUnbondingRecord := range epochUnbondingRecords {
+				for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+					if hostZoneUnbonding.HostZoneId != hostZone.ChainId {
+						continue
+					}
+					status := hostZoneUnbonding.Status
+					if status == recordstypes.HostZoneUnbonding_BONDED || status == recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
+						escrowedAmount = escrowedAmount.Add(sdk.NewIntFromUint64(hostZoneUnbonding.StTokenAmount))
+					}
+				}
+			}
+
+			stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddress, stDenom).Amount
+			if !balance.Equal(escrowedAmount) {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s: module account holds %v%s, BONDED and UNBONDING_IN_PROGRESS unbonding records sum to %v\n",
+					hostZone.ChainId, balance, stDenom, escrowedAmount)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrowed-st-tokens",
+			fmt.Sprintf("escrowed stTokens do not match unbonding records\n%s", msg)), broken
+	}
+}
+
+// StakedBalanceInvariant checks that the staked balance of each host zone is the sum of its validators' delegations
+func StakedBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, hostZone := range k.GetAllHostZone(ctx) {
+			totalDelegation := sdk.ZeroInt()
+			for _, validator := range hostZone.Validators {
+				totalDelegation = totalDelegation.Add(sdk.NewIntFromUint64(validator.DelegationAmt))
+			}
+
+			if !totalDelegation.Equal(sdk.NewIntFromUint64(hostZone.StakedBal)) {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s: staked balance is %d, validator delegations sum to %v\n",
+					hostZone.ChainId, hostZone.StakedBal, totalDelegation)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "staked-balance",
+			fmt.Sprintf("staked balance does not match validator delegations\n%s", msg)), broken
+	}
+}
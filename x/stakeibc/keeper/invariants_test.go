@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icacallbackstypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
+	recordskeeper "github.com/Stride-Labs/stride/x/records/keeper"
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	stakeibc "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupInvariants() stakeibc.HostZone {
+	hostZone := stakeibc.HostZone{
+		ChainId:        "GAIA",
+		HostDenom:      atom,
+		IBCDenom:       ibcAtom,
+		RedemptionRate: sdk.NewDec(1),
+		StakedBal:      300,
+		Validators: []*stakeibc.Validator{
+			{Address: "val1", DelegationAmt: 100},
+			{Address: "val2", DelegationAmt: 200},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// epoch 1's record is in flight, epoch 2's record is still on the module account
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{Id: 1, HostZoneId: "GAIA", Amount: 50, DepositEpochNumber: 1, Status: recordtypes.DepositRecord_TRANSFER})
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{Id: 2, HostZoneId: "GAIA", Amount: 70, DepositEpochNumber: 2, Status: recordtypes.DepositRecord_TRANSFER})
+	transferArgs, err := s.App.RecordsKeeper.MarshalTransferCallbackArgs(s.Ctx, recordtypes.TransferCallback{DepositRecordId: 1})
+	s.Require().NoError(err)
+	s.App.IcacallbacksKeeper.SetCallbackData(s.Ctx, icacallbackstypes.CallbackData{
+		CallbackKey:  icacallbackstypes.PacketID("transfer", "channel-0", 1),
+		PortId:       "transfer",
+		ChannelId:    "channel-0",
+		Sequence:     1,
+		CallbackId:   recordskeeper.TRANSFER,
+		CallbackArgs: transferArgs,
+		Module:       recordtypes.ModuleName,
+	})
+	s.FundModuleAccount(stakeibc.ModuleName, sdk.NewInt64Coin(ibcAtom, 70))
+
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: "GAIA", StTokenAmount: 40, Status: recordtypes.HostZoneUnbonding_BONDED},
+			{HostZoneId: "GAIA", StTokenAmount: 15, Status: recordtypes.HostZoneUnbonding_UNBONDED},
+		},
+	})
+	// the stTokens of an undelegation that hasn't been acked yet are still escrowed
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: "GAIA", StTokenAmount: 25, Status: recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS},
+		},
+	})
+	s.FundModuleAccount(stakeibc.ModuleName, sdk.NewInt64Coin(stAtom, 40+25))
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) TestInvariantsHold() {
+	s.SetupInvariants()
+	msg, broken := keeper.AllInvariants(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *KeeperTestSuite) TestInvariantsRegistered() {
+	routes := map[string]bool{}
+	for _, route := range s.App.CrisisKeeper.Routes() {
+		routes[route.FullRoute()] = true
+	}
+	for _, route := range []string{"module-account-balance", "escrowed-st-tokens", "staked-balance"} {
+		s.Require().True(routes[stakeibc.ModuleName+"/"+route], "invariant %s registered", route)
+	}
+}
+
+func (s *KeeperTestSuite) TestInvariantsAssertedByCrisis() {
+	s.SetupInvariants()
+	s.Require().NotPanics(func() { s.App.CrisisKeeper.AssertInvariants(s.Ctx) })
+
+	s.FundModuleAccount(stakeibc.ModuleName, sdk.NewInt64Coin(ibcAtom, 1))
+	s.Require().Panics(func() { s.App.CrisisKeeper.AssertInvariants(s.Ctx) })
+}
+
+func (s *KeeperTestSuite) TestModuleAccountBalanceInvariantBroken() {
+	tcs := []struct {
+		desc        string
+		breakEscrow func()
+	}{
+		{
+			desc: "module account doesn't cover the deposits",
+			breakEscrow: func() {
+				s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{Id: 2, HostZoneId: "GAIA", Amount: 90, DepositEpochNumber: 2, Status: recordtypes.DepositRecord_TRANSFER})
+			},
+		},
+		{
+			desc: "module account holds more than the deposits",
+			breakEscrow: func() {
+				s.FundModuleAccount(stakeibc.ModuleName, sdk.NewInt64Coin(ibcAtom, 10))
+			},
+		},
+		{
+			desc: "transfer acked without updating the deposit record",
+			breakEscrow: func() {
+				s.App.IcacallbacksKeeper.RemoveCallbackData(s.Ctx, icacallbackstypes.PacketID("transfer", "channel-0", 1))
+			},
+		},
+	}
+	for _, tc := range tcs {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			s.SetupInvariants()
+			tc.breakEscrow()
+
+			_, broken := keeper.ModuleAccountBalanceInvariant(s.App.StakeibcKeeper)(s.Ctx)
+			s.Require().True(broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEscrowedStTokensInvariantBroken() {
+	s.SetupInvariants()
+	s.FundModuleAccount(stakeibc.ModuleName, sdk.NewInt64Coin(stAtom, 1))
+
+	_, broken := keeper.EscrowedStTokensInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestStakedBalanceInvariantBroken() {
+	hostZone := s.SetupInvariants()
+	hostZone.StakedBal = 301
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, broken := keeper.StakedBalanceInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken)
+}
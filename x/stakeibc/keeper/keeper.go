@@ -46,6 +46,7 @@ type (
 		ICACallbacksKeeper    icacallbacksmodulekeeper.Keeper
 
 		accountKeeper types.AccountKeeper
+		epochsKeeper  types.EpochsKeeper
 	}
 )
 
@@ -67,6 +68,7 @@ func NewKeeper(
 	RecordsKeeper recordsmodulekeeper.Keeper,
 	StakingKeeper stakingkeeper.Keeper,
 	ICACallbacksKeeper icacallbacksmodulekeeper.Keeper,
+	epochsKeeper types.EpochsKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -88,6 +90,7 @@ func NewKeeper(
 		RecordsKeeper:         RecordsKeeper,
 		StakingKeeper:         StakingKeeper,
 		ICACallbacksKeeper:    ICACallbacksKeeper,
+		epochsKeeper:          epochsKeeper,
 	}
 }
 
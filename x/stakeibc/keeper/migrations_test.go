@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	params := types.DefaultParams()
+	params.IcqResponseReward = 10
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// remove the ICQ response reward from the params, as it was before the migration
+	paramsStore := s.Ctx.KVStore(s.App.GetKey(paramstypes.StoreKey))
+	paramsStore.Delete(append([]byte(types.ModuleName+"/"), types.KeyIcqResponseReward...))
+	s.Require().Panics(func() { s.App.StakeibcKeeper.GetParams(s.Ctx) })
+
+	err := keeper.NewMigrator(s.App.StakeibcKeeper).Migrate1to2(s.Ctx)
+	s.Require().NoError(err)
+	params.IcqResponseReward = types.DefaultIcqResponseReward
+	s.Require().Equal(params, s.App.StakeibcKeeper.GetParams(s.Ctx))
+}
@@ -54,6 +54,20 @@ func (k msgServer) ClaimUndelegatedTokens(goCtx context.Context, msg *types.MsgC
 	userRedemptionRecord.IsClaimable = false
 	k.RecordsKeeper.SetUserRedemptionRecord(ctx, *userRedemptionRecord)
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaimUndelegatedTokens{
+		Sender:                 msg.Sender,
+		HostZone:               msg.HostZoneId,
+		Receiver:               userRedemptionRecord.Receiver,
+		EpochNumber:            msg.Epoch,
+		Amount:                 userRedemptionRecord.Amount,
+		Denom:                  userRedemptionRecord.Denom,
+		UserRedemptionRecordId: userRedemptionRecord.Id,
+	})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to emit claim event | %s", err.Error()))
+		return nil, err
+	}
+
 	return &types.MsgClaimUndelegatedTokensResponse{}, nil
 }
 
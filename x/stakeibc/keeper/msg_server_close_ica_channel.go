@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// CloseIcaChannel is the only path through which stakeibc closes one of its ICA channels. Relayer or user initiated
+// closes are rejected in OnChanCloseInit, so the close is performed here directly against the channel keeper with the
+// channel capability stakeibc claimed when the channel was opened. The channel is reopened by the next stride epoch's
+// ICA recovery.
+func (k msgServer) CloseIcaChannel(goCtx context.Context, msg *types.MsgCloseIcaChannel) (*types.MsgCloseIcaChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("Host Zone not found: %s", msg.ChainId))
+		return nil, types.ErrInvalidHostZone
+	}
+
+	portId, err := icatypes.NewControllerPortID(types.FormatICAAccountOwner(msg.ChainId, msg.AccountType))
+	if err != nil {
+		return nil, err
+	}
+	channelId, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, hostZone.ConnectionId, portId)
+	if !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for port %s", portId)
+	}
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portId, channelId))
+	if !found {
+		return nil, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	if err := k.IBCKeeper.ChannelKeeper.ChanCloseInit(ctx, portId, channelId, chanCap); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to close channel %s on port %s | %s", channelId, portId, err.Error()))
+		return nil, err
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("Closed %s ICA channel %s for %s", msg.AccountType.String(), channelId, msg.ChainId))
+
+	hostZone.Degraded = true
+	k.SetHostZone(ctx, hostZone)
+
+	return &types.MsgCloseIcaChannelResponse{}, nil
+}
@@ -50,6 +50,14 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 		k.Logger(ctx).Error("invalid token denom - denom is not an IBC token (%s)", ibcDenom)
 		return nil, sdkerrors.Wrapf(types.ErrInvalidToken, "denom is not an IBC token (%s)", ibcDenom)
 	}
+	// protect the user against a redemption rate update between signing and inclusion
+	stAmount := sdk.NewIntFromUint64(msg.Amount).ToDec().Quo(hostZone.RedemptionRate).TruncateInt()
+	if msg.MinStAmountOut > 0 {
+		if stAmount.LT(sdk.NewIntFromUint64(msg.MinStAmountOut)) {
+			k.Logger(ctx).Error(fmt.Sprintf("stToken amount %v is below the minimum %d", stAmount, msg.MinStAmountOut))
+			return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "stToken amount %v is below the minimum %d", stAmount, msg.MinStAmountOut)
+		}
+	}
 
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(inCoin))
 	if err != nil {
@@ -84,6 +92,20 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 	depositRecord.Amount += msgAmt
 	k.RecordsKeeper.SetDepositRecord(ctx, *depositRecord)
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLiquidStake{
+		Creator:         msg.Creator,
+		HostZone:        hostZone.ChainId,
+		HostDenom:       hostZone.HostDenom,
+		NativeAmount:    msg.Amount,
+		StAmount:        stAmount,
+		RedemptionRate:  hostZone.RedemptionRate,
+		DepositRecordId: depositRecord.Id,
+	})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to emit liquid stake event | %s", err.Error()))
+		return nil, err
+	}
+
 	return &types.MsgLiquidStakeResponse{}, nil
 }
 
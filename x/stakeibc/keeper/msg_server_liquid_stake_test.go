@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	_ "github.com/stretchr/testify/suite"
 
 	epochtypes "github.com/Stride-Labs/stride/x/epochs/types"
@@ -225,3 +226,51 @@ func (s *KeeperTestSuite) TestLiquidStakeNoDepositRecord() {
 
 	s.Require().EqualError(err, fmt.Sprintf("no deposit record for epoch (%d): not found", 1))
 }
+
+func (s *KeeperTestSuite) TestLiquidStakeMinStAmountOut() {
+	tc := s.SetupLiquidStake()
+	// Redemption rate moves from 1.0 to 1.25 after the user signed, so only 800_000 stuatom would be minted
+	hz := tc.initialState.hostZone
+	hz.RedemptionRate = sdk.NewDecWithPrec(125, 2)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hz)
+
+	msg := tc.validMsg
+	msg.MinStAmountOut = 900_000
+	_, err := s.msgServer.LiquidStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().EqualError(err, "stToken amount 800000 is below the minimum 900000: output amount is below the requested minimum")
+
+	// Nothing should have been minted
+	s.Require().Equal(int64(0), s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, stAtom).Amount.Int64(), "user stuatom balance")
+
+	// A minimum equal to the output is accepted
+	msg.MinStAmountOut = 800_000
+	_, err = s.msgServer.LiquidStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(800_000), s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, stAtom).Amount.Int64(), "user stuatom balance")
+}
+
+func (s *KeeperTestSuite) TestLiquidStakeEmitsEvent() {
+	tc := s.SetupLiquidStake()
+	msg := tc.validMsg
+
+	_, err := s.msgServer.LiquidStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err)
+
+	var event *types.EventLiquidStake
+	for _, abciEvent := range s.Ctx.EventManager().ABCIEvents() {
+		if abciEvent.Type != proto.MessageName(&types.EventLiquidStake{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abciEvent)
+		s.Require().NoError(err)
+		event = typedEvent.(*types.EventLiquidStake)
+	}
+	s.Require().NotNil(event, "liquid stake event emitted")
+
+	s.Require().Equal(msg.Creator, event.Creator, "event creator")
+	s.Require().Equal("GAIA", event.HostZone, "event host zone")
+	s.Require().Equal(msg.Amount, event.NativeAmount, "event native amount")
+	s.Require().Equal(sdk.NewIntFromUint64(msg.Amount), event.StAmount, "event st amount")
+	s.Require().Equal(sdk.OneDec(), event.RedemptionRate, "event redemption rate")
+	s.Require().Equal(uint64(1), event.DepositRecordId, "event deposit record id")
+}
@@ -60,6 +60,10 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	if !nativeAmount.IsPositive() {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be greater than 0. found: %d", msg.Amount)
 	}
+	// 	- Redemption amount must be at least the minimum requested by the user
+	if msg.MinNativeAmountOut > 0 && nativeAmount.LT(sdk.NewIntFromUint64(msg.MinNativeAmountOut)) {
+		return nil, sdkerrors.Wrapf(types.ErrSlippageExceeded, "native amount %v is below the minimum %d", nativeAmount, msg.MinNativeAmountOut)
+	}
 	// 	- Creator owns at least "amount" stAssets
 	balance := k.bankKeeper.GetBalance(ctx, sender, coinDenom)
 	k.Logger(ctx).Info(fmt.Sprintf("Redemption issuer IBCDenom balance: %v%s", balance.Amount, balance.Denom))
@@ -136,5 +140,19 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	}
 	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
 
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedeemStake{
+		Creator:                msg.Creator,
+		HostZone:               hostZone.ChainId,
+		Receiver:               msg.Receiver,
+		StAmount:               stTokenAmount,
+		NativeAmount:           userRedemptionRecord.Amount,
+		EpochNumber:            epochTracker.EpochNumber,
+		UserRedemptionRecordId: userRedemptionRecord.Id,
+	})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to emit redeem stake event | %s", err.Error()))
+		return nil, err
+	}
+
 	return &types.MsgRedeemStakeResponse{}, nil
 }
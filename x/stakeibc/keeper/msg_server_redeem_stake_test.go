@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cast"
 	_ "github.com/stretchr/testify/suite"
 
@@ -262,3 +263,54 @@ func (suite *KeeperTestSuite) TestRedeemStakeHostZoneNoUnbondings() {
 
 	suite.Require().EqualError(err, "host zone not found in unbondings: GAIA: host zone not registered")
 }
+
+func (suite *KeeperTestSuite) TestRedeemStakeMinNativeAmountOut() {
+	tc := suite.SetupRedeemStake()
+	// Redemption rate moves from 1.0 to 0.8 after the user signed, so only 800_000 uatom would be redeemed
+	hostZone, _ := suite.App.StakeibcKeeper.GetHostZone(suite.Ctx, "GAIA")
+	hostZone.RedemptionRate = sdk.NewDecWithPrec(8, 1)
+	suite.App.StakeibcKeeper.SetHostZone(suite.Ctx, hostZone)
+
+	msg := tc.validMsg
+	msg.MinNativeAmountOut = 900_000
+	_, err := suite.msgServer.RedeemStake(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().EqualError(err, "native amount 800000 is below the minimum 900000: output amount is below the requested minimum")
+
+	// Nothing should have been escrowed
+	actualUserStAtomBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, tc.user.acc, "stuatom")
+	suite.CompareCoins(tc.user.stAtomBalance, actualUserStAtomBalance, "user stuatom balance")
+
+	// A minimum equal to the output is accepted
+	msg.MinNativeAmountOut = 800_000
+	_, err = suite.msgServer.RedeemStake(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRedeemStakeEmitsEvent() {
+	tc := suite.SetupRedeemStake()
+	msg := tc.validMsg
+
+	_, err := suite.msgServer.RedeemStake(sdk.WrapSDKContext(suite.Ctx), &msg)
+	suite.Require().NoError(err)
+
+	var event *stakeibc.EventRedeemStake
+	for _, abciEvent := range suite.Ctx.EventManager().ABCIEvents() {
+		if abciEvent.Type != proto.MessageName(&stakeibc.EventRedeemStake{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abciEvent)
+		suite.Require().NoError(err)
+		event = typedEvent.(*stakeibc.EventRedeemStake)
+	}
+	suite.Require().NotNil(event, "redeem stake event emitted")
+
+	userRedemptionRecord, found := suite.App.RecordsKeeper.GetUserRedemptionRecord(suite.Ctx, event.UserRedemptionRecordId)
+	suite.Require().True(found, "user redemption record from event")
+
+	suite.Require().Equal(msg.Creator, event.Creator, "event creator")
+	suite.Require().Equal(msg.HostZone, event.HostZone, "event host zone")
+	suite.Require().Equal(msg.Receiver, event.Receiver, "event receiver")
+	suite.Require().Equal(msg.Amount, event.StAmount, "event st amount")
+	suite.Require().Equal(userRedemptionRecord.Amount, event.NativeAmount, "event native amount")
+	suite.Require().Equal(tc.initialState.epochNumber, event.EpochNumber, "event epoch number")
+}
@@ -13,12 +13,9 @@ import (
 
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
 
-	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-
 	epochstypes "github.com/Stride-Labs/stride/x/epochs/types"
 	icqtypes "github.com/Stride-Labs/stride/x/interchainquery/types"
 
@@ -175,34 +172,49 @@ func (k Keeper) SetWithdrawalAddressOnHost(ctx sdk.Context, hostZone types.HostZ
 // Simple balance query helper using new ICQ module
 func (k Keeper) UpdateWithdrawalBalance(ctx sdk.Context, zoneInfo types.HostZone) error {
 	k.Logger(ctx).Info(fmt.Sprintf("\tUpdating withdrawal balances on %s", zoneInfo.ChainId))
+	return k.queryIcaBalance(ctx, zoneInfo, zoneInfo.GetWithdrawalAccount(), "withdrawalbalance")
+}
 
-	withdrawalIca := zoneInfo.GetWithdrawalAccount()
-	if withdrawalIca == nil || withdrawalIca.Address == "" {
-		k.Logger(ctx).Error(fmt.Sprintf("Zone %s is missing a withdrawal address!", zoneInfo.ChainId))
+// UpdateFeeBalance queries the balance of the fee ICA, which is only recorded for the host zone accounting
+func (k Keeper) UpdateFeeBalance(ctx sdk.Context, zoneInfo types.HostZone) error {
+	k.Logger(ctx).Info(fmt.Sprintf("\tUpdating fee balance on %s", zoneInfo.ChainId))
+	return k.queryIcaBalance(ctx, zoneInfo, zoneInfo.GetFeeAccount(), "feebalance")
+}
+
+// queryIcaBalance queries the host denom balance of an ICA, which is handled by the callback
+func (k Keeper) queryIcaBalance(ctx sdk.Context, zoneInfo types.HostZone, ica *types.ICAAccount, callbackId string) error {
+	if ica == nil || ica.Address == "" {
+		k.Logger(ctx).Error(fmt.Sprintf("Zone %s is missing an ICA address for %s!", zoneInfo.ChainId, callbackId))
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "zone %s is missing an ICA for %s", zoneInfo.ChainId, callbackId)
 	}
-	k.Logger(ctx).Info(fmt.Sprintf("\tQuerying withdrawalBalances for %s", zoneInfo.ChainId))
+	k.Logger(ctx).Info(fmt.Sprintf("\tQuerying %s for %s", callbackId, zoneInfo.ChainId))
 
-	_, addr, _ := bech32.DecodeAndConvert(withdrawalIca.GetAddress())
-	data := bankTypes.CreateAccountBalancesPrefix(addr)
+	_, addr, err := bech32.DecodeAndConvert(ica.GetAddress())
+	if err != nil {
+		return err
+	}
+	data := icqtypes.NewBalanceRequest(addr, zoneInfo.HostDenom)
 	k.Logger(ctx).Info("Querying for value", "key", icqtypes.BANK_STORE_QUERY_WITH_PROOF, "denom", zoneInfo.HostDenom)
-	err := k.InterchainQueryKeeper.MakeRequest(
+	err = k.InterchainQueryKeeper.MakeRequest(
 		ctx,
 		zoneInfo.ConnectionId,
 		zoneInfo.ChainId,
 		// use "bank" store to access acct balances which live in the bank module
 		// use "key" suffix to retrieve a proof alongside the query result
 		icqtypes.BANK_STORE_QUERY_WITH_PROOF,
-		append(data, []byte(zoneInfo.HostDenom)...),
+		data,
 		sdk.NewInt(-1),
 		types.ModuleName,
-		"withdrawalbalance",
+		callbackId,
 		0, // ttl
 		0, // height always 0 (which means current height)
+		k.IcqTimeoutPolicy(ctx),
 	)
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Error querying for withdrawal balance, error: %s", err.Error()))
+		k.Logger(ctx).Error(fmt.Sprintf("Error querying for %s, error: %s", callbackId, err.Error()))
 		return err
 	}
+	k.fundIcqReward(ctx, zoneInfo, icqtypes.BANK_STORE_QUERY_WITH_PROOF, data, 0)
 	return nil
 }
 
@@ -248,6 +260,13 @@ func (k Keeper) SubmitTxsEpoch(
 	callbackArgs []byte,
 ) (uint64, error) {
 	k.Logger(ctx).Info(fmt.Sprintf("SubmitTxsEpoch: %v", msgs))
+	// hold off the epoch's txs while the account's channel is being restored, they're resubmitted next epoch
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		if hostZone.ConnectionId == connectionId && hostZone.Degraded && k.IsIcaAccountDegraded(ctx, hostZone, account.Target) {
+			k.Logger(ctx).Error(fmt.Sprintf("Not submitting txs for %s, %s ICA channel is not open", hostZone.ChainId, account.Target.String()))
+			return 0, sdkerrors.Wrapf(types.ErrHostZoneDegraded, "host zone %s, account %s", hostZone.ChainId, account.Target.String())
+		}
+	}
 	epochTracker, found := k.GetEpochTracker(ctx, epochType)
 	if !found {
 		k.Logger(ctx).Error(fmt.Sprintf("Failed to get epoch tracker for %s", epochType))
@@ -325,15 +344,31 @@ func (k Keeper) SubmitTxs(
 	// Store the callback data
 	if callbackId != "" && callbackArgs != nil {
 		callback := icacallbackstypes.CallbackData{
-			CallbackKey:  icacallbackstypes.PacketID(portID, channelID, sequence),
-			PortId:       portID,
-			ChannelId:    channelID,
-			Sequence:     sequence,
-			CallbackId:   callbackId,
-			CallbackArgs: callbackArgs,
+			CallbackKey:         icacallbackstypes.PacketID(portID, channelID, sequence),
+			PortId:              portID,
+			ChannelId:           channelID,
+			Sequence:            sequence,
+			CallbackId:          callbackId,
+			CallbackArgs:        callbackArgs,
+			ExpirationTimestamp: k.ICACallbacksKeeper.GetCallbackExpiration(ctx, timeoutTimestamp),
 		}
 		k.ICACallbacksKeeper.SetCallbackData(ctx, callback)
 	}
+	k.IncrIcaCounter(ctx, types.MetricKeyIcaTxSubmitted, portID, channelID, callbackId)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventIcaTxSubmitted{
+		ChainId:          chainId,
+		ConnectionId:     connectionId,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		CallbackId:       callbackId,
+		NumMsgs:          uint64(len(msgs)),
+		TimeoutTimestamp: timeoutTimestamp,
+	})
+	if err != nil {
+		return 0, err
+	}
 
 	return sequence, nil
 }
@@ -407,7 +442,7 @@ func (k Keeper) QueryValidatorExchangeRate(ctx sdk.Context, msg *types.MsgUpdate
 	if err != nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator operator address, could not decode (%s)", err.Error())
 	}
-	data := stakingtypes.GetValidatorKey(valAddr)
+	data := icqtypes.NewValidatorRequest(valAddr)
 
 	k.Logger(ctx).Info(fmt.Sprintf("Querying validator %v key %v denom %v", valAddr, icqtypes.STAKING_STORE_QUERY_WITH_PROOF, hostZone.HostDenom))
 	err = k.InterchainQueryKeeper.MakeRequest(
@@ -423,16 +458,19 @@ func (k Keeper) QueryValidatorExchangeRate(ctx sdk.Context, msg *types.MsgUpdate
 		"validator",
 		0, // ttl
 		0, // height always 0 (which means current height)
+		k.IcqTimeoutPolicy(ctx),
 	)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error querying for validator, error %s", err.Error()))
 		return nil, err
 	}
+	k.fundIcqReward(ctx, hostZone, icqtypes.STAKING_STORE_QUERY_WITH_PROOF, data, 0)
 	return &types.MsgUpdateValidatorSharesExchRateResponse{}, nil
 }
 
 // to icq delegation amounts, this fn is executed after validator exch rates are icq'd
-func (k Keeper) QueryDelegationsIcq(ctx sdk.Context, hostZone types.HostZone, valoper string) error {
+// the delegation is queried at the given host height, so that the shares match the exchange rate queried there
+func (k Keeper) QueryDelegationsIcq(ctx sdk.Context, hostZone types.HostZone, valoper string, height int64) error {
 
 	// ensure ICQ can be issued now! else fail the callback
 	valid, err := k.IsWithinBufferWindow(ctx)
@@ -450,7 +488,7 @@ func (k Keeper) QueryDelegationsIcq(ctx sdk.Context, hostZone types.HostZone, va
 	delegationAcctAddr := delegationIca.GetAddress()
 	_, valAddr, _ := bech32.DecodeAndConvert(valoper)
 	_, delAddr, _ := bech32.DecodeAndConvert(delegationAcctAddr)
-	data := stakingtypes.GetDelegationKey(delAddr, valAddr)
+	data := icqtypes.NewDelegationRequest(delAddr, valAddr)
 
 	k.Logger(ctx).Info(fmt.Sprintf("Querying delegation for %s on %s", delAddr, valoper))
 	err = k.InterchainQueryKeeper.MakeRequest(
@@ -465,11 +503,13 @@ func (k Keeper) QueryDelegationsIcq(ctx sdk.Context, hostZone types.HostZone, va
 		types.ModuleName,
 		"delegation",
 		0, // ttl
-		0, // height always 0 (which means current height)
+		height,
+		k.IcqTimeoutPolicy(ctx),
 	)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error querying for delegation, error : %s", err.Error()))
 		return err
 	}
+	k.fundIcqReward(ctx, hostZone, icqtypes.STAKING_STORE_QUERY_WITH_PROOF, data, height)
 	return nil
 }
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	recordstypes "github.com/Stride-Labs/stride/x/records/types"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// SetHostZoneGauges reports the per host zone gauges: redemption rate, staked balance,
+// pending deposits by status and the age of the host zone's light client
+func (k Keeper) SetHostZoneGauges(ctx sdk.Context) {
+	depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
+
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		chainLabel := telemetry.NewLabel(types.MetricLabelChainId, hostZone.ChainId)
+
+		redemptionRate, err := hostZone.RedemptionRate.Float64()
+		if err == nil {
+			telemetry.SetGaugeWithLabels(
+				[]string{types.ModuleName, types.MetricKeyRedemptionRate},
+				float32(redemptionRate),
+				[]metrics.Label{chainLabel},
+			)
+		}
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, types.MetricKeyStakedBalance},
+			float32(hostZone.StakedBal),
+			[]metrics.Label{chainLabel},
+		)
+
+		// always report every status so that drained statuses drop back to zero
+		pendingDeposits := map[recordstypes.DepositRecord_Status]int64{}
+		for status := range recordstypes.DepositRecord_Status_name {
+			pendingDeposits[recordstypes.DepositRecord_Status(status)] = 0
+		}
+		for _, depositRecord := range depositRecords {
+			if depositRecord.HostZoneId == hostZone.ChainId {
+				pendingDeposits[depositRecord.Status] += depositRecord.Amount
+			}
+		}
+		for status, amount := range pendingDeposits {
+			telemetry.SetGaugeWithLabels(
+				[]string{types.ModuleName, types.MetricKeyPendingDeposits},
+				float32(amount),
+				[]metrics.Label{chainLabel, telemetry.NewLabel(types.MetricLabelStatus, status.String())},
+			)
+		}
+
+		if hostZone.ConnectionId == "" {
+			continue
+		}
+		lightClientTime, found := k.GetLightClientTimeSafely(ctx, hostZone.ConnectionId)
+		if !found {
+			continue
+		}
+		lightClientTimeNs, err := cast.ToInt64E(lightClientTime)
+		if err != nil {
+			continue
+		}
+		staleness := ctx.BlockTime().Sub(time.Unix(0, lightClientTimeNs))
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, types.MetricKeyLightClientStaleness},
+			float32(staleness.Seconds()),
+			[]metrics.Label{chainLabel},
+		)
+	}
+}
+
+// IncrIcaCounter increments one of the ICA tx counters for the chain behind the given channel
+func (k Keeper) IncrIcaCounter(ctx sdk.Context, metricKey string, portId string, channelId string, callbackId string) {
+	chainId := ""
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portId, channelId)
+	if found && len(channel.ConnectionHops) > 0 {
+		chainId, _ = k.GetChainID(ctx, channel.ConnectionHops[0])
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, metricKey},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.MetricLabelChainId, chainId),
+			telemetry.NewLabel(types.MetricLabelCallbackId, callbackId),
+		},
+	)
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	recordtypes "github.com/Stride-Labs/stride/x/records/types"
+	stakeibc "github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestSetHostZoneGauges() {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	config := metrics.DefaultConfig("")
+	config.EnableHostname = false
+	config.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(config, sink)
+	s.Require().NoError(err)
+	// put back the default sink, which drops everything, so other tests don't report to this one
+	t := s.T()
+	t.Cleanup(func() {
+		_, err := metrics.NewGlobal(config, &metrics.BlackholeSink{})
+		require.NoError(t, err)
+	})
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibc.HostZone{
+		ChainId:        "GAIA",
+		HostDenom:      atom,
+		RedemptionRate: sdk.NewDecWithPrec(15, 1),
+		StakedBal:      1000,
+	})
+	depositRecords := []recordtypes.DepositRecord{
+		{Id: 1, HostZoneId: "GAIA", Amount: 100, Status: recordtypes.DepositRecord_TRANSFER},
+		{Id: 2, HostZoneId: "GAIA", Amount: 200, Status: recordtypes.DepositRecord_TRANSFER},
+		{Id: 3, HostZoneId: "GAIA", Amount: 300, Status: recordtypes.DepositRecord_STAKE},
+		{Id: 4, HostZoneId: "OSMO", Amount: 400, Status: recordtypes.DepositRecord_STAKE},
+	}
+	for _, depositRecord := range depositRecords {
+		s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+	}
+
+	s.App.StakeibcKeeper.SetHostZoneGauges(s.Ctx)
+
+	intervals := sink.Data()
+	s.Require().NotEmpty(intervals)
+	gauges := intervals[0].Gauges
+
+	expectedGauges := map[string]float32{
+		"stakeibc.redemption_rate;chain_id=GAIA":                  1.5,
+		"stakeibc.staked_balance;chain_id=GAIA":                   1000,
+		"stakeibc.pending_deposits;chain_id=GAIA;status=TRANSFER": 300,
+		"stakeibc.pending_deposits;chain_id=GAIA;status=STAKE":    300,
+	}
+	for key, expected := range expectedGauges {
+		gauge, found := gauges[key]
+		s.Require().True(found, "gauge %s reported", key)
+		s.Require().Equal(expected, gauge.Value, "gauge %s", key)
+	}
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -173,16 +172,56 @@ func (k Keeper) SendHostZoneUnbondings(ctx sdk.Context, hostZone types.HostZone)
 		return false
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute("hostZone", hostZone.ChainId),
-			sdk.NewAttribute("newAmountUnbonding", strconv.FormatUint(totalAmtToUnbond, 10)),
-		),
-	)
+	// the records are owned by the in-flight tx (and the retry queue, if it fails) until the callback completes
+	err = k.SetHostZoneUnbondingStatus(ctx, hostZone.ChainId, epochUnbondingRecordIds,
+		recordstypes.HostZoneUnbonding_BONDED, recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error updating host zone unbonding status: %s", err))
+		return false
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUnbondingInitiated{
+		HostZone:                hostZone.ChainId,
+		Amount:                  totalAmtToUnbond,
+		EpochUnbondingRecordIds: epochUnbondingRecordIds,
+	})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error emitting unbonding event: %s", err))
+	}
 	return true
 }
 
+// SetHostZoneUnbondingStatus moves the host zone's unbonding in each of the given epoch unbonding records from one
+// status to another, skipping any that are not in the expected status
+func (k Keeper) SetHostZoneUnbondingStatus(
+	ctx sdk.Context,
+	chainId string,
+	epochUnbondingRecordIds []uint64,
+	from recordstypes.HostZoneUnbonding_Status,
+	to recordstypes.HostZoneUnbonding_Status,
+) error {
+	for _, epochNumber := range epochUnbondingRecordIds {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+		if !found {
+			errMsg := fmt.Sprintf("Host zone unbonding not found (%s) in epoch unbonding record: %d", chainId, epochNumber)
+			k.Logger(ctx).Error(errMsg)
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, errMsg)
+		}
+		if hostZoneUnbonding.Status != from {
+			continue
+		}
+		hostZoneUnbonding.Status = to
+		updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochNumber, chainId, hostZoneUnbonding)
+		if !success {
+			errMsg := fmt.Sprintf("Failed to set host zone epoch unbonding record: epochNumber %d, chainId %s", epochNumber, chainId)
+			k.Logger(ctx).Error(errMsg)
+			return sdkerrors.Wrapf(types.ErrEpochNotFound, errMsg)
+		}
+		k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+	}
+	return nil
+}
+
 func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64) bool {
 	// this function goes through each host zone, and if it's the right time to
 	// initiate an unbonding, it goes and tries to unbond all outstanding records
@@ -309,8 +348,19 @@ func (k Keeper) SweepAllUnbondedTokens(ctx sdk.Context) {
 				_, err = k.SubmitTxsDayEpoch(ctx, hostZone.ConnectionId, msgs, *delegationAccount, REDEMPTION, marshalledCallbackArgs)
 				if err != nil {
 					ctx.Logger().Info(fmt.Sprintf("Failed to SubmitTxs, transfer to redemption account on %s", hostZone.ChainId))
+					return nil
 				}
 				ctx.Logger().Info(fmt.Sprintf("Successfully completed unbonded token sweep ICA call for %s, %s, %v", hostZone.ConnectionId, hostZone.ChainId, msgs))
+
+				err = ctx.EventManager().EmitTypedEvent(&types.EventUnbondingSweep{
+					HostZone:                hostZone.ChainId,
+					Amount:                  totalAmtTransferToRedemptionAcct,
+					EpochUnbondingRecordIds: unbondingEpochNumbers,
+				})
+				if err != nil {
+					k.Logger(ctx).Error(fmt.Sprintf("Error emitting unbonding sweep event for %s: %s", hostZone.ChainId, err))
+				}
+				return nil
 			}
 		} else {
 			k.Logger(ctx).Info(fmt.Sprintf("\tNo unbonded tokens this day to sweep for host zone %s", hostZone.ChainId))
@@ -0,0 +1,17 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+// MigrateParams adds the IcqResponseReward param, which chains that started before v2 don't have, with its default
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeyIcqResponseReward, types.DefaultIcqResponseReward)
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	return params.Validate()
+}
@@ -10,7 +10,6 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
-	"github.com/gogo/protobuf/proto"
 
 	icacallbacktypes "github.com/Stride-Labs/stride/x/icacallbacks/types"
 
@@ -122,6 +121,11 @@ func (im IBCModule) OnChanOpenAck(
 		ctx.Logger().Error(fmt.Sprintf("Missing portId: %s", portID))
 	}
 
+	// if this channel replaced one that closed, the zone may no longer be degraded
+	if _, accountType, found := im.keeper.GetHostZoneFromIcaPort(ctx, portID); found {
+		im.keeper.CompleteIcaRecovery(ctx, &zoneInfo, accountType, portID)
+	}
+
 	im.keeper.SetHostZone(ctx, zoneInfo)
 	return nil
 }
@@ -143,7 +147,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return sdkerrors.Wrapf(types.ErrMarshalFailure, err.Error())
 	}
 
-	txMsgData, err := im.GetTxMsgData(ctx, ack)
+	ackResult, err := icacallbacktypes.UnpackAcknowledgementResult(ack)
 	if err != nil {
 		errMsg := fmt.Sprintf("Unable to unmarshal ack from stakeibc OnAcknowledgePacket | Sequence %d, from %s %s, to %s %s",
 			modulePacket.Sequence, modulePacket.SourceChannel, modulePacket.SourcePort, modulePacket.DestinationChannel, modulePacket.DestinationPort)
@@ -151,17 +155,26 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return sdkerrors.Wrapf(types.ErrMarshalFailure, errMsg)
 	}
 
-	im.keeper.Logger(ctx).Info(fmt.Sprintf("Acknowledgement was successfully unmarshalled: ackInfo: %s, txMsgData: %v", ackInfo, txMsgData))
-	eventType := "ack"
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			eventType,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyAck, fmt.Sprintf("%v", ackInfo)),
-		),
-	)
+	im.keeper.Logger(ctx).Info(fmt.Sprintf("Acknowledgement was successfully unmarshalled: ackInfo: %s, status: %s, error: %s", ackInfo, ackResult.Status.String(), ackResult.Error))
+	callbackId := im.GetCallbackId(ctx, modulePacket)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventIcaAcknowledgement{
+		PortId:     modulePacket.SourcePort,
+		ChannelId:  modulePacket.SourceChannel,
+		Sequence:   modulePacket.Sequence,
+		CallbackId: callbackId,
+		Success:    ack.Success(),
+		Error:      ack.GetError(),
+	})
+	if err != nil {
+		return err
+	}
+	if ack.Success() {
+		im.keeper.IncrIcaCounter(ctx, types.MetricKeyIcaAck, modulePacket.SourcePort, modulePacket.SourceChannel, callbackId)
+	} else {
+		im.keeper.IncrIcaCounter(ctx, types.MetricKeyIcaAckError, modulePacket.SourcePort, modulePacket.SourceChannel, callbackId)
+	}
 
-	err = im.keeper.ICACallbacksKeeper.CallRegisteredICACallback(ctx, modulePacket, txMsgData)
+	err = im.keeper.ICACallbacksKeeper.CallRegisteredICACallback(ctx, modulePacket, ackResult)
 	if err != nil {
 		errMsg := fmt.Sprintf("Unable to call registered callback from stakeibc OnAcknowledgePacket | Sequence %d, from %s %s, to %s %s",
 			modulePacket.Sequence, modulePacket.SourceChannel, modulePacket.SourcePort, modulePacket.DestinationChannel, modulePacket.DestinationPort)
@@ -178,7 +191,24 @@ func (im IBCModule) OnTimeoutPacket(
 	relayer sdk.AccAddress,
 ) error {
 	im.keeper.Logger(ctx).Info(fmt.Sprintf("OnTimeoutPacket: packet %v, relayer %v", modulePacket, relayer))
-	err := im.keeper.ICACallbacksKeeper.CallRegisteredICACallback(ctx, modulePacket, nil)
+	// ICA channels are ordered, so the timeout will close the channel until it's restored
+	if hostZone, _, found := im.keeper.GetHostZoneFromIcaPort(ctx, modulePacket.SourcePort); found {
+		hostZone.Degraded = true
+		im.keeper.SetHostZone(ctx, hostZone)
+	}
+
+	callbackId := im.GetCallbackId(ctx, modulePacket)
+	im.keeper.IncrIcaCounter(ctx, types.MetricKeyIcaTimeout, modulePacket.SourcePort, modulePacket.SourceChannel, callbackId)
+	err := ctx.EventManager().EmitTypedEvent(&types.EventIcaTimeout{
+		PortId:     modulePacket.SourcePort,
+		ChannelId:  modulePacket.SourceChannel,
+		Sequence:   modulePacket.Sequence,
+		CallbackId: callbackId,
+	})
+	if err != nil {
+		return err
+	}
+	err = im.keeper.ICACallbacksKeeper.CallRegisteredICACallback(ctx, modulePacket, icacallbacktypes.NewTimeoutAcknowledgementResult())
 	if err != nil {
 		return err
 	}
@@ -209,33 +239,22 @@ func (im IBCModule) NegotiateAppVersion(
 	return proposedVersion, nil
 }
 
-func (im IBCModule) GetTxMsgData(ctx sdk.Context, ack channeltypes.Acknowledgement) (*sdk.TxMsgData, error) {
-	txMsgData := &sdk.TxMsgData{}
-	switch response := ack.Response.(type) {
-	case *channeltypes.Acknowledgement_Result:
-		if len(response.Result) == 0 {
-			return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "acknowledgement result cannot be empty")
-		}
-		err := proto.Unmarshal(ack.GetResult(), txMsgData)
-		if err != nil {
-			im.keeper.Logger(ctx).Error(fmt.Sprintf("cannot unmarshal ICS-27 tx message data: %s", err.Error()))
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 tx message data: %s", err.Error())
-		}
-		return txMsgData, nil
-	case *channeltypes.Acknowledgement_Error:
-		im.keeper.Logger(ctx).Error(fmt.Sprintf("acknowledgement error: %s", response.Error))
-		return txMsgData, nil
-
-	default:
-		return nil, sdkerrors.Wrapf(channeltypes.ErrInvalidAcknowledgement, "unsupported acknowledgement response field type %T", response)
+// GetCallbackId returns the callback registered for the packet, or an empty string if there is none
+func (im IBCModule) GetCallbackId(ctx sdk.Context, modulePacket channeltypes.Packet) string {
+	callbackDataKey := icacallbacktypes.PacketID(modulePacket.SourcePort, modulePacket.SourceChannel, modulePacket.Sequence)
+	callbackData, found := im.keeper.ICACallbacksKeeper.GetCallbackData(ctx, callbackDataKey)
+	if !found {
+		return ""
 	}
+	return callbackData.CallbackId
 }
 
 // ###################################################################################
-// 	Required functions to satisfy interface but not implemented for ICA auth modules
+// 	Required functions to satisfy interface but not supported for ICA auth modules
 // ###################################################################################
 
-// OnChanOpenTry implements the IBCModule interface
+// OnChanOpenTry implements the IBCModule interface. Stride is always the controller, so channel handshakes
+// initiated from the host side are rejected
 func (im IBCModule) OnChanOpenTry(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -246,32 +265,39 @@ func (im IBCModule) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	panic("UNIMPLEMENTED")
+	im.keeper.Logger(ctx).Error(fmt.Sprintf("OnChanOpenTry: rejecting channel %s on port %s, stakeibc channels must be initiated by stride", channelID, portID))
+	return "", sdkerrors.Wrapf(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by the controller chain")
 }
 
-// OnChanOpenConfirm implements the IBCModule interface
+// OnChanOpenConfirm implements the IBCModule interface. It's only reached on the host side of a handshake,
+// which stakeibc never is
 func (im IBCModule) OnChanOpenConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
-	panic("UNIMPLEMENTED")
+	im.keeper.Logger(ctx).Error(fmt.Sprintf("OnChanOpenConfirm: rejecting channel %s on port %s, stakeibc channels must be initiated by stride", channelID, portID))
+	return sdkerrors.Wrapf(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by the controller chain")
 }
 
-// OnChanCloseInit implements the IBCModule interface
+// OnChanCloseInit implements the IBCModule interface. ICA channels can only be closed through
+// the admin MsgCloseIcaChannel, which closes the channel directly without going through this callback
 func (im IBCModule) OnChanCloseInit(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
-	panic("UNIMPLEMENTED")
+	im.keeper.Logger(ctx).Error(fmt.Sprintf("OnChanCloseInit: rejecting close of channel %s on port %s", channelID, portID))
+	return sdkerrors.Wrapf(icatypes.ErrInvalidChannelFlow, "stakeibc channels can only be closed through MsgCloseIcaChannel")
 }
 
-// OnRecvPacket implements the IBCModule interface
+// OnRecvPacket implements the IBCModule interface. Packets are only ever sent from the controller,
+// so anything received is acknowledged with an error
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	modulePacket channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	panic("UNIMPLEMENTED")
+	im.keeper.Logger(ctx).Error(fmt.Sprintf("OnRecvPacket: rejecting packet %d on port %s, channel %s", modulePacket.Sequence, modulePacket.DestinationPort, modulePacket.DestinationChannel))
+	return channeltypes.NewErrorAcknowledgement("cannot receive packet on controller chain")
 }
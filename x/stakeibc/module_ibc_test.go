@@ -0,0 +1,173 @@
+package stakeibc_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/app/apptesting"
+	"github.com/Stride-Labs/stride/x/stakeibc"
+	"github.com/Stride-Labs/stride/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/x/stakeibc/types"
+)
+
+const adminAddress = "stride1u20df3trc2c2zdhm8qvh2hdjx9ewh00sv6eyy8"
+
+type IBCModuleTestSuite struct {
+	apptesting.AppTestHelper
+	path   *ibctesting.Path
+	module stakeibc.IBCModule
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCModuleTestSuite))
+}
+
+// SetupTest connects stride to a host chain and opens the host zone's delegation ICA channel
+func (s *IBCModuleTestSuite) SetupTest() {
+	s.SetupIBCChains()
+	s.module = stakeibc.NewIBCModule(s.App.StakeibcKeeper)
+
+	s.path = ibctesting.NewPath(s.StrideChain, s.HostChain)
+	s.Coordinator.SetupConnections(s.path)
+
+	hostZone := types.HostZone{
+		ChainId:      s.HostChain.ChainID,
+		ConnectionId: s.path.EndpointA.ConnectionID,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.StrideChain.GetContext(), hostZone)
+
+	owner := types.FormatICAAccountOwner(hostZone.ChainId, types.ICAAccountType_DELEGATION)
+	portId, err := icatypes.NewControllerPortID(owner)
+	s.Require().NoError(err)
+
+	metadata := icatypes.NewMetadata(icatypes.Version, s.path.EndpointA.ConnectionID, s.path.EndpointB.ConnectionID, "", icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+
+	s.path.EndpointA.ChannelConfig.PortID = portId
+	s.path.EndpointA.ChannelConfig.Version = version
+	s.path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	s.path.EndpointB.ChannelConfig.PortID = icatypes.PortID
+	s.path.EndpointB.ChannelConfig.Version = version
+	s.path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	strideCtx := s.StrideChain.GetContext()
+	channelSequence := s.App.IBCKeeper.ChannelKeeper.GetNextChannelSequence(strideCtx)
+	err = s.App.ICAControllerKeeper.RegisterInterchainAccount(strideCtx, s.path.EndpointA.ConnectionID, owner)
+	s.Require().NoError(err)
+	s.path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	s.Coordinator.CommitBlock(s.StrideChain)
+
+	s.Require().NoError(s.path.EndpointB.ChanOpenTry())
+	s.Require().NoError(s.path.EndpointA.ChanOpenAck())
+	s.Require().NoError(s.path.EndpointB.ChanOpenConfirm())
+}
+
+func (s *IBCModuleTestSuite) TestHandshakeOpensDelegationAccount() {
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.StrideChain.GetContext(), s.HostChain.ChainID)
+	s.Require().True(found)
+	s.Require().NotNil(hostZone.DelegationAccount, "delegation account registered")
+	s.Require().NotEmpty(hostZone.DelegationAccount.Address, "delegation account address")
+}
+
+func (s *IBCModuleTestSuite) TestOnChanOpenTryRejected() {
+	counterparty := channeltypes.NewCounterparty(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID)
+	_, err := s.module.OnChanOpenTry(
+		s.StrideChain.GetContext(),
+		channeltypes.ORDERED,
+		[]string{s.path.EndpointA.ConnectionID},
+		s.path.EndpointA.ChannelConfig.PortID,
+		"channel-1",
+		&capabilitytypes.Capability{},
+		counterparty,
+		s.path.EndpointB.ChannelConfig.Version,
+	)
+	s.Require().ErrorIs(err, icatypes.ErrInvalidChannelFlow)
+}
+
+func (s *IBCModuleTestSuite) TestOnChanOpenConfirmRejected() {
+	err := s.module.OnChanOpenConfirm(s.StrideChain.GetContext(), s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+	s.Require().ErrorIs(err, icatypes.ErrInvalidChannelFlow)
+}
+
+func (s *IBCModuleTestSuite) TestOnChanCloseInitRejected() {
+	err := s.module.OnChanCloseInit(s.StrideChain.GetContext(), s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+	s.Require().ErrorIs(err, icatypes.ErrInvalidChannelFlow)
+
+	// a close initiated through a channel message is rejected and the channel stays open
+	closeInit := channeltypes.NewMsgChannelCloseInit(
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		s.StrideChain.SenderAccount.GetAddress().String(),
+	)
+	_, err = s.App.IBCKeeper.ChannelCloseInit(sdk.WrapSDKContext(s.StrideChain.GetContext()), closeInit)
+	s.Require().Error(err)
+	channel := s.path.EndpointA.GetChannel()
+	s.Require().Equal(channeltypes.OPEN, channel.State, "channel state")
+}
+
+func (s *IBCModuleTestSuite) TestOnRecvPacketReturnsErrorAck() {
+	packet := channeltypes.NewPacket(
+		[]byte("data"),
+		1,
+		s.path.EndpointB.ChannelConfig.PortID,
+		s.path.EndpointB.ChannelID,
+		s.path.EndpointA.ChannelConfig.PortID,
+		s.path.EndpointA.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+	ack := s.module.OnRecvPacket(s.StrideChain.GetContext(), packet, sdk.AccAddress{})
+	s.Require().False(ack.Success(), "ack should be an error")
+}
+
+func (s *IBCModuleTestSuite) TestCloseIcaChannel() {
+	msgServer := keeper.NewMsgServerImpl(s.App.StakeibcKeeper)
+	strideCtx := s.StrideChain.GetContext()
+
+	_, err := msgServer.CloseIcaChannel(sdk.WrapSDKContext(strideCtx), &types.MsgCloseIcaChannel{
+		Creator:     adminAddress,
+		ChainId:     s.HostChain.ChainID,
+		AccountType: types.ICAAccountType_DELEGATION,
+	})
+	s.Require().NoError(err)
+
+	channel := s.path.EndpointA.GetChannel()
+	s.Require().Equal(channeltypes.CLOSED, channel.State, "stride channel state")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(strideCtx, s.HostChain.ChainID)
+	s.Require().True(found)
+	s.Require().True(hostZone.Degraded, "host zone degraded")
+
+	// the host should be able to confirm the close
+	s.Coordinator.CommitBlock(s.StrideChain)
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	channelKey := host.ChannelKey(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+	proof, proofHeight := s.StrideChain.QueryProof(channelKey)
+	closeConfirm := channeltypes.NewMsgChannelCloseConfirm(
+		s.path.EndpointB.ChannelConfig.PortID,
+		s.path.EndpointB.ChannelID,
+		proof,
+		proofHeight,
+		s.HostChain.SenderAccount.GetAddress().String(),
+	)
+	_, err = s.HostChain.SendMsgs(closeConfirm)
+	s.Require().NoError(err)
+	channel = s.path.EndpointB.GetChannel()
+	s.Require().Equal(channeltypes.CLOSED, channel.State, "host channel state")
+
+	// closing a zone that isn't registered fails
+	_, err = msgServer.CloseIcaChannel(sdk.WrapSDKContext(s.StrideChain.GetContext()), &types.MsgCloseIcaChannel{
+		Creator:     adminAddress,
+		ChainId:     "OSMO",
+		AccountType: types.ICAAccountType_DELEGATION,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidHostZone)
+}
@@ -34,4 +34,7 @@ var (
 	ErrInvalidPacketCompletionTime = sdkerrors.Register(ModuleName, 1524, "invalid packet completion time")
 	ErrIntCast                     = sdkerrors.Register(ModuleName, 1525, "unable to cast to safe cast int")
 	ErrFeeAccountNotRegistered     = sdkerrors.Register(ModuleName, 1526, "fee account is not registered")
+	ErrSlippageExceeded            = sdkerrors.Register(ModuleName, 1527, "output amount is below the requested minimum")
+	ErrHostZoneDegraded            = sdkerrors.Register(ModuleName, 1528, "host zone has an ICA channel that is not open")
+	ErrInvalidUnbondingFrequency   = sdkerrors.Register(ModuleName, 1529, "invalid unbonding frequency")
 )
@@ -14,6 +14,7 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		ICAAccount:        nil,
 		EpochTrackerList:  []EpochTracker{},
+		IcaBalanceList:    []HostZoneIcaBalance{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 		PortId: PortID,
@@ -37,6 +38,26 @@ func (gs GenesisState) Validate() error {
 		epochTrackerIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated index and invalid amounts in icaBalance
+	icaBalanceIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.IcaBalanceList {
+		if elem.ChainId == "" {
+			return fmt.Errorf("icaBalance is missing a chain id")
+		}
+		if _, ok := ICAAccountType_name[int32(elem.AccountType)]; !ok {
+			return fmt.Errorf("invalid account type %d for icaBalance of %s", elem.AccountType, elem.ChainId)
+		}
+		index := string(IcaBalanceKey(elem.ChainId, elem.AccountType))
+		if _, ok := icaBalanceIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for icaBalance")
+		}
+		icaBalanceIndexMap[index] = struct{}{}
+		if elem.Balance.Amount.IsNil() || elem.Balance.Amount.IsNegative() {
+			return fmt.Errorf("invalid icaBalance amount for %s %s", elem.ChainId, elem.AccountType)
+		}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
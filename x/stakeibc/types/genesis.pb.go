@@ -34,6 +34,8 @@ type GenesisState struct {
 	// stores a map from hostZone base denom to hostZone
 	DenomToHostZone  map[string]string `protobuf:"bytes,9,rep,name=denomToHostZone,proto3" json:"denomToHostZone,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	EpochTrackerList []EpochTracker    `protobuf:"bytes,10,rep,name=epochTrackerList,proto3" json:"epochTrackerList"`
+	// last balances of the host zone ICAs reported by interchain queries
+	IcaBalanceList []HostZoneIcaBalance `protobuf:"bytes,12,rep,name=icaBalanceList,proto3" json:"icaBalanceList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,6 +120,13 @@ func (m *GenesisState) GetEpochTrackerList() []EpochTracker {
 	return nil
 }
 
+func (m *GenesisState) GetIcaBalanceList() []HostZoneIcaBalance {
+	if m != nil {
+		return m.IcaBalanceList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "Stridelabs.stride.stakeibc.GenesisState")
 	proto.RegisterMapType((map[string]string)(nil), "Stridelabs.stride.stakeibc.GenesisState.DenomToHostZoneEntry")
@@ -126,35 +135,37 @@ func init() {
 func init() { proto.RegisterFile("stakeibc/genesis.proto", fileDescriptor_b132bbaf7441a735) }
 
 var fileDescriptor_b132bbaf7441a735 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0xaf, 0xd7, 0xac, 0xac, 0x6e, 0x11, 0x95, 0x55, 0x20, 0x8a, 0x50, 0x88, 0xa6, 0x09, 0xe5,
-	0x82, 0x23, 0x8d, 0x0b, 0x42, 0x42, 0x62, 0x1d, 0x83, 0x6d, 0x9a, 0x10, 0xca, 0x76, 0xea, 0x25,
-	0x72, 0x12, 0x2b, 0xb5, 0xba, 0xc6, 0x51, 0xec, 0x22, 0xca, 0xa7, 0xe0, 0x03, 0xf1, 0x01, 0x76,
-	0xec, 0x91, 0x13, 0x42, 0xed, 0x17, 0x41, 0xb1, 0xdd, 0xa8, 0x05, 0x96, 0xdb, 0x7b, 0x79, 0xbf,
-	0x7f, 0x2f, 0xcf, 0xf0, 0x89, 0x90, 0x64, 0x4a, 0x59, 0x9c, 0x04, 0x19, 0xcd, 0xa9, 0x60, 0x02,
-	0x17, 0x25, 0x97, 0x1c, 0x39, 0xd7, 0xb2, 0x64, 0x29, 0xbd, 0x25, 0xb1, 0xc0, 0x42, 0x95, 0x78,
-	0x83, 0x74, 0x86, 0x19, 0xcf, 0xb8, 0x82, 0x05, 0x55, 0xa5, 0x19, 0xce, 0xe3, 0x5a, 0xa9, 0x20,
-	0x25, 0x99, 0x19, 0x21, 0xc7, 0xa9, 0x3f, 0xb3, 0x84, 0x44, 0x24, 0x49, 0xf8, 0x3c, 0x97, 0x66,
-	0x66, 0xd7, 0xb3, 0x09, 0x17, 0x32, 0xfa, 0xc6, 0x73, 0x6a, 0x26, 0xcf, 0xea, 0x09, 0x2d, 0x78,
-	0x32, 0x89, 0x64, 0x49, 0x92, 0x29, 0x2d, 0xf5, 0xf4, 0xf0, 0x87, 0x05, 0xfb, 0x1f, 0x75, 0xdc,
-	0x6b, 0x49, 0x24, 0x45, 0xef, 0x60, 0x47, 0x9b, 0xda, 0xc0, 0x03, 0x7e, 0xef, 0xf8, 0x10, 0xdf,
-	0x1f, 0x1f, 0x7f, 0x56, 0xc8, 0x91, 0x75, 0xf7, 0xeb, 0x79, 0x2b, 0x34, 0x3c, 0xf4, 0x14, 0x3e,
-	0x28, 0x78, 0x29, 0x23, 0x96, 0xda, 0x7b, 0x1e, 0xf0, 0xbb, 0x61, 0xa7, 0x6a, 0x2f, 0x52, 0xf4,
-	0x01, 0x42, 0x76, 0x7a, 0x72, 0xa2, 0x73, 0xdb, 0x96, 0x92, 0x7f, 0xd1, 0x24, 0x7f, 0x51, 0xa3,
-	0xc3, 0x2d, 0x26, 0xfa, 0x04, 0xfb, 0xd5, 0x92, 0x63, 0x9e, 0xd3, 0x2b, 0x26, 0xa4, 0xbd, 0xef,
-	0xb5, 0xfd, 0xde, 0xf1, 0x51, 0x93, 0xd2, 0xb9, 0xc1, 0x9b, 0xa8, 0x3b, 0x7c, 0x74, 0x04, 0x1f,
-	0x6e, 0xfa, 0x53, 0x15, 0xad, 0xe3, 0x01, 0xdf, 0x0a, 0x77, 0x3f, 0xa2, 0x0c, 0x3e, 0x4a, 0x69,
-	0xce, 0x67, 0x37, 0x7c, 0x23, 0x66, 0x77, 0x95, 0xf1, 0xdb, 0x26, 0xe3, 0xed, 0x7f, 0x8b, 0xdf,
-	0xef, 0xf2, 0xcf, 0x72, 0x59, 0x2e, 0xc2, 0xbf, 0x55, 0xd1, 0x18, 0x0e, 0xd4, 0xa5, 0x6e, 0xf4,
-	0xa1, 0xd4, 0x8a, 0x50, 0x39, 0xf9, 0x4d, 0x4e, 0x67, 0x5b, 0x1c, 0xb3, 0xe6, 0x3f, 0x3a, 0xce,
-	0x08, 0x0e, 0xff, 0x17, 0x02, 0x0d, 0x60, 0x7b, 0x4a, 0x17, 0xea, 0xe4, 0xdd, 0xb0, 0x2a, 0xd1,
-	0x10, 0xee, 0x7f, 0x21, 0xb7, 0x73, 0x6a, 0x6e, 0xa8, 0x9b, 0x37, 0x7b, 0xaf, 0xc1, 0xa5, 0x75,
-	0xd0, 0x1e, 0x58, 0x97, 0xd6, 0x41, 0x6f, 0xd0, 0x1f, 0x9d, 0xdf, 0xad, 0x5c, 0xb0, 0x5c, 0xb9,
-	0xe0, 0xf7, 0xca, 0x05, 0xdf, 0xd7, 0x6e, 0x6b, 0xb9, 0x76, 0x5b, 0x3f, 0xd7, 0x6e, 0x6b, 0x8c,
-	0x33, 0x26, 0x27, 0xf3, 0x18, 0x27, 0x7c, 0x16, 0xe8, 0xd4, 0x2f, 0xaf, 0x48, 0x2c, 0x02, 0x1d,
-	0x3b, 0xf8, 0x1a, 0xd4, 0xcf, 0x52, 0x2e, 0x0a, 0x2a, 0xe2, 0x8e, 0x7a, 0x8f, 0xaf, 0xfe, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x4c, 0xec, 0x8f, 0x2a, 0x46, 0x03, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8b, 0x13, 0x31,
+	0x14, 0xc7, 0x9b, 0xed, 0xb4, 0x6e, 0xd3, 0xaa, 0x25, 0x54, 0x1d, 0x06, 0x19, 0xcb, 0xb2, 0xc8,
+	0x5c, 0xcc, 0xc0, 0x7a, 0x11, 0x41, 0x70, 0xbb, 0xae, 0x6e, 0x97, 0x45, 0x64, 0x76, 0x4f, 0x45,
+	0x28, 0x99, 0x34, 0x4c, 0x43, 0xdb, 0xc9, 0x30, 0x49, 0xc5, 0xfa, 0x29, 0x3c, 0xfb, 0x89, 0xf6,
+	0xb8, 0x47, 0x4f, 0x22, 0xed, 0x17, 0x91, 0x26, 0xe9, 0xd0, 0xae, 0x3a, 0xec, 0x2d, 0xc9, 0x7b,
+	0xff, 0xdf, 0xfb, 0xbf, 0x97, 0x07, 0x1f, 0x4b, 0x45, 0x26, 0x8c, 0xc7, 0x34, 0x4c, 0x58, 0xca,
+	0x24, 0x97, 0x38, 0xcb, 0x85, 0x12, 0xc8, 0xbb, 0x54, 0x39, 0x1f, 0xb1, 0x29, 0x89, 0x25, 0x96,
+	0xfa, 0x88, 0x37, 0x99, 0x5e, 0x27, 0x11, 0x89, 0xd0, 0x69, 0xe1, 0xfa, 0x64, 0x14, 0xde, 0xa3,
+	0x82, 0x94, 0x91, 0x9c, 0xcc, 0x2c, 0xc8, 0xf3, 0x8a, 0x67, 0x4e, 0xc9, 0x90, 0x50, 0x2a, 0xe6,
+	0xa9, 0xb2, 0x31, 0xb7, 0x88, 0x8d, 0x85, 0x54, 0xc3, 0x6f, 0x22, 0x65, 0x36, 0xf2, 0xb4, 0x88,
+	0xb0, 0x4c, 0xd0, 0xf1, 0x50, 0xe5, 0x84, 0x4e, 0x58, 0x6e, 0xa2, 0x07, 0x3f, 0x6a, 0xb0, 0xf5,
+	0xc1, 0xd8, 0xbd, 0x54, 0x44, 0x31, 0xf4, 0x16, 0xd6, 0x4d, 0x51, 0x17, 0x74, 0x41, 0xd0, 0x3c,
+	0x3a, 0xc0, 0xff, 0xb7, 0x8f, 0x3f, 0xe9, 0xcc, 0x9e, 0x73, 0xfd, 0xeb, 0x59, 0x25, 0xb2, 0x3a,
+	0xf4, 0x04, 0xde, 0xcb, 0x44, 0xae, 0x86, 0x7c, 0xe4, 0xee, 0x75, 0x41, 0xd0, 0x88, 0xea, 0xeb,
+	0x6b, 0x7f, 0x84, 0xde, 0x43, 0xc8, 0x4f, 0x8e, 0x8f, 0x8d, 0x6f, 0xd7, 0xd1, 0xf8, 0xe7, 0x65,
+	0xf8, 0x7e, 0x91, 0x1d, 0x6d, 0x29, 0xd1, 0x47, 0xd8, 0x5a, 0x37, 0x39, 0x10, 0x29, 0xbb, 0xe0,
+	0x52, 0xb9, 0xb5, 0x6e, 0x35, 0x68, 0x1e, 0x1d, 0x96, 0x91, 0xce, 0x6c, 0xbe, 0xb5, 0xba, 0xa3,
+	0x47, 0x87, 0xf0, 0xfe, 0xe6, 0x7e, 0xa2, 0xad, 0xd5, 0xbb, 0x20, 0x70, 0xa2, 0xdd, 0x47, 0x94,
+	0xc0, 0x87, 0x23, 0x96, 0x8a, 0xd9, 0x95, 0xd8, 0xc0, 0xdc, 0x86, 0x2e, 0xfc, 0xa6, 0xac, 0xf0,
+	0xf6, 0x6c, 0xf1, 0xbb, 0x5d, 0xfd, 0x69, 0xaa, 0xf2, 0x45, 0x74, 0x9b, 0x8a, 0x06, 0xb0, 0xad,
+	0x7f, 0xea, 0xca, 0x7c, 0x94, 0x6e, 0x11, 0xea, 0x4a, 0x41, 0x59, 0xa5, 0xd3, 0x2d, 0x8d, 0x6d,
+	0xf3, 0x2f, 0x0e, 0xfa, 0x0c, 0x1f, 0x70, 0x4a, 0x7a, 0x64, 0x4a, 0x52, 0x6a, 0x86, 0xd7, 0xd2,
+	0x64, 0x7c, 0x97, 0xe1, 0xf5, 0x0b, 0xa5, 0xe5, 0xdf, 0x62, 0x79, 0x3d, 0xd8, 0xf9, 0x57, 0x8b,
+	0xa8, 0x0d, 0xab, 0x13, 0xb6, 0xd0, 0x0b, 0xd5, 0x88, 0xd6, 0x47, 0xd4, 0x81, 0xb5, 0x2f, 0x64,
+	0x3a, 0x67, 0x76, 0x43, 0xcc, 0xe5, 0xf5, 0xde, 0x2b, 0x70, 0xee, 0xec, 0x57, 0xdb, 0xce, 0xb9,
+	0xb3, 0xdf, 0x6c, 0xb7, 0x7a, 0x67, 0xd7, 0x4b, 0x1f, 0xdc, 0x2c, 0x7d, 0xf0, 0x7b, 0xe9, 0x83,
+	0xef, 0x2b, 0xbf, 0x72, 0xb3, 0xf2, 0x2b, 0x3f, 0x57, 0x7e, 0x65, 0x80, 0x13, 0xae, 0xc6, 0xf3,
+	0x18, 0x53, 0x31, 0x0b, 0x8d, 0xf3, 0x17, 0x17, 0x24, 0x96, 0xa1, 0xb1, 0x1e, 0x7e, 0x0d, 0x8b,
+	0xa5, 0x57, 0x8b, 0x8c, 0xc9, 0xb8, 0xae, 0xb7, 0xfd, 0xe5, 0x9f, 0x01, 0x00, 0x79, 0x73, 0x20,
+	0x62, 0xa4, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -177,6 +188,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IcaBalanceList) > 0 {
+		for iNdEx := len(m.IcaBalanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaBalanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.EpochTrackerList) > 0 {
 		for iNdEx := len(m.EpochTrackerList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -311,6 +336,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.IcaBalanceList) > 0 {
+		for _, e := range m.IcaBalanceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -664,6 +695,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaBalanceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaBalanceList = append(m.IcaBalanceList, HostZoneIcaBalance{})
+			if err := m.IcaBalanceList[len(m.IcaBalanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Stride-Labs/stride/x/stakeibc/types"
@@ -38,6 +39,60 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid ica balances",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IcaBalanceList: []types.HostZoneIcaBalance{
+					{ChainId: "GAIA", AccountType: types.ICAAccountType_FEE, Balance: types.IcaBalance{Amount: sdk.NewInt(10)}},
+					{ChainId: "GAIA", AccountType: types.ICAAccountType_WITHDRAWAL, Balance: types.IcaBalance{Amount: sdk.ZeroInt()}},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated ica balance",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IcaBalanceList: []types.HostZoneIcaBalance{
+					{ChainId: "GAIA", AccountType: types.ICAAccountType_FEE, Balance: types.IcaBalance{Amount: sdk.NewInt(10)}},
+					{ChainId: "GAIA", AccountType: types.ICAAccountType_FEE, Balance: types.IcaBalance{Amount: sdk.NewInt(20)}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "ica balance without chain id",
+			genState: &types.GenesisState{
+				PortId:         types.PortID,
+				IcaBalanceList: []types.HostZoneIcaBalance{{AccountType: types.ICAAccountType_FEE, Balance: types.IcaBalance{Amount: sdk.NewInt(10)}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "ica balance with invalid account type",
+			genState: &types.GenesisState{
+				PortId:         types.PortID,
+				IcaBalanceList: []types.HostZoneIcaBalance{{ChainId: "GAIA", AccountType: 9, Balance: types.IcaBalance{Amount: sdk.NewInt(10)}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative ica balance",
+			genState: &types.GenesisState{
+				PortId:         types.PortID,
+				IcaBalanceList: []types.HostZoneIcaBalance{{ChainId: "GAIA", AccountType: types.ICAAccountType_FEE, Balance: types.IcaBalance{Amount: sdk.NewInt(-1)}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "ica balance without amount",
+			genState: &types.GenesisState{
+				PortId:         types.PortID,
+				IcaBalanceList: []types.HostZoneIcaBalance{{ChainId: "GAIA", AccountType: types.ICAAccountType_FEE}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
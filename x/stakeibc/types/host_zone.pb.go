@@ -26,7 +26,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// next id: 18
+// next id: 20
 type HostZone struct {
 	ChainId               string       `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
 	ConnectionId          string       `protobuf:"bytes,2,opt,name=connectionId,proto3" json:"connectionId,omitempty"`
@@ -50,6 +50,11 @@ type HostZone struct {
 	UnbondingFrequency uint64 `protobuf:"varint,14,opt,name=unbondingFrequency,proto3" json:"unbondingFrequency,omitempty"`
 	//TODO(TEST-101) int to dec
 	StakedBal uint64 `protobuf:"varint,13,opt,name=stakedBal,proto3" json:"stakedBal,omitempty"`
+	// set while one of the zone's ICA channels is closed or being reopened, the
+	// epoch's ICA txs are held off until it's cleared
+	Degraded bool `protobuf:"varint,18,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	// ICA accounts whose closed channels have been reopened but are not yet OPEN
+	RecoveringAccounts []ICAAccountType `protobuf:"varint,19,rep,packed,name=recoveringAccounts,proto3,enum=Stridelabs.stride.stakeibc.ICAAccountType" json:"recoveringAccounts,omitempty"`
 }
 
 func (m *HostZone) Reset()         { *m = HostZone{} }
@@ -183,6 +188,20 @@ func (m *HostZone) GetStakedBal() uint64 {
 	return 0
 }
 
+func (m *HostZone) GetDegraded() bool {
+	if m != nil {
+		return m.Degraded
+	}
+	return false
+}
+
+func (m *HostZone) GetRecoveringAccounts() []ICAAccountType {
+	if m != nil {
+		return m.RecoveringAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*HostZone)(nil), "Stridelabs.stride.stakeibc.HostZone")
 }
@@ -190,43 +209,45 @@ func init() {
 func init() { proto.RegisterFile("stakeibc/host_zone.proto", fileDescriptor_a1d300c62c2b2d54) }
 
 var fileDescriptor_a1d300c62c2b2d54 = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0xc7, 0xb3, 0xb4, 0x34, 0x89, 0x53, 0x4a, 0x62, 0x51, 0x69, 0x89, 0xd0, 0x36, 0xaa, 0x44,
-	0x95, 0x03, 0xd9, 0x95, 0xd2, 0x2b, 0x97, 0x26, 0xa1, 0x6a, 0x50, 0x4f, 0x0b, 0xea, 0xa1, 0x1c,
-	0x22, 0xaf, 0x3d, 0xd9, 0xb5, 0xb2, 0xb1, 0xc3, 0xda, 0xa1, 0x2d, 0x4f, 0xc1, 0xc3, 0xf0, 0x10,
-	0x3d, 0x56, 0x9c, 0x10, 0x87, 0x0a, 0x25, 0x37, 0x9e, 0x02, 0xed, 0x47, 0x3e, 0x68, 0x02, 0x52,
-	0x24, 0x4e, 0x6b, 0xcf, 0xff, 0x3f, 0xbf, 0x1d, 0xcd, 0xd8, 0x46, 0xa6, 0xd2, 0x64, 0x00, 0xdc,
-	0xa3, 0x4e, 0x20, 0x95, 0xee, 0x7d, 0x96, 0x02, 0xec, 0x51, 0x24, 0xb5, 0xc4, 0xd5, 0x77, 0x3a,
-	0xe2, 0x0c, 0x42, 0xe2, 0x29, 0x5b, 0x25, 0x4b, 0x7b, 0xe6, 0xad, 0x2e, 0xb2, 0x3e, 0x91, 0x90,
-	0x33, 0xa2, 0x65, 0x94, 0x66, 0x55, 0xab, 0x73, 0x85, 0x53, 0xd2, 0x23, 0x94, 0xca, 0xb1, 0xd0,
-	0x99, 0xf6, 0xcc, 0x97, 0xbe, 0x4c, 0x96, 0x4e, 0xbc, 0xca, 0xa2, 0xcf, 0xa9, 0x54, 0x43, 0xa9,
-	0x7a, 0xa9, 0x90, 0x6e, 0x32, 0x69, 0x3f, 0x02, 0x2a, 0x23, 0xa6, 0x1c, 0x1f, 0x04, 0x28, 0x9e,
-	0x85, 0x0f, 0x7f, 0xe5, 0x51, 0xe1, 0x4c, 0x2a, 0x7d, 0x29, 0x05, 0x60, 0x13, 0xe5, 0x69, 0x40,
-	0xb8, 0xe8, 0x32, 0xd3, 0xa8, 0x19, 0xf5, 0xa2, 0x3b, 0xdb, 0xe2, 0x43, 0xb4, 0x4b, 0xa5, 0x10,
-	0x40, 0x35, 0x97, 0xb1, 0xfc, 0x28, 0x91, 0xff, 0x88, 0xc5, 0x1e, 0x0f, 0x68, 0x70, 0xdc, 0x1c,
-	0x45, 0xd0, 0xe7, 0xd7, 0x66, 0x25, 0xf5, 0x2c, 0xc7, 0xf0, 0x2b, 0x54, 0xd1, 0x11, 0x11, 0xaa,
-	0x0f, 0x51, 0x3b, 0x20, 0x42, 0x40, 0xd8, 0x65, 0xe6, 0x6e, 0x62, 0x5c, 0x15, 0xf0, 0x1b, 0x84,
-	0xe6, 0x3d, 0x51, 0xe6, 0x56, 0x6d, 0xab, 0x5e, 0x6a, 0xbe, 0xb4, 0xff, 0xde, 0x4b, 0xfb, 0x62,
-	0xe6, 0x76, 0x97, 0x12, 0xf1, 0x07, 0xb4, 0xef, 0x85, 0x84, 0x0e, 0x42, 0xae, 0x34, 0xb0, 0x8b,
-	0x05, 0x71, 0x7b, 0x13, 0xe2, 0x7a, 0x06, 0x7e, 0x8f, 0x2a, 0x57, 0x5c, 0x07, 0x2c, 0x22, 0x57,
-	0x24, 0x3c, 0x49, 0x67, 0x64, 0x3e, 0xae, 0x19, 0xf5, 0x52, 0xf3, 0xe8, 0x5f, 0xe0, 0x6e, 0xfb,
-	0x24, 0x73, 0xbb, 0xab, 0x00, 0x7c, 0x8a, 0x50, 0x1f, 0x60, 0x86, 0xdb, 0xd9, 0x08, 0xb7, 0x94,
-	0x19, 0x57, 0xc7, 0x20, 0x04, 0x9f, 0xc4, 0x33, 0x9a, 0xe1, 0xf2, 0x9b, 0x55, 0xb7, 0x02, 0x88,
-	0xa9, 0x11, 0x30, 0x18, 0x8e, 0x96, 0xa9, 0xe5, 0xcd, 0xa8, 0x2b, 0x00, 0x5c, 0x45, 0x85, 0x6e,
-	0xab, 0xdd, 0x01, 0x21, 0x87, 0x66, 0x21, 0x39, 0x12, 0xf3, 0x3d, 0x7e, 0x81, 0x8a, 0xf1, 0x29,
-	0x4d, 0xc5, 0x62, 0x22, 0x2e, 0x02, 0x38, 0x44, 0xf8, 0x9c, 0x28, 0xed, 0xce, 0x91, 0x2e, 0xd1,
-	0x60, 0xa2, 0xd8, 0xd6, 0x7a, 0x7d, 0x7b, 0x7f, 0x90, 0xfb, 0x71, 0x7f, 0x70, 0xe4, 0x73, 0x1d,
-	0x8c, 0x3d, 0x9b, 0xca, 0x61, 0x76, 0x31, 0xb2, 0x4f, 0x43, 0xb1, 0x81, 0xa3, 0x6f, 0x46, 0xa0,
-	0xec, 0x0e, 0xd0, 0x6f, 0x5f, 0x1b, 0x28, 0xbb, 0x37, 0x1d, 0xa0, 0xee, 0x1a, 0x2e, 0x66, 0x68,
-	0xef, 0xc1, 0x9f, 0x4a, 0xff, 0xe1, 0x4f, 0x0f, 0x98, 0xd8, 0x46, 0x78, 0x2c, 0x3c, 0x29, 0x18,
-	0x17, 0xfe, 0x69, 0x04, 0x1f, 0xc7, 0x20, 0xe8, 0x8d, 0xb9, 0x57, 0x33, 0xea, 0xdb, 0xee, 0x1a,
-	0x25, 0xee, 0x50, 0xd2, 0x67, 0xd6, 0x22, 0xa1, 0xf9, 0x24, 0xb1, 0x2d, 0x02, 0x6f, 0xb7, 0x0b,
-	0x4f, 0xcb, 0xe5, 0xd6, 0xd9, 0xed, 0xc4, 0x32, 0xee, 0x26, 0x96, 0xf1, 0x73, 0x62, 0x19, 0x5f,
-	0xa6, 0x56, 0xee, 0x6e, 0x6a, 0xe5, 0xbe, 0x4f, 0xad, 0xdc, 0xa5, 0xbd, 0x54, 0x73, 0x3a, 0xc0,
-	0xc6, 0x39, 0xf1, 0x94, 0x93, 0x4e, 0xd0, 0xb9, 0x76, 0xe6, 0x4f, 0x51, 0x52, 0xbf, 0xb7, 0x93,
-	0xbc, 0x1e, 0xc7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x57, 0x06, 0xf6, 0x6a, 0xf3, 0x04, 0x00,
-	0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x4e, 0x1b, 0x31,
+	0x10, 0xc6, 0xb3, 0x85, 0x42, 0x30, 0x94, 0x82, 0x5b, 0x24, 0x37, 0xaa, 0x42, 0x84, 0x54, 0x14,
+	0x55, 0x65, 0x57, 0x0a, 0xd7, 0x5e, 0x08, 0x14, 0x91, 0x8a, 0xd3, 0x16, 0x71, 0xa0, 0x07, 0xe4,
+	0xb5, 0x87, 0x8d, 0xc5, 0xc6, 0x4e, 0x6d, 0x87, 0x3f, 0x7d, 0x8a, 0x3e, 0x4c, 0x1f, 0x82, 0x23,
+	0xea, 0xa9, 0xea, 0x01, 0x55, 0xe1, 0x45, 0x2a, 0xef, 0x6e, 0x36, 0x29, 0x49, 0xab, 0x46, 0xea,
+	0x29, 0x9e, 0xf9, 0xbe, 0xf9, 0xcd, 0x6a, 0xc6, 0x31, 0x22, 0xc6, 0xd2, 0x73, 0x10, 0x11, 0x0b,
+	0xda, 0xca, 0xd8, 0xd3, 0xcf, 0x4a, 0x82, 0xdf, 0xd5, 0xca, 0x2a, 0x5c, 0xf9, 0x60, 0xb5, 0xe0,
+	0x90, 0xd0, 0xc8, 0xf8, 0x26, 0x3d, 0xfa, 0x03, 0x6f, 0x65, 0x58, 0x75, 0x41, 0x13, 0xc1, 0xa9,
+	0x55, 0x3a, 0xab, 0xaa, 0x54, 0x0a, 0x45, 0x30, 0x7a, 0x4a, 0x19, 0x53, 0x3d, 0x69, 0x73, 0xed,
+	0x79, 0xac, 0x62, 0x95, 0x1e, 0x03, 0x77, 0xca, 0xb3, 0x2f, 0x98, 0x32, 0x1d, 0x65, 0x4e, 0x33,
+	0x21, 0x0b, 0x72, 0x69, 0x4d, 0x03, 0x53, 0x9a, 0x9b, 0x20, 0x06, 0x09, 0x46, 0xe4, 0xe9, 0x8d,
+	0x7e, 0x19, 0x95, 0x0f, 0x94, 0xb1, 0x27, 0x4a, 0x02, 0x26, 0x68, 0x9e, 0xb5, 0xa9, 0x90, 0x2d,
+	0x4e, 0xbc, 0x9a, 0x57, 0x5f, 0x08, 0x07, 0x21, 0xde, 0x40, 0x4b, 0x4c, 0x49, 0x09, 0xcc, 0x0a,
+	0xe5, 0xe4, 0x47, 0xa9, 0xfc, 0x5b, 0xce, 0x79, 0x22, 0x60, 0xed, 0xed, 0x46, 0x57, 0xc3, 0x99,
+	0xb8, 0x22, 0xab, 0x99, 0x67, 0x34, 0x87, 0xdf, 0xa0, 0x55, 0xab, 0xa9, 0x34, 0x67, 0xa0, 0x77,
+	0xdb, 0x54, 0x4a, 0x48, 0x5a, 0x9c, 0x2c, 0xa5, 0xc6, 0x71, 0x01, 0xbf, 0x43, 0xa8, 0x98, 0x89,
+	0x21, 0x33, 0xb5, 0x99, 0xfa, 0x62, 0xe3, 0x95, 0xff, 0xe7, 0x59, 0xfa, 0xc7, 0x03, 0x77, 0x38,
+	0x52, 0x88, 0x3f, 0xa2, 0xb5, 0x28, 0xa1, 0xec, 0x3c, 0x11, 0xc6, 0x02, 0x3f, 0x1e, 0x12, 0x67,
+	0xa7, 0x21, 0x4e, 0x66, 0xe0, 0x23, 0xb4, 0x7a, 0x29, 0x6c, 0x9b, 0x6b, 0x7a, 0x49, 0x93, 0x9d,
+	0x6c, 0x47, 0xe4, 0x71, 0xcd, 0xab, 0x2f, 0x36, 0x36, 0xff, 0x06, 0x6e, 0xed, 0xee, 0xe4, 0xee,
+	0x70, 0x1c, 0x80, 0xf7, 0x11, 0x3a, 0x03, 0x18, 0xe0, 0xe6, 0xa6, 0xc2, 0x8d, 0x54, 0xba, 0xaf,
+	0xe3, 0x90, 0x40, 0x4c, 0xdd, 0x8e, 0x06, 0xb8, 0xf9, 0xe9, 0xbe, 0x6e, 0x0c, 0xe0, 0xa8, 0x1a,
+	0x38, 0x74, 0xba, 0xa3, 0xd4, 0x95, 0xe9, 0xa8, 0x63, 0x00, 0x5c, 0x41, 0xe5, 0x56, 0x73, 0x77,
+	0x0f, 0xa4, 0xea, 0x90, 0x72, 0x7a, 0x25, 0x8a, 0x18, 0xbf, 0x44, 0x0b, 0xee, 0x96, 0x66, 0xe2,
+	0x42, 0x2a, 0x0e, 0x13, 0x38, 0x41, 0xf8, 0x90, 0x1a, 0x1b, 0x16, 0xc8, 0x90, 0x5a, 0x20, 0xc8,
+	0xd9, 0x9a, 0x6f, 0x6f, 0xee, 0xd6, 0x4b, 0x3f, 0xee, 0xd6, 0x37, 0x63, 0x61, 0xdb, 0xbd, 0xc8,
+	0x67, 0xaa, 0x93, 0xff, 0x31, 0xf2, 0x9f, 0x2d, 0xc3, 0xcf, 0x03, 0x7b, 0xdd, 0x05, 0xe3, 0xef,
+	0x01, 0xfb, 0xf6, 0x75, 0x0b, 0x65, 0x79, 0x17, 0x85, 0x13, 0xb8, 0x98, 0xa3, 0xe5, 0x07, 0x9d,
+	0x16, 0xff, 0x43, 0xa7, 0x07, 0x4c, 0xec, 0x23, 0xdc, 0x93, 0x91, 0x92, 0x5c, 0xc8, 0x78, 0x5f,
+	0xc3, 0xa7, 0x1e, 0x48, 0x76, 0x4d, 0x96, 0x6b, 0x5e, 0x7d, 0x36, 0x9c, 0xa0, 0xb8, 0x09, 0xa5,
+	0x73, 0xe6, 0x4d, 0x9a, 0x90, 0x27, 0xa9, 0x6d, 0x98, 0x70, 0xb3, 0xe5, 0x10, 0x6b, 0xca, 0x81,
+	0x13, 0x5c, 0xf3, 0xea, 0xe5, 0xb0, 0x88, 0xf1, 0x09, 0xc2, 0xee, 0x6d, 0xb8, 0x00, 0x2d, 0x64,
+	0x9c, 0x2f, 0xc3, 0x90, 0x67, 0xb5, 0x99, 0xfa, 0x72, 0xe3, 0xf5, 0xbf, 0xad, 0xf3, 0xe8, 0xba,
+	0x0b, 0xe1, 0x04, 0xca, 0xfb, 0xd9, 0xf2, 0xd3, 0x95, 0x95, 0xe6, 0xc1, 0x4d, 0xbf, 0xea, 0xdd,
+	0xf6, 0xab, 0xde, 0xcf, 0x7e, 0xd5, 0xfb, 0x72, 0x5f, 0x2d, 0xdd, 0xde, 0x57, 0x4b, 0xdf, 0xef,
+	0xab, 0xa5, 0x13, 0x7f, 0x64, 0x56, 0x59, 0xa7, 0xad, 0x43, 0x1a, 0x99, 0x20, 0x6b, 0x15, 0x5c,
+	0x05, 0xc5, 0x13, 0x98, 0xce, 0x2d, 0x9a, 0x4b, 0x5f, 0xad, 0xed, 0x5f, 0x03, 0x00, 0x62, 0xcb,
+	0xad, 0x33, 0x6b, 0x05, 0x00, 0x00,
 }
 
 func (m *HostZone) Marshal() (dAtA []byte, err error) {
@@ -249,6 +270,38 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecoveringAccounts) > 0 {
+		dAtA2 := make([]byte, len(m.RecoveringAccounts)*10)
+		var j1 int
+		for _, num := range m.RecoveringAccounts {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintHostZone(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Degraded {
+		i--
+		if m.Degraded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.Bech32Prefix) > 0 {
 		i -= len(m.Bech32Prefix)
 		copy(dAtA[i:], m.Bech32Prefix)
@@ -483,6 +536,16 @@ func (m *HostZone) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovHostZone(uint64(l))
 	}
+	if m.Degraded {
+		n += 3
+	}
+	if len(m.RecoveringAccounts) > 0 {
+		l = 0
+		for _, e := range m.RecoveringAccounts {
+			l += sovHostZone(uint64(e))
+		}
+		n += 2 + sovHostZone(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1031,6 +1094,95 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			}
 			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Degraded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Degraded = bool(v != 0)
+		case 19:
+			if wireType == 0 {
+				var v ICAAccountType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHostZone
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ICAAccountType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RecoveringAccounts = append(m.RecoveringAccounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHostZone
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthHostZone
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthHostZone
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RecoveringAccounts) == 0 {
+					m.RecoveringAccounts = make([]ICAAccountType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ICAAccountType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHostZone
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ICAAccountType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RecoveringAccounts = append(m.RecoveringAccounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveringAccounts", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostZone(dAtA[iNdEx:])
@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -115,36 +117,166 @@ func (m *ICAAccount) GetTarget() ICAAccountType {
 	return ICAAccountType_DELEGATION
 }
 
+// IcaBalance is the last host denom balance of an ICA reported by an
+// interchain query
+type IcaBalance struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// host zone height the balance was queried at, 0 if it was never queried
+	RemoteHeight int64 `protobuf:"varint,3,opt,name=remote_height,json=remoteHeight,proto3" json:"remote_height,omitempty"`
+}
+
+func (m *IcaBalance) Reset()         { *m = IcaBalance{} }
+func (m *IcaBalance) String() string { return proto.CompactTextString(m) }
+func (*IcaBalance) ProtoMessage()    {}
+func (*IcaBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7243c23ee376c2f, []int{1}
+}
+func (m *IcaBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IcaBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IcaBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IcaBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IcaBalance.Merge(m, src)
+}
+func (m *IcaBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *IcaBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_IcaBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IcaBalance proto.InternalMessageInfo
+
+func (m *IcaBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *IcaBalance) GetRemoteHeight() int64 {
+	if m != nil {
+		return m.RemoteHeight
+	}
+	return 0
+}
+
+// HostZoneIcaBalance is the IcaBalance of one of a host zone's ICAs, as it is
+// exported in genesis
+type HostZoneIcaBalance struct {
+	ChainId     string         `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AccountType ICAAccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=Stridelabs.stride.stakeibc.ICAAccountType" json:"account_type,omitempty"`
+	Balance     IcaBalance     `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *HostZoneIcaBalance) Reset()         { *m = HostZoneIcaBalance{} }
+func (m *HostZoneIcaBalance) String() string { return proto.CompactTextString(m) }
+func (*HostZoneIcaBalance) ProtoMessage()    {}
+func (*HostZoneIcaBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7243c23ee376c2f, []int{2}
+}
+func (m *HostZoneIcaBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZoneIcaBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZoneIcaBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZoneIcaBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZoneIcaBalance.Merge(m, src)
+}
+func (m *HostZoneIcaBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZoneIcaBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZoneIcaBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZoneIcaBalance proto.InternalMessageInfo
+
+func (m *HostZoneIcaBalance) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HostZoneIcaBalance) GetAccountType() ICAAccountType {
+	if m != nil {
+		return m.AccountType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *HostZoneIcaBalance) GetBalance() IcaBalance {
+	if m != nil {
+		return m.Balance
+	}
+	return IcaBalance{}
+}
+
 func init() {
 	proto.RegisterEnum("Stridelabs.stride.stakeibc.ICAAccountType", ICAAccountType_name, ICAAccountType_value)
 	proto.RegisterType((*ICAAccount)(nil), "Stridelabs.stride.stakeibc.ICAAccount")
+	proto.RegisterType((*IcaBalance)(nil), "Stridelabs.stride.stakeibc.IcaBalance")
+	proto.RegisterType((*HostZoneIcaBalance)(nil), "Stridelabs.stride.stakeibc.HostZoneIcaBalance")
 }
 
 func init() { proto.RegisterFile("stakeibc/ica_account.proto", fileDescriptor_f7243c23ee376c2f) }
 
 var fileDescriptor_f7243c23ee376c2f = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0x2e, 0x49, 0xcc,
-	0x4e, 0xcd, 0x4c, 0x4a, 0xd6, 0xcf, 0x4c, 0x4e, 0x8c, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b,
-	0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x0a, 0x2e, 0x29, 0xca, 0x4c, 0x49, 0xcd, 0x49,
-	0x4c, 0x2a, 0xd6, 0x2b, 0x06, 0x33, 0xf5, 0x60, 0xaa, 0xa5, 0x24, 0xe1, 0xfa, 0x52, 0x52, 0x73,
-	0x52, 0xd3, 0x13, 0x4b, 0x32, 0xf3, 0xf3, 0x20, 0xda, 0xa4, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3,
-	0x8b, 0xe3, 0xc1, 0x3c, 0x7d, 0x08, 0x07, 0x22, 0xa5, 0x74, 0x8e, 0x91, 0x8b, 0xcb, 0xd3, 0xd9,
-	0xd1, 0x11, 0x62, 0x8d, 0x90, 0x11, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0x1d, 0x8e, 0x10, 0x19,
-	0x90, 0x1b, 0xf2, 0xd2, 0x83, 0x60, 0x0a, 0x85, 0x3c, 0xb8, 0xb8, 0x11, 0x36, 0x16, 0x4b, 0x30,
-	0x29, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xe9, 0xe1, 0x76, 0xaa, 0x9e, 0x0b, 0x5c, 0x79, 0x10, 0xb2,
-	0x56, 0x21, 0x27, 0x2e, 0xb6, 0x92, 0xc4, 0xa2, 0xf4, 0xd4, 0x12, 0x09, 0x66, 0x05, 0x46, 0x0d,
-	0x3e, 0x23, 0x2d, 0x7c, 0x86, 0x20, 0x5c, 0x1d, 0x52, 0x59, 0x90, 0x1a, 0x04, 0xd5, 0xa9, 0xe5,
-	0xc9, 0xc5, 0x87, 0x2a, 0x23, 0xc4, 0xc7, 0xc5, 0xe5, 0xe2, 0xea, 0xe3, 0xea, 0xee, 0x18, 0xe2,
-	0xe9, 0xef, 0x27, 0xc0, 0x20, 0xc4, 0xce, 0xc5, 0xec, 0xe6, 0xea, 0x2a, 0xc0, 0x08, 0x92, 0x08,
-	0xf7, 0x0c, 0xf1, 0x70, 0x09, 0x72, 0x0c, 0x77, 0xf4, 0x11, 0x60, 0x02, 0xf1, 0x83, 0x5c, 0x5d,
-	0x5c, 0x7d, 0x03, 0xc0, 0x0a, 0x99, 0x9d, 0x3c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0x4a, 0x2f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xe2,
-	0x44, 0x5d, 0x9f, 0xc4, 0xa4, 0x62, 0x7d, 0x88, 0x1b, 0xf5, 0x2b, 0xf4, 0xe1, 0x71, 0x51, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x6c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e,
-	0x89, 0x28, 0x79, 0xdc, 0x01, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xc7, 0xbd, 0x56, 0xb1, 0xdb, 0x75, 0x6a, 0xcc, 0x92, 0x83, 0xe2, 0x83, 0x62, 0x5c, 0x08,
+	0x26, 0x60, 0x09, 0xdc, 0x6b, 0x2f, 0x52, 0xad, 0x54, 0x02, 0xa7, 0x2d, 0xaa, 0x21, 0x90, 0x8b,
+	0x58, 0xad, 0x16, 0x59, 0xc4, 0xd6, 0x1a, 0xed, 0x06, 0x9a, 0xb7, 0xe8, 0x2b, 0xf4, 0x1d, 0xf2,
+	0x0c, 0x25, 0xa7, 0x12, 0x72, 0x2a, 0x3d, 0x84, 0x62, 0xbf, 0x48, 0xd1, 0xae, 0x64, 0xb9, 0x85,
+	0xb6, 0xf4, 0x24, 0xcd, 0xce, 0xfc, 0x67, 0x7e, 0xf3, 0x01, 0xfb, 0x5c, 0xe0, 0x2b, 0x9a, 0x46,
+	0xc4, 0x4a, 0x09, 0x0e, 0x31, 0x21, 0xec, 0x3a, 0x13, 0xe6, 0x3a, 0x67, 0x82, 0xa1, 0xfe, 0x07,
+	0x91, 0xa7, 0x31, 0x5d, 0xe2, 0x88, 0x9b, 0x5c, 0xfe, 0x9a, 0x55, 0x74, 0xff, 0x30, 0x61, 0x09,
+	0x93, 0x61, 0x56, 0xf1, 0xa7, 0x14, 0xfd, 0xa3, 0x5d, 0xb6, 0x98, 0x2e, 0x69, 0x82, 0x45, 0xca,
+	0xb2, 0xca, 0x45, 0x18, 0x5f, 0x31, 0x1e, 0x2a, 0x8d, 0x32, 0x94, 0x6b, 0xf8, 0x15, 0x40, 0xe8,
+	0xbf, 0xb6, 0x6d, 0x55, 0x1c, 0x4d, 0x60, 0x1b, 0xc7, 0x71, 0x4e, 0x39, 0xd7, 0xc1, 0x00, 0x8c,
+	0x9e, 0x39, 0xfa, 0xc3, 0xed, 0xf8, 0xb0, 0x54, 0xd8, 0xca, 0x53, 0x90, 0x65, 0x49, 0x50, 0x05,
+	0x22, 0x0f, 0x76, 0xea, 0x8a, 0x5c, 0x6f, 0x0e, 0xb4, 0x51, 0x67, 0x72, 0x62, 0xfe, 0xb9, 0x01,
+	0x73, 0xba, 0x0b, 0x0f, 0xf6, 0xa5, 0xc8, 0x81, 0x2d, 0x81, 0xf3, 0x84, 0x0a, 0x5d, 0x1b, 0x80,
+	0x51, 0x77, 0x72, 0xfa, 0xb7, 0x24, 0x35, 0xf5, 0xfc, 0x66, 0x4d, 0x83, 0x52, 0x39, 0xfc, 0x5c,
+	0x34, 0x44, 0xb0, 0x83, 0x97, 0x38, 0x23, 0x14, 0xe9, 0xbf, 0x35, 0x54, 0x63, 0xcf, 0x61, 0x0b,
+	0xaf, 0x0a, 0xb9, 0xde, 0x94, 0x9d, 0xbe, 0xba, 0x7b, 0x3c, 0x6e, 0x7c, 0x7f, 0x3c, 0x3e, 0x49,
+	0x52, 0xb1, 0xb8, 0x8e, 0x4c, 0xc2, 0x56, 0xe5, 0xa8, 0xca, 0xcf, 0x98, 0xc7, 0x57, 0x96, 0xb8,
+	0x59, 0x53, 0x6e, 0xfa, 0x99, 0x78, 0xb8, 0x1d, 0xc3, 0x72, 0x2e, 0x7e, 0x26, 0x82, 0x32, 0x17,
+	0x7a, 0x01, 0x9f, 0xe7, 0x74, 0xc5, 0x04, 0x0d, 0x17, 0x34, 0x4d, 0x16, 0xaa, 0x13, 0x2d, 0x38,
+	0x50, 0x8f, 0x9e, 0x7c, 0x1b, 0x7e, 0x01, 0x10, 0x79, 0x8c, 0x8b, 0x4b, 0x96, 0xd1, 0x3d, 0xd6,
+	0x23, 0xf8, 0x94, 0x2c, 0x70, 0x9a, 0x85, 0x69, 0x5c, 0xc1, 0x4a, 0xdb, 0x8f, 0xd1, 0x39, 0x3c,
+	0x28, 0xef, 0x23, 0x2c, 0x08, 0xf4, 0xe6, 0x7f, 0xcf, 0xa7, 0x83, 0x6b, 0x03, 0x9d, 0xc1, 0x76,
+	0xa4, 0x8a, 0x4a, 0xbe, 0x7f, 0xac, 0xab, 0x46, 0x74, 0x9e, 0x14, 0x43, 0x0a, 0x2a, 0xf1, 0xa9,
+	0x0f, 0xbb, 0xbf, 0x96, 0x41, 0x5d, 0x08, 0xa7, 0xee, 0xcc, 0x7d, 0x63, 0xcf, 0xfd, 0x77, 0x6f,
+	0x7b, 0x0d, 0xd4, 0x86, 0xda, 0x99, 0xeb, 0xf6, 0x40, 0xe1, 0xb8, 0xf0, 0xe7, 0xde, 0x34, 0xb0,
+	0x2f, 0xec, 0x59, 0xaf, 0x59, 0xd8, 0x81, 0x3b, 0x75, 0xcf, 0xdf, 0xcb, 0x40, 0xcd, 0xf1, 0xee,
+	0x36, 0x06, 0xb8, 0xdf, 0x18, 0xe0, 0xc7, 0xc6, 0x00, 0x9f, 0xb6, 0x46, 0xe3, 0x7e, 0x6b, 0x34,
+	0xbe, 0x6d, 0x8d, 0xc6, 0xa5, 0xb9, 0xb7, 0x10, 0x45, 0x39, 0x9e, 0xe1, 0x88, 0x5b, 0x0a, 0xd3,
+	0xfa, 0x68, 0xed, 0x0e, 0x5f, 0x2e, 0x27, 0x6a, 0xc9, 0xcb, 0x7e, 0xf9, 0x73, 0x00, 0xf0, 0xe3,
+	0xc6, 0xda, 0x5f, 0x03, 0x00, 0x00,
 }
 
 func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
@@ -196,6 +328,96 @@ func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IcaBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IcaBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IcaBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemoteHeight != 0 {
+		i = encodeVarintIcaAccount(dAtA, i, uint64(m.RemoteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIcaAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIcaAccount(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostZoneIcaBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostZoneIcaBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZoneIcaBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIcaAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AccountType != 0 {
+		i = encodeVarintIcaAccount(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintIcaAccount(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIcaAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIcaAccount(v)
 	base := offset
@@ -229,6 +451,42 @@ func (m *ICAAccount) Size() (n int) {
 	return n
 }
 
+func (m *IcaBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIcaAccount(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIcaAccount(uint64(l))
+	if m.RemoteHeight != 0 {
+		n += 1 + sovIcaAccount(uint64(m.RemoteHeight))
+	}
+	return n
+}
+
+func (m *HostZoneIcaBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIcaAccount(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovIcaAccount(uint64(m.AccountType))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovIcaAccount(uint64(l))
+	return n
+}
+
 func sovIcaAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -370,6 +628,275 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IcaBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcaBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcaBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteHeight", wireType)
+			}
+			m.RemoteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostZoneIcaBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZoneIcaBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZoneIcaBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIcaAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,21 @@
+package types
+
+const (
+	// IcaBalanceKeyPrefix is the prefix to retrieve all IcaBalance
+	IcaBalanceKeyPrefix = "IcaBalance/value/"
+)
+
+// IcaBalanceKey returns the store key to retrieve an IcaBalance from the index fields
+func IcaBalanceKey(
+	chainId string,
+	accountType ICAAccountType,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(chainId)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(accountType.String())...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
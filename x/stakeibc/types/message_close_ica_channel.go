@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/utils"
+)
+
+const TypeMsgCloseIcaChannel = "close_ica_channel"
+
+var _ sdk.Msg = &MsgCloseIcaChannel{}
+
+func NewMsgCloseIcaChannel(creator string, chainId string, accountType ICAAccountType) *MsgCloseIcaChannel {
+	return &MsgCloseIcaChannel{
+		Creator:     creator,
+		ChainId:     chainId,
+		AccountType: accountType,
+	}
+}
+
+func (msg *MsgCloseIcaChannel) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCloseIcaChannel) Type() string {
+	return TypeMsgCloseIcaChannel
+}
+
+func (msg *MsgCloseIcaChannel) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCloseIcaChannel) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCloseIcaChannel) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if len(msg.ChainId) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chainid is required")
+	}
+	if _, found := ICAAccountType_name[int32(msg.AccountType)]; !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type (%d)", msg.AccountType)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	cmdcfg "github.com/Stride-Labs/stride/cmd/strided/config"
+	"github.com/Stride-Labs/stride/testutil/sample"
+)
+
+func TestMsgCloseIcaChannel_ValidateBasic(t *testing.T) {
+	cmdcfg.SetBech32Prefixes(sdk.GetConfig())
+	adminAddress := "stride1u20df3trc2c2zdhm8qvh2hdjx9ewh00sv6eyy8"
+	tests := []struct {
+		name string
+		msg  MsgCloseIcaChannel
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCloseIcaChannel{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "not admin address",
+			msg: MsgCloseIcaChannel{
+				Creator: sample.AccAddress(),
+				ChainId: "GAIA",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "missing chain id",
+			msg: MsgCloseIcaChannel{
+				Creator: adminAddress,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid account type",
+			msg: MsgCloseIcaChannel{
+				Creator:     adminAddress,
+				ChainId:     "GAIA",
+				AccountType: ICAAccountType(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid message",
+			msg: MsgCloseIcaChannel{
+				Creator:     adminAddress,
+				ChainId:     "GAIA",
+				AccountType: ICAAccountType_DELEGATION,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
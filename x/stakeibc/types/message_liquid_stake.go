@@ -11,11 +11,12 @@ const TypeMsgLiquidStake = "liquid_stake"
 
 var _ sdk.Msg = &MsgLiquidStake{}
 
-func NewMsgLiquidStake(creator string, amount uint64, hostDenom string) *MsgLiquidStake {
+func NewMsgLiquidStake(creator string, amount uint64, hostDenom string, minStAmountOut uint64) *MsgLiquidStake {
 	return &MsgLiquidStake{
-		Creator:   creator,
-		Amount:    amount,
-		HostDenom: hostDenom,
+		Creator:        creator,
+		Amount:         amount,
+		HostDenom:      hostDenom,
+		MinStAmountOut: minStAmountOut,
 	}
 }
 
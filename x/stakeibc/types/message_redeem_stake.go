@@ -9,12 +9,13 @@ const TypeMsgRedeemStake = "redeem_stake"
 
 var _ sdk.Msg = &MsgRedeemStake{}
 
-func NewMsgRedeemStake(creator string, amount uint64, hostZone string, receiver string) *MsgRedeemStake {
+func NewMsgRedeemStake(creator string, amount uint64, hostZone string, receiver string, minNativeAmountOut uint64) *MsgRedeemStake {
 	return &MsgRedeemStake{
-		Creator:  creator,
-		Amount:   amount,
-		HostZone: hostZone,
-		Receiver: receiver,
+		Creator:            creator,
+		Amount:             amount,
+		HostZone:           hostZone,
+		Receiver:           receiver,
+		MinNativeAmountOut: minNativeAmountOut,
 	}
 }
 
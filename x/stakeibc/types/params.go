@@ -23,6 +23,7 @@ var (
 	DefaultBufferSize       uint64 = 5   // 1/5=20% of the epoch
 	DefaultIbcTimeoutBlocks uint64 = 300 // 300 blocks ~= 30 minutes
 	DefaultFeeTransferTimeoutNanos  uint64 = 600000000000 // 10 minutes
+	DefaultIcqResponseReward uint64 = 0
 
 
 	// KeyDepositInterval is store's key for the DepositInterval option
@@ -37,6 +38,7 @@ var (
 	KeyFeeTransferTimeoutNanos       = []byte("FeeTransferTimeoutNanos")
 	KeyBufferSize                    = []byte("BufferSize")
 	KeyIbcTimeoutBlocks              = []byte("IBCTimeoutBlocks")
+	KeyIcqResponseReward             = []byte("IcqResponseReward")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -59,6 +61,7 @@ func NewParams(
 	buffer_size uint64,
 	ibc_timeout_blocks uint64,
 	fee_transfer_timeout_nanos uint64,
+	icq_response_reward uint64,
 ) Params {
 	return Params{
 		DepositInterval:               deposit_interval,
@@ -72,6 +75,7 @@ func NewParams(
 		BufferSize:                    buffer_size,
 		IbcTimeoutBlocks:              ibc_timeout_blocks,
 		FeeTransferTimeoutNanos:       fee_transfer_timeout_nanos,
+		IcqResponseReward:             icq_response_reward,
 	}
 }
 
@@ -89,6 +93,7 @@ func DefaultParams() Params {
 		DefaultBufferSize,
 		DefaultIbcTimeoutBlocks,
 		DefaultFeeTransferTimeoutNanos,
+		DefaultIcqResponseReward,
 	)
 }
 
@@ -106,6 +111,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBufferSize, &p.BufferSize, isPositive),
 		paramtypes.NewParamSetPair(KeyIbcTimeoutBlocks, &p.IbcTimeoutBlocks, isPositive),
 		paramtypes.NewParamSetPair(KeyFeeTransferTimeoutNanos, &p.FeeTransferTimeoutNanos, validTimeoutNanos),
+		paramtypes.NewParamSetPair(KeyIcqResponseReward, &p.IcqResponseReward, isUint64),
 	}
 }
 
@@ -154,6 +160,13 @@ func isPositive(i interface{}) error {
 	return nil
 }
 
+func isUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	return nil
+}
+
 func isCommission(i interface{}) error {
 	ival, ok := i.(uint64)
 	if !ok {
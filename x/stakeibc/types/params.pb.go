@@ -24,7 +24,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
-// next id: 13
+// next id: 14
 type Params struct {
 	// define epoch lengths, in stride_epochs
 	RewardsInterval        uint64 `protobuf:"varint,1,opt,name=rewards_interval,json=rewardsInterval,proto3" json:"rewards_interval,omitempty"`
@@ -43,6 +43,8 @@ type Params struct {
 	BufferSize                    uint64            `protobuf:"varint,10,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
 	IbcTimeoutBlocks              uint64            `protobuf:"varint,11,opt,name=ibc_timeout_blocks,json=ibcTimeoutBlocks,proto3" json:"ibc_timeout_blocks,omitempty"`
 	FeeTransferTimeoutNanos       uint64            `protobuf:"varint,12,opt,name=fee_transfer_timeout_nanos,json=feeTransferTimeoutNanos,proto3" json:"fee_transfer_timeout_nanos,omitempty"`
+	// ustrd paid from the stakeibc module account to the relayer that answers each stakeibc ICQ, 0 for no reward
+	IcqResponseReward uint64 `protobuf:"varint,13,opt,name=icq_response_reward,json=icqResponseReward,proto3" json:"icq_response_reward,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -161,6 +163,13 @@ func (m *Params) GetFeeTransferTimeoutNanos() uint64 {
 	return 0
 }
 
+func (m *Params) GetIcqResponseReward() uint64 {
+	if m != nil {
+		return m.IcqResponseReward
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "Stridelabs.stride.stakeibc.Params")
 	proto.RegisterMapType((map[string]string)(nil), "Stridelabs.stride.stakeibc.Params.ZoneComAddressEntry")
@@ -169,40 +178,41 @@ func init() {
 func init() { proto.RegisterFile("stakeibc/params.proto", fileDescriptor_41f5fe1d2f7ac763) }
 
 var fileDescriptor_41f5fe1d2f7ac763 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x8e, 0xd3, 0x3c,
-	0x14, 0xc5, 0x9b, 0xe9, 0x9f, 0xef, 0xab, 0x8b, 0xa0, 0x35, 0x03, 0x44, 0x95, 0x48, 0x47, 0xac,
-	0x66, 0x18, 0x48, 0x24, 0x90, 0xd0, 0x68, 0x58, 0xcd, 0x20, 0x10, 0x48, 0x08, 0xa1, 0x4c, 0x57,
-	0xb3, 0x31, 0x76, 0x72, 0xdb, 0x5a, 0x4d, 0xec, 0xca, 0x76, 0x0b, 0xed, 0x53, 0xb0, 0x64, 0xc9,
-	0xe3, 0x20, 0x56, 0xb3, 0x64, 0x89, 0xda, 0x17, 0x41, 0xb1, 0xdb, 0xb4, 0x95, 0x60, 0xe7, 0x9c,
-	0xfb, 0xbb, 0x27, 0xc7, 0xd7, 0x36, 0xba, 0xa7, 0x0d, 0x1d, 0x03, 0x67, 0x49, 0x34, 0xa1, 0x8a,
-	0xe6, 0x3a, 0x9c, 0x28, 0x69, 0x24, 0xee, 0x5e, 0x19, 0xc5, 0x53, 0xc8, 0x28, 0xd3, 0xa1, 0xb6,
-	0xcb, 0x70, 0x03, 0x76, 0x0f, 0x87, 0x72, 0x28, 0x2d, 0x16, 0x15, 0x2b, 0xd7, 0xf1, 0xe8, 0x67,
-	0x1d, 0x35, 0x3e, 0x5a, 0x0b, 0x7c, 0x82, 0xda, 0x0a, 0x3e, 0x53, 0x95, 0x6a, 0xc2, 0x85, 0x01,
-	0x35, 0xa3, 0x99, 0xef, 0x1d, 0x79, 0xc7, 0xb5, 0xf8, 0xce, 0x5a, 0x7f, 0xb7, 0x96, 0xf1, 0x29,
-	0xea, 0xa4, 0x90, 0xc1, 0x90, 0x1a, 0xd8, 0xb2, 0x0d, 0xcb, 0xb6, 0x37, 0x85, 0x12, 0x3e, 0x41,
-	0xed, 0x14, 0x26, 0x52, 0x73, 0xb3, 0x65, 0x0f, 0x9c, 0xef, 0x5a, 0x2f, 0xd1, 0x33, 0xe4, 0x2b,
-	0x48, 0x21, 0x9f, 0x18, 0x2e, 0x05, 0x51, 0x7b, 0xf6, 0x55, 0xdb, 0x72, 0x7f, 0x5b, 0x8f, 0x77,
-	0x7f, 0x72, 0x8a, 0x3a, 0x6e, 0xc3, 0x24, 0x91, 0x79, 0xce, 0xb5, 0xe6, 0x52, 0xf8, 0x35, 0x97,
-	0xc8, 0x15, 0x5e, 0x95, 0x3a, 0xfe, 0x84, 0xda, 0x0b, 0x29, 0x2c, 0x4a, 0x68, 0x9a, 0x2a, 0xd0,
-	0xda, 0xaf, 0x1f, 0x55, 0x8f, 0x5b, 0xcf, 0x5e, 0x84, 0xff, 0x9e, 0x60, 0xe8, 0xe6, 0x14, 0x5e,
-	0x4b, 0x51, 0x98, 0x5d, 0xb8, 0xc6, 0xd7, 0xc2, 0xa8, 0x79, 0x7c, 0x7b, 0xb1, 0x27, 0x16, 0x71,
-	0x14, 0x70, 0x31, 0x03, 0xbd, 0xb3, 0xe9, 0xff, 0x5c, 0x9c, 0x4d, 0xa1, 0xcc, 0xfe, 0x06, 0xf5,
-	0x66, 0x34, 0xe3, 0x29, 0x35, 0x52, 0x11, 0x05, 0x8c, 0x66, 0x54, 0x24, 0x5c, 0x0c, 0x89, 0x19,
-	0x29, 0xd0, 0x23, 0x99, 0xa5, 0xfe, 0xff, 0xb6, 0xf5, 0x61, 0x89, 0xc5, 0x5b, 0xaa, 0xbf, 0x81,
-	0xf0, 0x63, 0xd4, 0xe1, 0x09, 0x25, 0x86, 0xe7, 0x20, 0xa7, 0x86, 0x08, 0x2a, 0xa4, 0xf6, 0x9b,
-	0x6e, 0xd2, 0x3c, 0xa1, 0x7d, 0xa7, 0x7f, 0x28, 0x64, 0xdc, 0x43, 0x2d, 0x36, 0x1d, 0x0c, 0x40,
-	0x11, 0xcd, 0x17, 0xe0, 0x23, 0x4b, 0x21, 0x27, 0x5d, 0xf1, 0x05, 0xe0, 0x27, 0x08, 0x73, 0x96,
-	0x94, 0x66, 0x2c, 0x93, 0xc9, 0x58, 0xfb, 0x2d, 0xb7, 0x05, 0xce, 0x92, 0xb5, 0xdb, 0xa5, 0xd5,
-	0xf1, 0x4b, 0xd4, 0x1d, 0x00, 0x10, 0xa3, 0xa8, 0xd0, 0x85, 0xe9, 0x7e, 0x86, 0x5b, 0xb6, 0xeb,
-	0xc1, 0x00, 0xa0, 0xbf, 0x06, 0x76, 0xb3, 0x74, 0x2f, 0xd0, 0xdd, 0xbf, 0xcc, 0x14, 0xb7, 0x51,
-	0x75, 0x0c, 0x73, 0x7b, 0x05, 0x9b, 0x71, 0xb1, 0xc4, 0x87, 0xa8, 0x3e, 0xa3, 0xd9, 0x14, 0xec,
-	0xf5, 0x69, 0xc6, 0xee, 0xe3, 0xfc, 0xe0, 0xcc, 0x3b, 0xaf, 0x7d, 0xfb, 0xde, 0xab, 0x5c, 0xbe,
-	0xfd, 0xb1, 0x0c, 0xbc, 0x9b, 0x65, 0xe0, 0xfd, 0x5e, 0x06, 0xde, 0xd7, 0x55, 0x50, 0xb9, 0x59,
-	0x05, 0x95, 0x5f, 0xab, 0xa0, 0x72, 0x1d, 0x0e, 0xb9, 0x19, 0x4d, 0x59, 0x98, 0xc8, 0x3c, 0x72,
-	0x27, 0xfc, 0xf4, 0x3d, 0x65, 0x3a, 0x72, 0x47, 0x1c, 0x7d, 0x89, 0xca, 0xf7, 0x64, 0xe6, 0x13,
-	0xd0, 0xac, 0x61, 0x5f, 0xc7, 0xf3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x71, 0x9b, 0x5c, 0x76,
-	0x68, 0x03, 0x00, 0x00,
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0x36, 0xc9, 0xf7, 0x65, 0xc2, 0x9f, 0xc4, 0x2d, 0x60, 0x45, 0xc2, 0xa9, 0x58,
+	0xb5, 0x14, 0x6c, 0x09, 0x24, 0x54, 0x95, 0x55, 0x8b, 0x40, 0x20, 0x21, 0x84, 0xdc, 0xac, 0xba,
+	0x19, 0xc6, 0xe3, 0x9b, 0x64, 0x14, 0x7b, 0xc6, 0x9d, 0x99, 0x04, 0x92, 0xa7, 0x60, 0xc9, 0x92,
+	0xc7, 0x61, 0xd9, 0x25, 0x4b, 0x94, 0xbc, 0x05, 0x2b, 0xe4, 0x19, 0xc7, 0x69, 0x24, 0xd8, 0x4d,
+	0xce, 0xfd, 0xdd, 0x93, 0x33, 0xd7, 0x77, 0xd0, 0x3d, 0xa5, 0xc9, 0x04, 0x58, 0x4c, 0xc3, 0x9c,
+	0x48, 0x92, 0xa9, 0x20, 0x97, 0x42, 0x0b, 0xb7, 0x77, 0xa1, 0x25, 0x4b, 0x20, 0x25, 0xb1, 0x0a,
+	0x94, 0x39, 0x06, 0x6b, 0xb0, 0xb7, 0x3f, 0x12, 0x23, 0x61, 0xb0, 0xb0, 0x38, 0xd9, 0x8e, 0x47,
+	0xbf, 0x1b, 0xa8, 0xf9, 0xd1, 0x58, 0xb8, 0x47, 0xa8, 0x23, 0xe1, 0x33, 0x91, 0x89, 0xc2, 0x8c,
+	0x6b, 0x90, 0x33, 0x92, 0x7a, 0xce, 0x81, 0x73, 0x58, 0x8f, 0xee, 0x96, 0xfa, 0xbb, 0x52, 0x76,
+	0x8f, 0x51, 0x37, 0x81, 0x14, 0x46, 0x44, 0xc3, 0x86, 0x6d, 0x1a, 0xb6, 0xb3, 0x2e, 0x54, 0xf0,
+	0x11, 0xea, 0x24, 0x90, 0x0b, 0xc5, 0xf4, 0x86, 0xdd, 0xb1, 0xbe, 0xa5, 0x5e, 0xa1, 0x27, 0xc8,
+	0x93, 0x90, 0x40, 0x96, 0x6b, 0x26, 0x38, 0x96, 0x5b, 0xf6, 0xbb, 0xa6, 0xe5, 0xfe, 0xa6, 0x1e,
+	0xdd, 0xfc, 0x93, 0x63, 0xd4, 0xb5, 0x17, 0xc6, 0x54, 0x64, 0x19, 0x53, 0x8a, 0x09, 0xee, 0xd5,
+	0x6d, 0x22, 0x5b, 0x78, 0x55, 0xe9, 0xee, 0x27, 0xd4, 0x59, 0x08, 0x6e, 0x50, 0x4c, 0x92, 0x44,
+	0x82, 0x52, 0x5e, 0xe3, 0x60, 0xf7, 0xb0, 0xfd, 0xec, 0x45, 0xf0, 0xef, 0x09, 0x06, 0x76, 0x4e,
+	0xc1, 0xa5, 0xe0, 0x85, 0xd9, 0x99, 0x6d, 0x7c, 0xcd, 0xb5, 0x9c, 0x47, 0x77, 0x16, 0x5b, 0x62,
+	0x11, 0x47, 0x02, 0xe3, 0x33, 0x50, 0x37, 0x2e, 0xfd, 0x9f, 0x8d, 0xb3, 0x2e, 0x54, 0xd9, 0xdf,
+	0xa0, 0xfe, 0x8c, 0xa4, 0x2c, 0x21, 0x5a, 0x48, 0x2c, 0x21, 0x26, 0x29, 0xe1, 0x94, 0xf1, 0x11,
+	0xd6, 0x63, 0x09, 0x6a, 0x2c, 0xd2, 0xc4, 0xfb, 0xdf, 0xb4, 0x3e, 0xac, 0xb0, 0x68, 0x43, 0x0d,
+	0xd6, 0x90, 0xfb, 0x18, 0x75, 0x19, 0x25, 0x58, 0xb3, 0x0c, 0xc4, 0x54, 0x63, 0x4e, 0xb8, 0x50,
+	0x5e, 0xcb, 0x4e, 0x9a, 0x51, 0x32, 0xb0, 0xfa, 0x87, 0x42, 0x76, 0xfb, 0xa8, 0x1d, 0x4f, 0x87,
+	0x43, 0x90, 0x58, 0xb1, 0x05, 0x78, 0xc8, 0x50, 0xc8, 0x4a, 0x17, 0x6c, 0x01, 0xee, 0x13, 0xe4,
+	0xb2, 0x98, 0x56, 0x66, 0x71, 0x2a, 0xe8, 0x44, 0x79, 0x6d, 0x7b, 0x05, 0x16, 0xd3, 0xd2, 0xed,
+	0xdc, 0xe8, 0xee, 0x4b, 0xd4, 0x1b, 0x02, 0x60, 0x2d, 0x09, 0x57, 0x85, 0xe9, 0x76, 0x86, 0x5b,
+	0xa6, 0xeb, 0xc1, 0x10, 0x60, 0x50, 0x02, 0x5b, 0x59, 0x02, 0xb4, 0xc7, 0xe8, 0x15, 0x96, 0xa0,
+	0x72, 0xc1, 0x15, 0x60, 0xbb, 0x6d, 0xde, 0x6d, 0xd3, 0xd5, 0x65, 0xf4, 0x2a, 0x2a, 0x2b, 0x91,
+	0x29, 0xf4, 0xce, 0xd0, 0xde, 0x5f, 0xbe, 0x81, 0xdb, 0x41, 0xbb, 0x13, 0x98, 0x9b, 0x95, 0x6d,
+	0x45, 0xc5, 0xd1, 0xdd, 0x47, 0x8d, 0x19, 0x49, 0xa7, 0x60, 0xd6, 0xad, 0x15, 0xd9, 0x1f, 0xa7,
+	0x3b, 0x27, 0xce, 0x69, 0xfd, 0xdb, 0xf7, 0x7e, 0xed, 0xfc, 0xed, 0x8f, 0xa5, 0xef, 0x5c, 0x2f,
+	0x7d, 0xe7, 0xd7, 0xd2, 0x77, 0xbe, 0xae, 0xfc, 0xda, 0xf5, 0xca, 0xaf, 0xfd, 0x5c, 0xf9, 0xb5,
+	0xcb, 0x60, 0xc4, 0xf4, 0x78, 0x1a, 0x07, 0x54, 0x64, 0xa1, 0xdd, 0x88, 0xa7, 0xef, 0x49, 0xac,
+	0x42, 0xbb, 0x12, 0xe1, 0x97, 0xb0, 0x7a, 0x7f, 0x7a, 0x9e, 0x83, 0x8a, 0x9b, 0xe6, 0x35, 0x3d,
+	0xff, 0x33, 0x00, 0x50, 0x10, 0x4c, 0x8a, 0x98, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -225,6 +235,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IcqResponseReward != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IcqResponseReward))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.FeeTransferTimeoutNanos != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.FeeTransferTimeoutNanos))
 		i--
@@ -360,6 +375,9 @@ func (m *Params) Size() (n int) {
 	if m.FeeTransferTimeoutNanos != 0 {
 		n += 1 + sovParams(uint64(m.FeeTransferTimeoutNanos))
 	}
+	if m.IcqResponseReward != 0 {
+		n += 1 + sovParams(uint64(m.IcqResponseReward))
+	}
 	return n
 }
 
@@ -734,6 +752,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcqResponseReward", wireType)
+			}
+			m.IcqResponseReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IcqResponseReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/Stride-Labs/stride/x/epochs/types"
+	types "github.com/Stride-Labs/stride/x/records/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -841,6 +845,396 @@ func (m *QueryAllEpochTrackerResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryHostZoneAccountingRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryHostZoneAccountingRequest) Reset()         { *m = QueryHostZoneAccountingRequest{} }
+func (m *QueryHostZoneAccountingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZoneAccountingRequest) ProtoMessage()    {}
+func (*QueryHostZoneAccountingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{18}
+}
+func (m *QueryHostZoneAccountingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZoneAccountingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZoneAccountingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostZoneAccountingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZoneAccountingRequest.Merge(m, src)
+}
+func (m *QueryHostZoneAccountingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZoneAccountingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZoneAccountingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostZoneAccountingRequest proto.InternalMessageInfo
+
+func (m *QueryHostZoneAccountingRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+// UnbondingAmount sums the HostZoneUnbondings of a host zone that are in a given status
+type UnbondingAmount struct {
+	Status            types.HostZoneUnbonding_Status `protobuf:"varint,1,opt,name=status,proto3,enum=Stridelabs.stride.records.HostZoneUnbonding_Status" json:"status,omitempty"`
+	NativeTokenAmount uint64                         `protobuf:"varint,2,opt,name=native_token_amount,json=nativeTokenAmount,proto3" json:"native_token_amount,omitempty"`
+	StTokenAmount     uint64                         `protobuf:"varint,3,opt,name=st_token_amount,json=stTokenAmount,proto3" json:"st_token_amount,omitempty"`
+}
+
+func (m *UnbondingAmount) Reset()         { *m = UnbondingAmount{} }
+func (m *UnbondingAmount) String() string { return proto.CompactTextString(m) }
+func (*UnbondingAmount) ProtoMessage()    {}
+func (*UnbondingAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{19}
+}
+func (m *UnbondingAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingAmount.Merge(m, src)
+}
+func (m *UnbondingAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingAmount proto.InternalMessageInfo
+
+func (m *UnbondingAmount) GetStatus() types.HostZoneUnbonding_Status {
+	if m != nil {
+		return m.Status
+	}
+	return types.HostZoneUnbonding_BONDED
+}
+
+func (m *UnbondingAmount) GetNativeTokenAmount() uint64 {
+	if m != nil {
+		return m.NativeTokenAmount
+	}
+	return 0
+}
+
+func (m *UnbondingAmount) GetStTokenAmount() uint64 {
+	if m != nil {
+		return m.StTokenAmount
+	}
+	return 0
+}
+
+type QueryHostZoneAccountingResponse struct {
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	HostDenom string `protobuf:"bytes,2,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	// tokens on the stakeibc module account waiting to be transferred to the host zone
+	ModuleAccountBalance int64 `protobuf:"varint,3,opt,name=module_account_balance,json=moduleAccountBalance,proto3" json:"module_account_balance,omitempty"`
+	// tokens on the delegation ICA waiting to be staked
+	UndelegatedBalance int64 `protobuf:"varint,4,opt,name=undelegated_balance,json=undelegatedBalance,proto3" json:"undelegated_balance,omitempty"`
+	// tokens staked on the host zone
+	StakedBalance  uint64                                 `protobuf:"varint,5,opt,name=staked_balance,json=stakedBalance,proto3" json:"staked_balance,omitempty"`
+	StSupply       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=st_supply,json=stSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"st_supply"`
+	RedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate"`
+	// amounts pending unbonding, one entry per HostZoneUnbonding status
+	UnbondingAmounts []UnbondingAmount `protobuf:"bytes,8,rep,name=unbonding_amounts,json=unbondingAmounts,proto3" json:"unbonding_amounts"`
+	// native tokens in claimable user redemption records
+	ClaimableAmount   uint64     `protobuf:"varint,9,opt,name=claimable_amount,json=claimableAmount,proto3" json:"claimable_amount,omitempty"`
+	WithdrawalBalance IcaBalance `protobuf:"bytes,10,opt,name=withdrawal_balance,json=withdrawalBalance,proto3" json:"withdrawal_balance"`
+	FeeBalance        IcaBalance `protobuf:"bytes,11,opt,name=fee_balance,json=feeBalance,proto3" json:"fee_balance"`
+	// total value locked in native units: module_account_balance + undelegated_balance + staked_balance
+	Tvl github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=tvl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tvl"`
+}
+
+func (m *QueryHostZoneAccountingResponse) Reset()         { *m = QueryHostZoneAccountingResponse{} }
+func (m *QueryHostZoneAccountingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostZoneAccountingResponse) ProtoMessage()    {}
+func (*QueryHostZoneAccountingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{20}
+}
+func (m *QueryHostZoneAccountingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostZoneAccountingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostZoneAccountingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostZoneAccountingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostZoneAccountingResponse.Merge(m, src)
+}
+func (m *QueryHostZoneAccountingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostZoneAccountingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostZoneAccountingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostZoneAccountingResponse proto.InternalMessageInfo
+
+func (m *QueryHostZoneAccountingResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryHostZoneAccountingResponse) GetHostDenom() string {
+	if m != nil {
+		return m.HostDenom
+	}
+	return ""
+}
+
+func (m *QueryHostZoneAccountingResponse) GetModuleAccountBalance() int64 {
+	if m != nil {
+		return m.ModuleAccountBalance
+	}
+	return 0
+}
+
+func (m *QueryHostZoneAccountingResponse) GetUndelegatedBalance() int64 {
+	if m != nil {
+		return m.UndelegatedBalance
+	}
+	return 0
+}
+
+func (m *QueryHostZoneAccountingResponse) GetStakedBalance() uint64 {
+	if m != nil {
+		return m.StakedBalance
+	}
+	return 0
+}
+
+func (m *QueryHostZoneAccountingResponse) GetUnbondingAmounts() []UnbondingAmount {
+	if m != nil {
+		return m.UnbondingAmounts
+	}
+	return nil
+}
+
+func (m *QueryHostZoneAccountingResponse) GetClaimableAmount() uint64 {
+	if m != nil {
+		return m.ClaimableAmount
+	}
+	return 0
+}
+
+func (m *QueryHostZoneAccountingResponse) GetWithdrawalBalance() IcaBalance {
+	if m != nil {
+		return m.WithdrawalBalance
+	}
+	return IcaBalance{}
+}
+
+func (m *QueryHostZoneAccountingResponse) GetFeeBalance() IcaBalance {
+	if m != nil {
+		return m.FeeBalance
+	}
+	return IcaBalance{}
+}
+
+type QueryUnbondingScheduleRequest struct {
+	// host zone, every host zone if empty
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// upcoming unbondings per host zone, 1 if unset
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryUnbondingScheduleRequest) Reset()         { *m = QueryUnbondingScheduleRequest{} }
+func (m *QueryUnbondingScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingScheduleRequest) ProtoMessage()    {}
+func (*QueryUnbondingScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{21}
+}
+func (m *QueryUnbondingScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingScheduleRequest.Merge(m, src)
+}
+func (m *QueryUnbondingScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingScheduleRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryUnbondingScheduleRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// HostZoneUnbondingSchedule lists the next day epochs a host zone's unbondings are sent in
+type HostZoneUnbondingSchedule struct {
+	ChainId            string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	UnbondingFrequency uint64                 `protobuf:"varint,2,opt,name=unbonding_frequency,json=unbondingFrequency,proto3" json:"unbonding_frequency,omitempty"`
+	UpcomingUnbondings []types1.UpcomingEpoch `protobuf:"bytes,3,rep,name=upcoming_unbondings,json=upcomingUnbondings,proto3" json:"upcoming_unbondings"`
+	// why the schedule of the host zone couldn't be computed, if it has no upcoming unbondings
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *HostZoneUnbondingSchedule) Reset()         { *m = HostZoneUnbondingSchedule{} }
+func (m *HostZoneUnbondingSchedule) String() string { return proto.CompactTextString(m) }
+func (*HostZoneUnbondingSchedule) ProtoMessage()    {}
+func (*HostZoneUnbondingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{22}
+}
+func (m *HostZoneUnbondingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostZoneUnbondingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostZoneUnbondingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostZoneUnbondingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostZoneUnbondingSchedule.Merge(m, src)
+}
+func (m *HostZoneUnbondingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostZoneUnbondingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostZoneUnbondingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostZoneUnbondingSchedule proto.InternalMessageInfo
+
+func (m *HostZoneUnbondingSchedule) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *HostZoneUnbondingSchedule) GetUnbondingFrequency() uint64 {
+	if m != nil {
+		return m.UnbondingFrequency
+	}
+	return 0
+}
+
+func (m *HostZoneUnbondingSchedule) GetUpcomingUnbondings() []types1.UpcomingEpoch {
+	if m != nil {
+		return m.UpcomingUnbondings
+	}
+	return nil
+}
+
+func (m *HostZoneUnbondingSchedule) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type QueryUnbondingScheduleResponse struct {
+	Schedules []HostZoneUnbondingSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+}
+
+func (m *QueryUnbondingScheduleResponse) Reset()         { *m = QueryUnbondingScheduleResponse{} }
+func (m *QueryUnbondingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingScheduleResponse) ProtoMessage()    {}
+func (*QueryUnbondingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc8fd2cb3c1d11f2, []int{23}
+}
+func (m *QueryUnbondingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingScheduleResponse.Merge(m, src)
+}
+func (m *QueryUnbondingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingScheduleResponse) GetSchedules() []HostZoneUnbondingSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "Stridelabs.stride.stakeibc.QueryInterchainAccountFromAddressRequest")
 	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "Stridelabs.stride.stakeibc.QueryInterchainAccountFromAddressResponse")
@@ -860,79 +1254,121 @@ func init() {
 	proto.RegisterType((*QueryGetEpochTrackerResponse)(nil), "Stridelabs.stride.stakeibc.QueryGetEpochTrackerResponse")
 	proto.RegisterType((*QueryAllEpochTrackerRequest)(nil), "Stridelabs.stride.stakeibc.QueryAllEpochTrackerRequest")
 	proto.RegisterType((*QueryAllEpochTrackerResponse)(nil), "Stridelabs.stride.stakeibc.QueryAllEpochTrackerResponse")
+	proto.RegisterType((*QueryHostZoneAccountingRequest)(nil), "Stridelabs.stride.stakeibc.QueryHostZoneAccountingRequest")
+	proto.RegisterType((*UnbondingAmount)(nil), "Stridelabs.stride.stakeibc.UnbondingAmount")
+	proto.RegisterType((*QueryHostZoneAccountingResponse)(nil), "Stridelabs.stride.stakeibc.QueryHostZoneAccountingResponse")
+	proto.RegisterType((*QueryUnbondingScheduleRequest)(nil), "Stridelabs.stride.stakeibc.QueryUnbondingScheduleRequest")
+	proto.RegisterType((*HostZoneUnbondingSchedule)(nil), "Stridelabs.stride.stakeibc.HostZoneUnbondingSchedule")
+	proto.RegisterType((*QueryUnbondingScheduleResponse)(nil), "Stridelabs.stride.stakeibc.QueryUnbondingScheduleResponse")
 }
 
 func init() { proto.RegisterFile("stakeibc/query.proto", fileDescriptor_cc8fd2cb3c1d11f2) }
 
 var fileDescriptor_cc8fd2cb3c1d11f2 = []byte{
-	// 1066 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0x24, 0x0d, 0xe5, 0x24, 0x55, 0xa5, 0x21, 0xc0, 0xc6, 0x8d, 0x36, 0x30, 0x6a,
-	0xcb, 0x36, 0x02, 0xbb, 0xd9, 0x84, 0x16, 0x2a, 0x40, 0x6c, 0x68, 0x92, 0xae, 0x54, 0xa4, 0xb2,
-	0x20, 0x90, 0x7a, 0xb3, 0x9a, 0xb5, 0x87, 0x8d, 0xa9, 0x77, 0x66, 0x63, 0xcf, 0x16, 0x42, 0x14,
-	0x21, 0xf1, 0x04, 0x95, 0x10, 0xef, 0x80, 0x54, 0x21, 0x71, 0x07, 0x82, 0x07, 0xa0, 0x97, 0x95,
-	0xb8, 0x80, 0xab, 0x08, 0x25, 0x3c, 0x41, 0x9f, 0x00, 0x79, 0x3c, 0x63, 0x7b, 0x77, 0x1d, 0xc7,
-	0x69, 0x7b, 0x67, 0xcf, 0x9c, 0x8f, 0xdf, 0x39, 0x3e, 0xfb, 0x9f, 0x59, 0x98, 0x0f, 0x05, 0xb9,
-	0x47, 0xbd, 0x8e, 0x63, 0xef, 0x0c, 0x68, 0xb0, 0x6b, 0xf5, 0x03, 0x2e, 0x38, 0x32, 0x3f, 0x15,
-	0x81, 0xe7, 0x52, 0x9f, 0x74, 0x42, 0x2b, 0x94, 0x8f, 0x96, 0xb6, 0x33, 0xe7, 0xbb, 0xbc, 0xcb,
-	0xa5, 0x99, 0x1d, 0x3d, 0xc5, 0x1e, 0xe6, 0x62, 0x97, 0xf3, 0xae, 0x4f, 0x6d, 0xd2, 0xf7, 0x6c,
-	0xc2, 0x18, 0x17, 0x44, 0x78, 0x9c, 0x85, 0x6a, 0x77, 0xd9, 0xe1, 0x61, 0x8f, 0x87, 0x76, 0x87,
-	0x84, 0x34, 0x4e, 0x64, 0xdf, 0x5f, 0xe9, 0x50, 0x41, 0x56, 0xec, 0x3e, 0xe9, 0x7a, 0x4c, 0x1a,
-	0x2b, 0xdb, 0x97, 0x13, 0xa2, 0x3e, 0x09, 0x48, 0x4f, 0x87, 0xa8, 0x24, 0xcb, 0xf7, 0x89, 0xef,
-	0xb9, 0x44, 0xf0, 0x40, 0xed, 0x2c, 0x24, 0x3b, 0x2e, 0xf5, 0x69, 0x37, 0x1b, 0xeb, 0x4a, 0xb2,
-	0xd5, 0xf3, 0x58, 0x3b, 0x71, 0x6c, 0x07, 0x74, 0x67, 0xe0, 0x05, 0xb4, 0x47, 0x99, 0xd0, 0xf1,
-	0xcd, 0xc4, 0xd4, 0x73, 0x48, 0x9b, 0x38, 0x0e, 0x1f, 0x30, 0x31, 0x96, 0x7b, 0x9b, 0x87, 0xa2,
-	0xfd, 0x2d, 0x67, 0x54, 0x97, 0x9d, 0xec, 0xd0, 0x3e, 0x77, 0xb6, 0xdb, 0x22, 0x20, 0xce, 0x3d,
-	0xaa, 0xc9, 0x5e, 0x49, 0x76, 0xbb, 0x94, 0xd1, 0xd0, 0x53, 0xb9, 0xf0, 0x77, 0x50, 0xfb, 0x24,
-	0x6a, 0x42, 0x93, 0x09, 0x1a, 0x38, 0xdb, 0xc4, 0x63, 0x8d, 0x38, 0xdf, 0x66, 0xc0, 0x7b, 0x0d,
-	0xd7, 0x0d, 0x68, 0x18, 0xb6, 0xe8, 0xce, 0x80, 0x86, 0x02, 0xcd, 0xc3, 0x19, 0xfe, 0x35, 0xa3,
-	0x41, 0xc5, 0x78, 0xcd, 0xa8, 0xbd, 0xd8, 0x8a, 0x5f, 0xd0, 0xfb, 0x70, 0xce, 0xe1, 0x8c, 0x51,
-	0x27, 0x2a, 0xb6, 0xed, 0xb9, 0x95, 0xc9, 0x68, 0x77, 0xbd, 0xf2, 0xe4, 0x60, 0x69, 0x7e, 0x97,
-	0xf4, 0xfc, 0x1b, 0x78, 0x68, 0x1b, 0xb7, 0xe6, 0xd2, 0xf7, 0xa6, 0x8b, 0x1f, 0x18, 0x70, 0xa5,
-	0x04, 0x41, 0xd8, 0xe7, 0x2c, 0xa4, 0xc8, 0x01, 0xd3, 0x4b, 0xec, 0x74, 0x6b, 0xda, 0x24, 0xb6,
-	0x8a, 0xb9, 0xd6, 0x2f, 0x3d, 0x39, 0x58, 0x7a, 0x3d, 0xce, 0x7c, 0xbc, 0x2d, 0x6e, 0x55, 0xbc,
-	0xd1, 0x84, 0x2a, 0x19, 0x9e, 0x07, 0x24, 0x89, 0xee, 0xc8, 0x8f, 0xae, 0xaa, 0xc7, 0x5f, 0xc0,
-	0x4b, 0x43, 0xab, 0x8a, 0xe8, 0x43, 0x98, 0x89, 0x87, 0x43, 0x66, 0x9f, 0xad, 0x63, 0xeb, 0xf8,
-	0x81, 0xb5, 0x62, 0xdf, 0xf5, 0xe9, 0x47, 0x07, 0x4b, 0x13, 0x2d, 0xe5, 0x87, 0xaf, 0xc1, 0x82,
-	0x0c, 0xbc, 0x45, 0xc5, 0xe7, 0x7a, 0x2c, 0x92, 0x9e, 0x2f, 0xc0, 0xd9, 0x98, 0xdf, 0x73, 0x55,
-	0xdb, 0x5f, 0x90, 0xef, 0x4d, 0x17, 0x3b, 0x60, 0xe6, 0xf9, 0x29, 0xae, 0x0d, 0x80, 0x64, 0xc8,
-	0x22, 0xb6, 0xa9, 0xda, 0x6c, 0xfd, 0x52, 0x11, 0x5b, 0x12, 0xa3, 0x95, 0x71, 0xc4, 0x17, 0x52,
-	0xb8, 0xe6, 0x47, 0x0d, 0xd5, 0x28, 0xdd, 0x92, 0xaf, 0x52, 0x82, 0xec, 0xa6, 0x22, 0xb8, 0x0d,
-	0x90, 0xae, 0xaa, 0xee, 0x5c, 0x2e, 0x22, 0x48, 0xad, 0x55, 0x87, 0x32, 0xfe, 0x78, 0x0d, 0x5e,
-	0xd5, 0xb9, 0x6e, 0xf1, 0x50, 0xdc, 0xe5, 0x8c, 0x96, 0xe8, 0x51, 0x07, 0x2a, 0xe3, 0x5e, 0x8a,
-	0x6f, 0x13, 0xce, 0xea, 0x35, 0x45, 0x77, 0xb1, 0x88, 0x4e, 0xdb, 0x2a, 0xb6, 0xc4, 0x17, 0x13,
-	0x45, 0xd6, 0xf0, 0xfd, 0x51, 0xb2, 0x4d, 0x80, 0x54, 0x54, 0x92, 0x16, 0xc4, 0x0a, 0x64, 0x45,
-	0x0a, 0x64, 0xc5, 0x52, 0xa7, 0x14, 0xc8, 0xba, 0x43, 0xba, 0xda, 0xb7, 0x95, 0xf1, 0xc4, 0x0f,
-	0x0d, 0x55, 0xc7, 0x50, 0x8e, 0xdc, 0x3a, 0xa6, 0x9e, 0xb6, 0x0e, 0xb4, 0x35, 0x04, 0x3b, 0x29,
-	0x61, 0xdf, 0x38, 0x11, 0x36, 0x86, 0x18, 0xa2, 0xb5, 0xd5, 0xcc, 0x7c, 0xcc, 0xdd, 0x81, 0x4f,
-	0x47, 0x44, 0x04, 0xc1, 0x34, 0x23, 0x3d, 0xaa, 0x3e, 0x94, 0x7c, 0xc6, 0x57, 0xd5, 0x1c, 0x8d,
-	0x38, 0xa8, 0xfa, 0x10, 0x4c, 0x47, 0x3f, 0x5a, 0xed, 0x11, 0x3d, 0xe3, 0x2d, 0xb8, 0xa0, 0xbf,
-	0xeb, 0x46, 0xa4, 0x76, 0x9f, 0xc5, 0x62, 0xa7, 0x93, 0xd4, 0xe0, 0xbc, 0x14, 0xc1, 0xa6, 0x4b,
-	0x99, 0xf0, 0xbe, 0xf4, 0x12, 0xcd, 0x1a, 0x5d, 0xc6, 0x01, 0x2c, 0xe6, 0x07, 0x52, 0xc9, 0x5b,
-	0x30, 0x47, 0x33, 0xeb, 0xea, 0x1b, 0xd6, 0x8a, 0x1a, 0x9c, 0x8d, 0xa3, 0x9a, 0x3c, 0x14, 0x03,
-	0x53, 0x05, 0xdf, 0xf0, 0xfd, 0x3c, 0xf8, 0xe7, 0x35, 0x34, 0x7f, 0x18, 0xaa, 0xb6, 0xb1, 0x3c,
-	0xc7, 0xd6, 0x36, 0xf5, 0xac, 0xb5, 0x3d, 0xb7, 0x21, 0xaa, 0xff, 0x3d, 0x07, 0x67, 0x24, 0x3d,
-	0xfa, 0xd1, 0x80, 0x99, 0x58, 0x38, 0x91, 0x55, 0xc4, 0x36, 0xae, 0xd9, 0xa6, 0x5d, 0xda, 0x3e,
-	0x26, 0xc0, 0xcb, 0xdf, 0xff, 0xf5, 0xdf, 0x0f, 0x93, 0x17, 0x11, 0xb6, 0x53, 0x47, 0x3b, 0x76,
-	0xb4, 0x47, 0x2e, 0x03, 0xe8, 0x57, 0x03, 0x20, 0x15, 0x5e, 0xf4, 0xf6, 0x89, 0xb9, 0xf2, 0x04,
-	0xde, 0xbc, 0x76, 0x5a, 0x37, 0x45, 0x7a, 0x43, 0x92, 0xae, 0xa1, 0xba, 0x22, 0x7d, 0xeb, 0x76,
-	0x1e, 0x6a, 0xaa, 0xe4, 0xf6, 0x9e, 0xd6, 0xc8, 0x7d, 0xf4, 0xb3, 0x91, 0x95, 0xe6, 0x72, 0xe4,
-	0x63, 0xea, 0x5f, 0x8e, 0x7c, 0xfc, 0x5c, 0xc0, 0x57, 0x25, 0xf9, 0x32, 0xaa, 0x15, 0x92, 0x67,
-	0xae, 0x3e, 0xe8, 0x17, 0x23, 0x95, 0x38, 0xb4, 0x5a, 0x26, 0xed, 0x88, 0x10, 0x9b, 0x6b, 0xa7,
-	0x73, 0x52, 0xa4, 0xef, 0x4a, 0xd2, 0x55, 0xb4, 0x52, 0x48, 0x9a, 0x5c, 0xc4, 0xb2, 0x2d, 0xfe,
-	0xc9, 0x80, 0x59, 0x1d, 0xaf, 0xe1, 0xfb, 0x25, 0xa8, 0xc7, 0x8f, 0x8f, 0x12, 0xd4, 0x39, 0xe7,
-	0x01, 0xb6, 0x24, 0x75, 0x0d, 0x5d, 0x2e, 0x47, 0x8d, 0x7e, 0x37, 0xe0, 0xdc, 0x90, 0xf2, 0x96,
-	0x18, 0x88, 0x3c, 0x69, 0x2f, 0x31, 0x10, 0xb9, 0x02, 0x5f, 0x72, 0x94, 0x7b, 0xd2, 0x57, 0xdf,
-	0xdf, 0xec, 0xbd, 0xe8, 0xe4, 0xd8, 0x47, 0x0f, 0x0d, 0x58, 0x2c, 0xba, 0x39, 0xa2, 0x9b, 0x27,
-	0x42, 0x95, 0xb8, 0xfa, 0x9a, 0x1b, 0xcf, 0x18, 0x45, 0x29, 0xee, 0x9f, 0x06, 0xcc, 0x65, 0x25,
-	0x14, 0x5d, 0x2f, 0x33, 0x97, 0x39, 0x87, 0x84, 0xf9, 0xce, 0xe9, 0x1d, 0x55, 0xb7, 0x6f, 0xca,
-	0x6e, 0x7f, 0x80, 0xde, 0x2b, 0xec, 0xf6, 0xd0, 0x7f, 0x08, 0x7b, 0x6f, 0xe4, 0xd8, 0xdc, 0x47,
-	0xbf, 0x19, 0x70, 0x3e, 0x1b, 0x3e, 0x9a, 0xf1, 0xeb, 0x65, 0xc6, 0xf5, 0xe9, 0x8a, 0x39, 0xe6,
-	0x08, 0xc3, 0x75, 0x59, 0xcc, 0x9b, 0x68, 0xb9, 0x7c, 0x31, 0xeb, 0xb7, 0x1e, 0x1d, 0x56, 0x8d,
-	0xc7, 0x87, 0x55, 0xe3, 0xdf, 0xc3, 0xaa, 0xf1, 0xe0, 0xa8, 0x3a, 0xf1, 0xf8, 0xa8, 0x3a, 0xf1,
-	0xcf, 0x51, 0x75, 0xe2, 0xae, 0xd5, 0xf5, 0xc4, 0xf6, 0xa0, 0x63, 0x39, 0xbc, 0x97, 0x17, 0xef,
-	0x9b, 0x34, 0xa2, 0xd8, 0xed, 0xd3, 0xb0, 0x33, 0x23, 0xff, 0x43, 0xad, 0xfe, 0x1f, 0x00, 0x00,
-	0xff, 0xff, 0xcf, 0x36, 0x74, 0x0e, 0xba, 0x0e, 0x00, 0x00,
+	// 1645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x6f, 0xd4, 0xd6,
+	0x12, 0x8f, 0x49, 0x08, 0xc9, 0x24, 0x10, 0x72, 0x12, 0xb8, 0x1b, 0x13, 0x92, 0x7b, 0x2d, 0x08,
+	0x21, 0xf7, 0xb2, 0x26, 0x7f, 0x08, 0x97, 0xc0, 0xbd, 0x6a, 0xd2, 0x10, 0x88, 0x0a, 0x15, 0x75,
+	0xa0, 0x55, 0xa9, 0xc4, 0xea, 0xac, 0x7d, 0xb2, 0x71, 0xe3, 0xb5, 0x17, 0xfb, 0x6c, 0x68, 0x1a,
+	0xa1, 0x4a, 0xfd, 0x04, 0x48, 0x55, 0xbf, 0x43, 0x25, 0x54, 0xa9, 0xaa, 0xaa, 0xb6, 0x6a, 0x1f,
+	0xfb, 0x50, 0x1e, 0x51, 0x79, 0xa9, 0x2a, 0x35, 0xaa, 0xa0, 0x9f, 0x80, 0x7e, 0x81, 0xca, 0xe7,
+	0x8f, 0xed, 0xdd, 0xf5, 0x6e, 0xbc, 0x81, 0xa7, 0x5d, 0x9f, 0x99, 0xdf, 0xcc, 0x6f, 0xe6, 0xcc,
+	0x19, 0xcf, 0x31, 0x0c, 0x07, 0x14, 0x6f, 0x12, 0xbb, 0x68, 0xea, 0xf7, 0xab, 0xc4, 0xdf, 0xce,
+	0x57, 0x7c, 0x8f, 0x7a, 0x48, 0x5d, 0xa3, 0xbe, 0x6d, 0x11, 0x07, 0x17, 0x83, 0x7c, 0xc0, 0xfe,
+	0xe6, 0xa5, 0x9e, 0x3a, 0x5c, 0xf2, 0x4a, 0x1e, 0x53, 0xd3, 0xc3, 0x7f, 0x1c, 0xa1, 0x8e, 0x98,
+	0x5e, 0x50, 0xf6, 0x82, 0x02, 0x17, 0xf0, 0x07, 0x21, 0x1a, 0x2d, 0x79, 0x5e, 0xc9, 0x21, 0x3a,
+	0xae, 0xd8, 0x3a, 0x76, 0x5d, 0x8f, 0x62, 0x6a, 0x7b, 0xae, 0x94, 0x4e, 0x71, 0x5d, 0xbd, 0x88,
+	0x03, 0xc2, 0x39, 0xe8, 0x5b, 0xd3, 0x45, 0x42, 0xf1, 0xb4, 0x5e, 0xc1, 0x25, 0xdb, 0x65, 0xca,
+	0x42, 0xf7, 0x58, 0x44, 0xb6, 0x82, 0x7d, 0x5c, 0x96, 0x26, 0x72, 0xd1, 0xf2, 0x16, 0x76, 0x6c,
+	0x0b, 0x53, 0xcf, 0x97, 0xac, 0x22, 0x89, 0x45, 0x1c, 0x52, 0x4a, 0xda, 0x3a, 0x1b, 0x89, 0xca,
+	0xb6, 0x5b, 0x88, 0x80, 0x05, 0x9f, 0xdc, 0xaf, 0xda, 0x3e, 0x29, 0x13, 0x97, 0x4a, 0xfb, 0x6a,
+	0xa4, 0x6a, 0x9b, 0xb8, 0x80, 0x4d, 0xd3, 0xab, 0xba, 0xb4, 0xc1, 0xf7, 0x86, 0x17, 0xd0, 0xc2,
+	0xc7, 0x9e, 0x4b, 0x64, 0xd8, 0x91, 0x84, 0x54, 0x3c, 0x73, 0xa3, 0x40, 0x7d, 0x6c, 0x6e, 0x12,
+	0xc9, 0xec, 0x78, 0x24, 0x2d, 0x11, 0x97, 0x04, 0xb6, 0xf4, 0x75, 0xcc, 0x27, 0xa6, 0xe7, 0x5b,
+	0x41, 0xfd, 0x32, 0xb3, 0x11, 0xe8, 0x81, 0xb9, 0x41, 0xac, 0xaa, 0x23, 0x7c, 0x68, 0x9f, 0xc0,
+	0xe4, 0x3b, 0x61, 0xca, 0x56, 0x5d, 0x4a, 0x7c, 0x73, 0x03, 0xdb, 0xee, 0x22, 0x67, 0xb7, 0xe2,
+	0x7b, 0xe5, 0x45, 0xcb, 0xf2, 0x49, 0x10, 0x18, 0xe4, 0x7e, 0x95, 0x04, 0x14, 0x0d, 0xc3, 0x41,
+	0xef, 0x81, 0x4b, 0xfc, 0x9c, 0xf2, 0x4f, 0x65, 0xb2, 0xd7, 0xe0, 0x0f, 0xe8, 0x7f, 0x70, 0xd8,
+	0xf4, 0x5c, 0x97, 0x98, 0x61, 0x6a, 0x0a, 0xb6, 0x95, 0x3b, 0x10, 0x4a, 0x97, 0x72, 0x2f, 0x77,
+	0xc7, 0x87, 0xb7, 0x71, 0xd9, 0x59, 0xd0, 0x6a, 0xc4, 0x9a, 0xd1, 0x1f, 0x3f, 0xaf, 0x5a, 0xda,
+	0x23, 0x05, 0xce, 0x66, 0x60, 0x10, 0x54, 0x3c, 0x37, 0x20, 0xc8, 0x04, 0xd5, 0x8e, 0xf4, 0x64,
+	0x22, 0x0b, 0x98, 0x6b, 0x71, 0x5e, 0x4b, 0xa7, 0x5f, 0xee, 0x8e, 0xff, 0x8b, 0x7b, 0x6e, 0xae,
+	0xab, 0x19, 0x39, 0xbb, 0xde, 0xa1, 0x70, 0xa6, 0x0d, 0x03, 0x62, 0x8c, 0x6e, 0xb1, 0x12, 0x11,
+	0xd1, 0x6b, 0xef, 0xc1, 0x50, 0xcd, 0xaa, 0x60, 0xf4, 0x06, 0x74, 0xf3, 0x52, 0x62, 0xde, 0xfb,
+	0x66, 0xb4, 0x7c, 0xf3, 0xca, 0xcf, 0x73, 0xec, 0x52, 0xd7, 0x93, 0xdd, 0xf1, 0x0e, 0x43, 0xe0,
+	0xb4, 0x79, 0x18, 0x61, 0x86, 0xaf, 0x11, 0xfa, 0xae, 0x2c, 0xa2, 0x28, 0xe7, 0x23, 0xd0, 0xc3,
+	0xf9, 0xdb, 0x96, 0x48, 0xfb, 0x21, 0xf6, 0xbc, 0x6a, 0x69, 0x26, 0xa8, 0x69, 0x38, 0xc1, 0xeb,
+	0x2a, 0x40, 0x54, 0x92, 0x21, 0xb7, 0xce, 0xc9, 0xbe, 0x99, 0xd3, 0xad, 0xb8, 0x45, 0x36, 0x8c,
+	0x04, 0x50, 0x3b, 0x11, 0x93, 0x5b, 0x7d, 0x73, 0x51, 0x24, 0x4a, 0xa6, 0xe4, 0x43, 0x50, 0xd3,
+	0x84, 0x82, 0xc1, 0x0d, 0x80, 0x78, 0x55, 0x64, 0x67, 0xa2, 0x15, 0x83, 0x58, 0x5b, 0x64, 0x28,
+	0x81, 0xd7, 0xe6, 0xe0, 0x1f, 0xd2, 0xd7, 0x75, 0x2f, 0xa0, 0x77, 0x3d, 0x97, 0x64, 0xc8, 0x51,
+	0x11, 0x72, 0x8d, 0x28, 0xc1, 0x6f, 0x05, 0x7a, 0xe4, 0x9a, 0x60, 0x77, 0xaa, 0x15, 0x3b, 0xa9,
+	0x2b, 0xb8, 0x45, 0x58, 0x0d, 0x0b, 0x66, 0x8b, 0x8e, 0x53, 0xcf, 0x6c, 0x05, 0x20, 0x6e, 0x41,
+	0x51, 0x0a, 0x44, 0x6f, 0x0b, 0xfb, 0x55, 0x9e, 0xf7, 0x4c, 0xd1, 0xaf, 0xf2, 0xb7, 0x70, 0x49,
+	0x62, 0x8d, 0x04, 0x52, 0x7b, 0xac, 0x40, 0xae, 0xd1, 0x47, 0x6a, 0x1c, 0x9d, 0xfb, 0x8d, 0x03,
+	0x5d, 0xab, 0x21, 0x7b, 0x80, 0x91, 0x3d, 0xb3, 0x27, 0x59, 0x4e, 0xa2, 0x86, 0xad, 0x2e, 0x6a,
+	0xe6, 0xa6, 0x17, 0x36, 0x9a, 0xba, 0x26, 0x82, 0xa0, 0xcb, 0xc5, 0x65, 0x22, 0x36, 0x8a, 0xfd,
+	0xd7, 0xce, 0x83, 0x9a, 0x06, 0x10, 0xf1, 0x21, 0xe8, 0x0a, 0x0f, 0xad, 0x44, 0x84, 0xff, 0xb5,
+	0x6b, 0x70, 0x42, 0xee, 0xeb, 0xd5, 0xb0, 0xaf, 0xdd, 0xe6, 0xad, 0x51, 0x3a, 0x99, 0x84, 0x01,
+	0xd6, 0xee, 0x56, 0x2d, 0xe2, 0x52, 0x7b, 0xdd, 0x8e, 0x7a, 0x56, 0xfd, 0xb2, 0xe6, 0xc3, 0x68,
+	0xba, 0x21, 0xe1, 0xdc, 0x80, 0x7e, 0x92, 0x58, 0x17, 0x7b, 0x38, 0xd9, 0x2a, 0xc1, 0x49, 0x3b,
+	0x22, 0xc9, 0x35, 0x36, 0x34, 0x22, 0xc8, 0x2f, 0x3a, 0x4e, 0x1a, 0xf9, 0xd7, 0x55, 0x34, 0x3f,
+	0x2a, 0x30, 0x9a, 0xee, 0xa7, 0x69, 0x6c, 0x9d, 0xaf, 0x1a, 0xdb, 0xeb, 0x2b, 0xa2, 0xcb, 0x30,
+	0xc6, 0xc8, 0xcb, 0xf2, 0x14, 0x7d, 0xc0, 0x76, 0x4b, 0x19, 0x8e, 0xfd, 0xb7, 0x0a, 0x0c, 0xdc,
+	0x71, 0x8b, 0x9e, 0x6b, 0xd9, 0x6e, 0x69, 0xb1, 0x1c, 0x02, 0xd1, 0x5b, 0xd0, 0x1d, 0x50, 0x4c,
+	0xab, 0xbc, 0x51, 0x1f, 0x99, 0x99, 0x4d, 0x89, 0x53, 0xbc, 0x3a, 0xa3, 0x33, 0x12, 0xd9, 0xc8,
+	0xaf, 0x31, 0xa8, 0x21, 0x4c, 0xa0, 0x3c, 0x0c, 0x85, 0x3c, 0xb7, 0x48, 0x81, 0x7a, 0x9b, 0xc4,
+	0x2d, 0x60, 0xe6, 0x83, 0xc5, 0xdb, 0x65, 0x0c, 0x72, 0xd1, 0xed, 0x50, 0x22, 0x9c, 0x4f, 0xc0,
+	0x40, 0x40, 0x6b, 0x75, 0x3b, 0x99, 0xee, 0xe1, 0x80, 0x26, 0xf4, 0xb4, 0xaf, 0xbb, 0x61, 0xbc,
+	0x69, 0xd8, 0x62, 0xdb, 0x9a, 0xc7, 0x8d, 0x4e, 0x02, 0xb0, 0x21, 0xc2, 0x22, 0xae, 0x57, 0xe6,
+	0x2f, 0x62, 0xa3, 0x37, 0x5c, 0x59, 0x0e, 0x17, 0xd0, 0x1c, 0x1c, 0x2f, 0xb3, 0x23, 0x16, 0xbd,
+	0x0d, 0x8b, 0xd8, 0xc1, 0xae, 0x49, 0x18, 0x99, 0x4e, 0x63, 0x98, 0x4b, 0x65, 0x13, 0xe6, 0x32,
+	0xa4, 0xc3, 0x50, 0xd5, 0x15, 0xd3, 0x0f, 0xb1, 0x22, 0x48, 0x17, 0x83, 0xa0, 0x84, 0x48, 0x02,
+	0x4e, 0xc3, 0x11, 0x56, 0x30, 0xb1, 0xee, 0x41, 0x19, 0x2b, 0xde, 0x8c, 0xd5, 0xde, 0x87, 0xde,
+	0x80, 0x16, 0x82, 0x6a, 0xa5, 0xe2, 0x6c, 0xe7, 0xba, 0xd9, 0xab, 0xfb, 0x4a, 0x58, 0x51, 0xbf,
+	0xed, 0x8e, 0x4f, 0x94, 0x6c, 0xba, 0x51, 0x2d, 0xe6, 0x4d, 0xaf, 0x2c, 0x26, 0x41, 0xf1, 0x73,
+	0x2e, 0xb0, 0x36, 0x75, 0xba, 0x5d, 0x21, 0x41, 0x7e, 0xd5, 0xa5, 0xbf, 0x7c, 0x73, 0x0e, 0xf8,
+	0x7a, 0xf8, 0x64, 0xf4, 0x04, 0x74, 0x8d, 0x59, 0x43, 0x04, 0x06, 0x7c, 0x62, 0x91, 0x72, 0x85,
+	0x0d, 0x1d, 0x3e, 0xa6, 0x24, 0x77, 0xa8, 0x6d, 0x07, 0xcb, 0xc4, 0x4c, 0x38, 0x58, 0x26, 0xa6,
+	0x71, 0x24, 0x36, 0x6a, 0x60, 0x4a, 0xd0, 0x3d, 0x18, 0xac, 0xca, 0x0a, 0x11, 0xdb, 0x1a, 0xe4,
+	0x7a, 0xd8, 0x29, 0xfa, 0x77, 0xab, 0x53, 0x54, 0x57, 0x9a, 0xe2, 0x20, 0x1d, 0xad, 0xd6, 0x2e,
+	0x07, 0xe8, 0x2c, 0x1c, 0x35, 0x1d, 0x6c, 0x97, 0x71, 0x31, 0xdc, 0x32, 0x5e, 0x36, 0xbd, 0x2c,
+	0x95, 0x03, 0xd1, 0xba, 0x28, 0xb0, 0x0f, 0x00, 0x3d, 0xb0, 0xe9, 0x86, 0xe5, 0xe3, 0x07, 0xd8,
+	0x89, 0xf2, 0x0e, 0x19, 0x5e, 0xba, 0x26, 0x16, 0x1b, 0x22, 0x68, 0x0c, 0xc6, 0x76, 0xe4, 0x4e,
+	0xdd, 0x84, 0xbe, 0x75, 0x42, 0x22, 0xab, 0x7d, 0xfb, 0xb0, 0x0a, 0xeb, 0x84, 0x48, 0x73, 0x6f,
+	0x43, 0x27, 0xdd, 0x72, 0x72, 0xfd, 0xaf, 0x61, 0xcb, 0x43, 0x43, 0xda, 0x2d, 0x38, 0xc9, 0xce,
+	0x4c, 0x94, 0xd6, 0x35, 0x31, 0xe3, 0xee, 0xdd, 0x29, 0xc2, 0x99, 0xd6, 0x4c, 0x1c, 0x5d, 0xfe,
+	0xa0, 0xfd, 0xae, 0xc0, 0x48, 0x43, 0x0f, 0x90, 0x56, 0x5b, 0x99, 0x63, 0x67, 0x45, 0x56, 0xc4,
+	0x7a, 0x78, 0x13, 0x20, 0xae, 0xb9, 0x2d, 0x8c, 0xa3, 0x48, 0xb4, 0x22, 0x25, 0xe8, 0x1e, 0x0c,
+	0x55, 0x2b, 0xa6, 0x57, 0x0e, 0xf5, 0x23, 0x71, 0x90, 0xeb, 0x64, 0x45, 0x74, 0x26, 0x25, 0xc5,
+	0x7c, 0x8c, 0xcf, 0xdf, 0x11, 0x20, 0xd6, 0x90, 0x45, 0x8e, 0x91, 0xb4, 0x14, 0x51, 0x0e, 0xc2,
+	0xf8, 0x88, 0xef, 0x7b, 0x3e, 0x3b, 0xae, 0xbd, 0x06, 0x7f, 0xd0, 0x76, 0x44, 0x73, 0x4d, 0xc9,
+	0x98, 0x68, 0x32, 0xe1, 0xe1, 0x14, 0x6b, 0x72, 0x7a, 0xbc, 0x90, 0x65, 0xaa, 0x68, 0xb0, 0x28,
+	0xb8, 0xc5, 0xd6, 0x66, 0xfe, 0x1a, 0x80, 0x83, 0xcc, 0x3b, 0xfa, 0x5c, 0x81, 0x6e, 0x3e, 0x12,
+	0xa3, 0x7c, 0x2b, 0xe3, 0x8d, 0xd3, 0xb8, 0xaa, 0x67, 0xd6, 0xe7, 0x01, 0x69, 0x53, 0x9f, 0x3e,
+	0xfb, 0xf3, 0xb3, 0x03, 0xa7, 0x90, 0xa6, 0xc7, 0x40, 0x9d, 0x03, 0xf5, 0xba, 0x4b, 0x21, 0xfa,
+	0x4e, 0x01, 0x88, 0x47, 0x6a, 0x74, 0x61, 0x4f, 0x5f, 0x69, 0xa3, 0xbb, 0x3a, 0xdf, 0x2e, 0x4c,
+	0x30, 0x5d, 0x60, 0x4c, 0xe7, 0xd0, 0x8c, 0x60, 0x7a, 0xee, 0x46, 0x1a, 0xd5, 0x78, 0x46, 0xd7,
+	0x77, 0x64, 0x35, 0x3e, 0x44, 0x5f, 0x2a, 0xc9, 0xa1, 0x3b, 0x1b, 0xf3, 0x86, 0xb9, 0x5e, 0x9d,
+	0x6f, 0x17, 0x26, 0x98, 0x9f, 0x67, 0xcc, 0xa7, 0xd0, 0x64, 0x4b, 0xe6, 0x89, 0x2b, 0x30, 0xfa,
+	0x4a, 0x89, 0x87, 0x57, 0x34, 0x9b, 0xc5, 0x6d, 0xdd, 0x88, 0xad, 0xce, 0xb5, 0x07, 0x12, 0x4c,
+	0x2f, 0x31, 0xa6, 0xb3, 0x68, 0xba, 0x25, 0xd3, 0xe8, 0x42, 0x9e, 0x4c, 0xf1, 0x17, 0x0a, 0xf4,
+	0x45, 0x6f, 0x67, 0xc7, 0xc9, 0xc0, 0xba, 0xf1, 0x62, 0xa0, 0xce, 0xb5, 0x07, 0x12, 0xac, 0xf3,
+	0x8c, 0xf5, 0x24, 0x9a, 0xc8, 0xc6, 0x1a, 0xfd, 0xa0, 0xc0, 0xe1, 0x9a, 0x99, 0x3a, 0x43, 0x41,
+	0xa4, 0x0d, 0xed, 0xea, 0x7c, 0xbb, 0xb0, 0xb6, 0x4a, 0x59, 0xce, 0x24, 0x1c, 0xac, 0xef, 0x84,
+	0x77, 0x82, 0x87, 0xe8, 0xb1, 0x02, 0xa3, 0xad, 0xbe, 0x09, 0xa0, 0xe5, 0x3d, 0x49, 0x65, 0xf8,
+	0xa8, 0xa1, 0x5e, 0x7d, 0x45, 0x2b, 0xa2, 0x5f, 0xfe, 0xac, 0x40, 0x7f, 0x72, 0x38, 0x46, 0x17,
+	0xb3, 0xd4, 0x65, 0xca, 0xf8, 0xaf, 0xfe, 0xb7, 0x7d, 0xa0, 0xc8, 0xf6, 0x32, 0xcb, 0xf6, 0xff,
+	0xd1, 0x95, 0x96, 0xd9, 0xae, 0xf9, 0x96, 0xa4, 0xef, 0xd4, 0x5d, 0x88, 0x1e, 0xa2, 0xef, 0x15,
+	0x18, 0x48, 0x9a, 0x0f, 0x6b, 0xfc, 0x62, 0x96, 0x72, 0xdd, 0x5f, 0x30, 0x4d, 0x2e, 0x27, 0xda,
+	0x0c, 0x0b, 0xe6, 0x3f, 0x68, 0x2a, 0x7b, 0x30, 0xe8, 0x99, 0x02, 0xa8, 0x71, 0x70, 0x46, 0x0b,
+	0x7b, 0x92, 0x68, 0x7a, 0xc9, 0x50, 0x2f, 0xef, 0x0b, 0xdb, 0xd6, 0x86, 0x44, 0xe7, 0x55, 0x76,
+	0x45, 0xdb, 0x2d, 0x25, 0x1b, 0xce, 0x4f, 0x0a, 0x0c, 0x36, 0x0e, 0x21, 0x97, 0xf6, 0x24, 0xd6,
+	0x6c, 0x1c, 0x52, 0x17, 0xf6, 0x03, 0x15, 0x21, 0x5d, 0x64, 0x21, 0x4d, 0x23, 0xbd, 0x65, 0x48,
+	0xf1, 0x0c, 0x24, 0x5f, 0xfb, 0x4b, 0xd7, 0x9f, 0x3c, 0x1f, 0x53, 0x9e, 0x3e, 0x1f, 0x53, 0xfe,
+	0x78, 0x3e, 0xa6, 0x3c, 0x7a, 0x31, 0xd6, 0xf1, 0xf4, 0xc5, 0x58, 0xc7, 0xaf, 0x2f, 0xc6, 0x3a,
+	0xee, 0xe6, 0x13, 0x93, 0x5f, 0x8a, 0xd1, 0x8f, 0x62, 0xb3, 0x6c, 0x0a, 0x2c, 0x76, 0xb3, 0x2f,
+	0x97, 0xb3, 0x7f, 0x0f, 0x00, 0x32, 0xb5, 0xc2, 0x0e, 0x79, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -965,6 +1401,10 @@ type QueryClient interface {
 	EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error)
 	// Queries a list of EpochTracker items.
 	EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error)
+	// Queries the accounting breakdown behind a HostZone's redemption rate
+	HostZoneAccounting(ctx context.Context, in *QueryHostZoneAccountingRequest, opts ...grpc.CallOption) (*QueryHostZoneAccountingResponse, error)
+	// Queries when the next unbondings of each host zone, or of one, are sent, following their UnbondingFrequency
+	UnbondingSchedule(ctx context.Context, in *QueryUnbondingScheduleRequest, opts ...grpc.CallOption) (*QueryUnbondingScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -1056,6 +1496,24 @@ func (c *queryClient) EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrac
 	return out, nil
 }
 
+func (c *queryClient) HostZoneAccounting(ctx context.Context, in *QueryHostZoneAccountingRequest, opts ...grpc.CallOption) (*QueryHostZoneAccountingResponse, error) {
+	out := new(QueryHostZoneAccountingResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Query/HostZoneAccounting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingSchedule(ctx context.Context, in *QueryUnbondingScheduleRequest, opts ...grpc.CallOption) (*QueryUnbondingScheduleResponse, error) {
+	out := new(QueryUnbondingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Query/UnbondingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1076,6 +1534,10 @@ type QueryServer interface {
 	EpochTracker(context.Context, *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error)
 	// Queries a list of EpochTracker items.
 	EpochTrackerAll(context.Context, *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error)
+	// Queries the accounting breakdown behind a HostZone's redemption rate
+	HostZoneAccounting(context.Context, *QueryHostZoneAccountingRequest) (*QueryHostZoneAccountingResponse, error)
+	// Queries when the next unbondings of each host zone, or of one, are sent, following their UnbondingFrequency
+	UnbondingSchedule(context.Context, *QueryUnbondingScheduleRequest) (*QueryUnbondingScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1109,6 +1571,12 @@ func (*UnimplementedQueryServer) EpochTracker(ctx context.Context, req *QueryGet
 func (*UnimplementedQueryServer) EpochTrackerAll(ctx context.Context, req *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochTrackerAll not implemented")
 }
+func (*UnimplementedQueryServer) HostZoneAccounting(ctx context.Context, req *QueryHostZoneAccountingRequest) (*QueryHostZoneAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZoneAccounting not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingSchedule(ctx context.Context, req *QueryUnbondingScheduleRequest) (*QueryUnbondingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1276,6 +1744,42 @@ func _Query_EpochTrackerAll_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HostZoneAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostZoneAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostZoneAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Query/HostZoneAccounting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZoneAccounting(ctx, req.(*QueryHostZoneAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Query/UnbondingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingSchedule(ctx, req.(*QueryUnbondingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Stridelabs.stride.stakeibc.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1316,7 +1820,15 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochTrackerAll",
 			Handler:    _Query_EpochTrackerAll_Handler,
 		},
-	},
+		{
+			MethodName: "HostZoneAccounting",
+			Handler:    _Query_HostZoneAccounting_Handler,
+		},
+		{
+			MethodName: "UnbondingSchedule",
+			Handler:    _Query_UnbondingSchedule_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stakeibc/query.proto",
 }
@@ -1921,6 +2433,323 @@ func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryHostZoneAccountingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZoneAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZoneAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnbondingAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StTokenAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StTokenAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NativeTokenAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NativeTokenAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostZoneAccountingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostZoneAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostZoneAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.FeeBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.WithdrawalBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.ClaimableAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimableAmount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.UnbondingAmounts) > 0 {
+		for iNdEx := len(m.UnbondingAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.RedemptionRate.Size()
+		i -= size
+		if _, err := m.RedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.StSupply.Size()
+		i -= size
+		if _, err := m.StSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.StakedBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakedBalance))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UndelegatedBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UndelegatedBalance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ModuleAccountBalance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ModuleAccountBalance))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostZoneUnbondingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostZoneUnbondingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostZoneUnbondingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpcomingUnbondings) > 0 {
+		for iNdEx := len(m.UpcomingUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpcomingUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.UnbondingFrequency != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingFrequency))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2168,8 +2997,141 @@ func (m *QueryAllEpochTrackerResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryHostZoneAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UnbondingAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.NativeTokenAmount != 0 {
+		n += 1 + sovQuery(uint64(m.NativeTokenAmount))
+	}
+	if m.StTokenAmount != 0 {
+		n += 1 + sovQuery(uint64(m.StTokenAmount))
+	}
+	return n
+}
+
+func (m *QueryHostZoneAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ModuleAccountBalance != 0 {
+		n += 1 + sovQuery(uint64(m.ModuleAccountBalance))
+	}
+	if m.UndelegatedBalance != 0 {
+		n += 1 + sovQuery(uint64(m.UndelegatedBalance))
+	}
+	if m.StakedBalance != 0 {
+		n += 1 + sovQuery(uint64(m.StakedBalance))
+	}
+	l = m.StSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RedemptionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnbondingAmounts) > 0 {
+		for _, e := range m.UnbondingAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ClaimableAmount != 0 {
+		n += 1 + sovQuery(uint64(m.ClaimableAmount))
+	}
+	l = m.WithdrawalBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Tvl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnbondingScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *HostZoneUnbondingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingFrequency != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingFrequency))
+	}
+	if len(m.UpcomingUnbondings) > 0 {
+		for _, e := range m.UpcomingUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -3708,6 +4670,939 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHostZoneAccountingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostZoneAccountingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostZoneAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.HostZoneUnbonding_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeTokenAmount", wireType)
+			}
+			m.NativeTokenAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NativeTokenAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+			}
+			m.StTokenAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StTokenAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostZoneAccountingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostZoneAccountingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostZoneAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalance", wireType)
+			}
+			m.ModuleAccountBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModuleAccountBalance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatedBalance", wireType)
+			}
+			m.UndelegatedBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UndelegatedBalance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedBalance", wireType)
+			}
+			m.StakedBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakedBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingAmounts = append(m.UnbondingAmounts, UnbondingAmount{})
+			if err := m.UnbondingAmounts[len(m.UnbondingAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+			}
+			m.ClaimableAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimableAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawalBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostZoneUnbondingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZoneUnbondingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZoneUnbondingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFrequency", wireType)
+			}
+			m.UnbondingFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpcomingUnbondings = append(m.UpcomingUnbondings, types1.UpcomingEpoch{})
+			if err := m.UpcomingUnbondings[len(m.UpcomingUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, HostZoneUnbondingSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,17 @@
+package types
+
+// Telemetry metric keys, emitted under the stakeibc module prefix
+const (
+	MetricKeyRedemptionRate       = "redemption_rate"
+	MetricKeyStakedBalance        = "staked_balance"
+	MetricKeyPendingDeposits      = "pending_deposits"
+	MetricKeyLightClientStaleness = "light_client_staleness_seconds"
+	MetricKeyIcaTxSubmitted       = "ica_tx_submitted"
+	MetricKeyIcaAck               = "ica_ack"
+	MetricKeyIcaAckError          = "ica_ack_error"
+	MetricKeyIcaTimeout           = "ica_timeout"
+
+	MetricLabelChainId    = "chain_id"
+	MetricLabelCallbackId = "callback_id"
+	MetricLabelStatus     = "status"
+)
@@ -35,6 +35,8 @@ type MsgLiquidStake struct {
 	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// TODO(TEST-86): Update Denom -> HostDenom
 	HostDenom string `protobuf:"bytes,3,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
+	// if non-zero, the tx fails if fewer than this many stTokens would be minted
+	MinStAmountOut uint64 `protobuf:"varint,4,opt,name=min_st_amount_out,json=minStAmountOut,proto3" json:"min_st_amount_out,omitempty"`
 }
 
 func (m *MsgLiquidStake) Reset()         { *m = MsgLiquidStake{} }
@@ -91,6 +93,13 @@ func (m *MsgLiquidStake) GetHostDenom() string {
 	return ""
 }
 
+func (m *MsgLiquidStake) GetMinStAmountOut() uint64 {
+	if m != nil {
+		return m.MinStAmountOut
+	}
+	return 0
+}
+
 type MsgLiquidStakeResponse struct {
 }
 
@@ -236,6 +245,8 @@ type MsgRedeemStake struct {
 	Amount   uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	HostZone string `protobuf:"bytes,3,opt,name=hostZone,proto3" json:"hostZone,omitempty"`
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// if non-zero, the tx fails if fewer than this many native tokens would be redeemed
+	MinNativeAmountOut uint64 `protobuf:"varint,5,opt,name=min_native_amount_out,json=minNativeAmountOut,proto3" json:"min_native_amount_out,omitempty"`
 }
 
 func (m *MsgRedeemStake) Reset()         { *m = MsgRedeemStake{} }
@@ -299,6 +310,13 @@ func (m *MsgRedeemStake) GetReceiver() string {
 	return ""
 }
 
+func (m *MsgRedeemStake) GetMinNativeAmountOut() uint64 {
+	if m != nil {
+		return m.MinNativeAmountOut
+	}
+	return 0
+}
+
 type MsgRedeemStakeResponse struct {
 }
 
@@ -1288,6 +1306,103 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateValidatorSharesExchRateResponse proto.InternalMessageInfo
 
+// Admin-only: closes the active controller channel of a host zone ICA
+type MsgCloseIcaChannel struct {
+	Creator     string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId     string         `protobuf:"bytes,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	AccountType ICAAccountType `protobuf:"varint,3,opt,name=accountType,proto3,enum=Stridelabs.stride.stakeibc.ICAAccountType" json:"accountType,omitempty"`
+}
+
+func (m *MsgCloseIcaChannel) Reset()         { *m = MsgCloseIcaChannel{} }
+func (m *MsgCloseIcaChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseIcaChannel) ProtoMessage()    {}
+func (*MsgCloseIcaChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{26}
+}
+func (m *MsgCloseIcaChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseIcaChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseIcaChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseIcaChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseIcaChannel.Merge(m, src)
+}
+func (m *MsgCloseIcaChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseIcaChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseIcaChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseIcaChannel proto.InternalMessageInfo
+
+func (m *MsgCloseIcaChannel) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCloseIcaChannel) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgCloseIcaChannel) GetAccountType() ICAAccountType {
+	if m != nil {
+		return m.AccountType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+type MsgCloseIcaChannelResponse struct {
+}
+
+func (m *MsgCloseIcaChannelResponse) Reset()         { *m = MsgCloseIcaChannelResponse{} }
+func (m *MsgCloseIcaChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseIcaChannelResponse) ProtoMessage()    {}
+func (*MsgCloseIcaChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80cdc2de072d1f1, []int{27}
+}
+func (m *MsgCloseIcaChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseIcaChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseIcaChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCloseIcaChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseIcaChannelResponse.Merge(m, src)
+}
+func (m *MsgCloseIcaChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseIcaChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseIcaChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseIcaChannelResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgLiquidStake)(nil), "Stridelabs.stride.stakeibc.MsgLiquidStake")
 	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "Stridelabs.stride.stakeibc.MsgLiquidStakeResponse")
@@ -1315,91 +1430,98 @@ func init() {
 	proto.RegisterType((*MsgRestoreInterchainAccountResponse)(nil), "Stridelabs.stride.stakeibc.MsgRestoreInterchainAccountResponse")
 	proto.RegisterType((*MsgUpdateValidatorSharesExchRate)(nil), "Stridelabs.stride.stakeibc.MsgUpdateValidatorSharesExchRate")
 	proto.RegisterType((*MsgUpdateValidatorSharesExchRateResponse)(nil), "Stridelabs.stride.stakeibc.MsgUpdateValidatorSharesExchRateResponse")
+	proto.RegisterType((*MsgCloseIcaChannel)(nil), "Stridelabs.stride.stakeibc.MsgCloseIcaChannel")
+	proto.RegisterType((*MsgCloseIcaChannelResponse)(nil), "Stridelabs.stride.stakeibc.MsgCloseIcaChannelResponse")
 }
 
 func init() { proto.RegisterFile("stakeibc/tx.proto", fileDescriptor_e80cdc2de072d1f1) }
 
 var fileDescriptor_e80cdc2de072d1f1 = []byte{
-	// 1256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x6b, 0xdb, 0xd6,
-	0x17, 0x8f, 0x1a, 0xb7, 0x4d, 0x4f, 0xd2, 0x5f, 0x8a, 0xdb, 0xaf, 0xa2, 0x6f, 0x6b, 0x67, 0x2a,
-	0xdb, 0x4a, 0x4b, 0x6d, 0xe6, 0x74, 0x1b, 0x84, 0x95, 0xe1, 0x24, 0x1b, 0x35, 0x24, 0x1b, 0x28,
-	0xe9, 0x06, 0x7d, 0x31, 0x57, 0xd2, 0x8d, 0x2c, 0x62, 0xdd, 0xeb, 0xea, 0xca, 0xad, 0x3d, 0xc6,
-	0x5e, 0x46, 0x61, 0x30, 0x18, 0x7b, 0xe8, 0xe3, 0x60, 0x85, 0xc1, 0xfe, 0x82, 0xfd, 0x0f, 0xdb,
-	0x63, 0xd9, 0xd3, 0x9e, 0xc2, 0x48, 0x5e, 0xf6, 0x9c, 0xbf, 0x60, 0xdc, 0x2b, 0xe9, 0x5a, 0x72,
-	0x6c, 0x2b, 0x71, 0xd8, 0x9b, 0xce, 0xef, 0xcf, 0x39, 0xf7, 0x9c, 0x7b, 0xae, 0x0d, 0xd7, 0x59,
-	0x88, 0xf6, 0xb0, 0x67, 0xd9, 0xd5, 0xb0, 0x57, 0xe9, 0x04, 0x34, 0xa4, 0xaa, 0xbe, 0x1d, 0x06,
-	0x9e, 0x83, 0xdb, 0xc8, 0x62, 0x15, 0x26, 0x3e, 0x2b, 0x89, 0x92, 0x7e, 0x4b, 0xaa, 0xe3, 0x0e,
-	0xb5, 0x5b, 0xcd, 0x30, 0x40, 0xf6, 0x1e, 0x0e, 0x22, 0x4b, 0x5d, 0x97, 0x52, 0xcf, 0x46, 0x4d,
-	0x64, 0xdb, 0xb4, 0x4b, 0xc2, 0x58, 0x56, 0x74, 0xa9, 0x4b, 0xc5, 0x67, 0x95, 0x7f, 0xc5, 0xdc,
-	0x25, 0x97, 0x52, 0xb7, 0x8d, 0xab, 0x82, 0xb2, 0xba, 0xbb, 0x55, 0x44, 0xfa, 0x89, 0xc8, 0xa6,
-	0xcc, 0xa7, 0xac, 0x19, 0xd9, 0x44, 0x44, 0x24, 0x32, 0x10, 0x5c, 0xd9, 0x62, 0xee, 0xa6, 0xf7,
-	0xac, 0xeb, 0x39, 0xdb, 0x3c, 0xa4, 0xaa, 0xc1, 0x45, 0x3b, 0xc0, 0x28, 0xa4, 0x81, 0xa6, 0x2c,
-	0x2b, 0x77, 0x2f, 0x99, 0x09, 0xa9, 0xde, 0x84, 0x0b, 0xc8, 0xe7, 0x38, 0xb4, 0x73, 0xcb, 0xca,
-	0xdd, 0x82, 0x19, 0x53, 0xea, 0x6d, 0x80, 0x16, 0x65, 0x61, 0xd3, 0xc1, 0x84, 0xfa, 0xda, 0xac,
-	0x30, 0xba, 0xc4, 0x39, 0x1b, 0x9c, 0x61, 0x68, 0x70, 0x33, 0x1b, 0xc2, 0xc4, 0xac, 0x43, 0x09,
-	0xc3, 0x46, 0x0f, 0xae, 0x6e, 0x31, 0x77, 0xbd, 0x8d, 0x51, 0xb0, 0x86, 0xda, 0x88, 0xd8, 0x93,
-	0xa2, 0x2f, 0xc1, 0x9c, 0xdd, 0x42, 0x1e, 0x69, 0x7a, 0x8e, 0x88, 0xcf, 0x45, 0x9c, 0x6e, 0x38,
-	0x29, 0x60, 0xb3, 0x19, 0x60, 0xdc, 0x59, 0x0b, 0x11, 0x82, 0xdb, 0x5a, 0x41, 0x5a, 0x70, 0xd2,
-	0x58, 0x82, 0xff, 0x0d, 0x45, 0x96, 0xa0, 0xbe, 0x12, 0x15, 0x31, 0xb1, 0x83, 0xb1, 0x3f, 0x6d,
-	0x45, 0x74, 0x98, 0xe3, 0xf9, 0x3f, 0xa5, 0x04, 0xc7, 0xf5, 0x90, 0x34, 0x97, 0x05, 0xd8, 0xc6,
-	0xde, 0x73, 0x1c, 0xc4, 0xa8, 0x24, 0x1d, 0x97, 0x2a, 0x15, 0x5b, 0xa2, 0x62, 0xa0, 0x0a, 0x89,
-	0xeb, 0xb1, 0x10, 0x07, 0xf5, 0xa8, 0x1f, 0xd4, 0x22, 0x9c, 0xa7, 0x2f, 0x08, 0x4e, 0x70, 0x45,
-	0x84, 0xfa, 0x08, 0x2e, 0xdb, 0x94, 0x10, 0x6c, 0x87, 0x1e, 0x1d, 0x94, 0x6b, 0x4d, 0x3b, 0xda,
-	0x2f, 0x17, 0xfb, 0xc8, 0x6f, 0xaf, 0x1a, 0x19, 0xb1, 0x61, 0x2e, 0x0c, 0xe8, 0x86, 0xb3, 0x3a,
-	0xf7, 0xdd, 0xeb, 0xf2, 0xcc, 0x3f, 0xaf, 0xcb, 0x33, 0xc6, 0x2d, 0xd0, 0x8f, 0x07, 0x95, 0x90,
-	0x5e, 0x29, 0x30, 0xbf, 0xc5, 0xdc, 0xed, 0xae, 0xe5, 0x7b, 0xe1, 0x4e, 0xef, 0x3f, 0x01, 0xa3,
-	0xbe, 0x03, 0xb3, 0x3e, 0x73, 0x45, 0x11, 0xe7, 0x6b, 0xc5, 0x4a, 0xd4, 0xe3, 0x95, 0xa4, 0xc7,
-	0x2b, 0x75, 0xd2, 0x37, 0xb9, 0x42, 0x0a, 0xf4, 0x0d, 0x58, 0x4c, 0xa1, 0x92, 0x68, 0x7f, 0x9d,
-	0x15, 0xfc, 0x24, 0x99, 0xc7, 0xc9, 0x71, 0x9c, 0x11, 0x9f, 0x01, 0x0b, 0x16, 0xb6, 0x5b, 0x2b,
-	0xb5, 0x4e, 0x80, 0x77, 0xbd, 0x9e, 0xb6, 0x20, 0x72, 0xcf, 0xf0, 0xd4, 0x87, 0x99, 0xf9, 0x10,
-	0x67, 0xbe, 0x76, 0xe3, 0x68, 0xbf, 0x7c, 0x3d, 0xf2, 0x3f, 0x90, 0x19, 0xa9, 0xb1, 0x51, 0xdf,
-	0x83, 0x4b, 0x9e, 0x65, 0xc7, 0x46, 0xe7, 0x85, 0x51, 0xf1, 0x68, 0xbf, 0x7c, 0x2d, 0x32, 0x92,
-	0x22, 0xc3, 0x9c, 0xf3, 0x2c, 0x3b, 0x32, 0x49, 0x35, 0xea, 0x85, 0x6c, 0xa3, 0x7e, 0x06, 0x8b,
-	0x61, 0x80, 0x08, 0xdb, 0xc5, 0x41, 0x33, 0x9e, 0x01, 0x9e, 0x2b, 0x08, 0xb7, 0xa5, 0xa3, 0xfd,
-	0xb2, 0x1e, 0xb9, 0x1d, 0xa1, 0x64, 0x98, 0xd7, 0x13, 0xee, 0x7a, 0xc4, 0x6c, 0x38, 0xea, 0xe7,
-	0xb0, 0xd8, 0x25, 0x16, 0x25, 0x8e, 0x47, 0xdc, 0xe6, 0x6e, 0x80, 0x9f, 0x75, 0x31, 0xb1, 0xfb,
-	0xda, 0x3c, 0x9f, 0x82, 0xb4, 0xbf, 0x11, 0x4a, 0x86, 0xa9, 0x4a, 0xee, 0xa7, 0x09, 0x33, 0x75,
-	0x7e, 0xb7, 0xe1, 0xff, 0x23, 0xce, 0x49, 0x9e, 0xe3, 0xb7, 0x0a, 0x2c, 0x89, 0xd1, 0x45, 0x9e,
-	0xff, 0x84, 0x38, 0xb8, 0x8d, 0x5d, 0x14, 0x62, 0x67, 0x87, 0xee, 0x61, 0xc2, 0x26, 0x8c, 0x6a,
-	0x29, 0x3a, 0x04, 0xee, 0xab, 0x91, 0x5c, 0x20, 0x29, 0x0e, 0xef, 0x5e, 0x71, 0x0f, 0xc7, 0x57,
-	0x48, 0x44, 0xf0, 0x01, 0x67, 0x98, 0x38, 0x72, 0x54, 0x63, 0xca, 0xb8, 0x03, 0x6f, 0x8d, 0x05,
-	0x21, 0xa1, 0x06, 0xf1, 0x34, 0x5b, 0xd1, 0x0d, 0xf3, 0x05, 0x6a, 0x7b, 0x0e, 0xc7, 0x32, 0x09,
-	0x66, 0xfa, 0xe6, 0x38, 0x37, 0x74, 0x73, 0x18, 0xb0, 0x40, 0xba, 0xbe, 0xf4, 0x17, 0x23, 0xcd,
-	0xf0, 0x8c, 0x65, 0x28, 0x8d, 0x8e, 0x29, 0x51, 0xfd, 0xae, 0x88, 0x5b, 0xb7, 0xee, 0x38, 0x52,
-	0x38, 0x25, 0x1e, 0x15, 0x0a, 0x04, 0xf9, 0xc9, 0x0d, 0x27, 0xbe, 0xd5, 0x1a, 0x5c, 0x44, 0x8e,
-	0x13, 0x60, 0xc6, 0xe2, 0x46, 0xd7, 0xfe, 0xfc, 0xed, 0x41, 0x31, 0x5e, 0x39, 0xf5, 0x48, 0xc2,
-	0x97, 0x22, 0x71, 0xcd, 0x44, 0x91, 0x1f, 0x8d, 0x4d, 0x7d, 0xdf, 0x63, 0xcc, 0xa3, 0x44, 0xb4,
-	0x7a, 0xc1, 0x4c, 0x71, 0xf8, 0x21, 0xbc, 0xc0, 0x9e, 0xdb, 0x0a, 0x45, 0x57, 0x17, 0xcc, 0x98,
-	0x8a, 0x2f, 0xf1, 0x74, 0x22, 0x32, 0xc9, 0x9f, 0x14, 0xd0, 0xf8, 0x01, 0xb5, 0x10, 0x71, 0x07,
-	0x45, 0xf8, 0x52, 0xd8, 0x4d, 0x99, 0x6d, 0x0d, 0x2e, 0x3e, 0x47, 0x6d, 0x9e, 0x42, 0x94, 0xf0,
-	0xa4, 0xcc, 0x62, 0xc5, 0x14, 0xf2, 0x42, 0x06, 0xb9, 0x01, 0xcb, 0xe3, 0xd0, 0xc9, 0x14, 0xbe,
-	0x11, 0x37, 0xfe, 0x06, 0x6e, 0xe3, 0x10, 0x9f, 0xf5, 0xa4, 0xa6, 0xc0, 0x1e, 0x5f, 0xfe, 0x43,
-	0xf1, 0x25, 0xba, 0x9f, 0x95, 0x78, 0x4c, 0x59, 0x48, 0x03, 0xdc, 0x20, 0x21, 0x0e, 0xc4, 0x36,
-	0x4e, 0x36, 0xd3, 0x78, 0x9c, 0xd1, 0x52, 0xe6, 0x7b, 0x7b, 0x78, 0x8d, 0x6f, 0xc2, 0x7c, 0xfc,
-	0xd0, 0xd9, 0xe9, 0x77, 0xa2, 0xb6, 0xba, 0x52, 0xbb, 0x57, 0x19, 0xff, 0x86, 0xaa, 0x34, 0xd6,
-	0xeb, 0xf5, 0x81, 0x85, 0x99, 0x36, 0x37, 0xde, 0x86, 0x3b, 0x13, 0x00, 0xca, 0x44, 0x3a, 0xe2,
-	0x28, 0x9e, 0x74, 0x1c, 0x94, 0x4a, 0x73, 0xbb, 0x85, 0x02, 0xcc, 0x3e, 0xe9, 0xd9, 0x2d, 0x13,
-	0x85, 0x78, 0xaa, 0x64, 0x34, 0x51, 0x72, 0xda, 0xc1, 0x71, 0xc9, 0xcd, 0x84, 0x34, 0xee, 0xc1,
-	0xdd, 0xbc, 0x88, 0x09, 0xba, 0xda, 0xcb, 0xcb, 0x30, 0xbb, 0xc5, 0x5c, 0xd5, 0x87, 0xf9, 0xf4,
-	0x1b, 0x6d, 0x62, 0x51, 0xb2, 0x8f, 0x2d, 0xbd, 0x76, 0x72, 0xdd, 0x24, 0x2c, 0x0f, 0x97, 0x7e,
-	0x00, 0xe5, 0x85, 0x4b, 0xe9, 0xe6, 0x86, 0x1b, 0xf1, 0xb8, 0x51, 0xfb, 0x70, 0x75, 0xf8, 0x65,
-	0x53, 0xc9, 0x75, 0x93, 0xd1, 0xd7, 0x3f, 0x38, 0x9d, 0xbe, 0x0c, 0xed, 0xc0, 0x9c, 0x7c, 0xc0,
-	0xbc, 0x9b, 0xe3, 0x23, 0x51, 0xd4, 0xab, 0x27, 0x54, 0x94, 0x51, 0xbe, 0x86, 0x6b, 0xc7, 0x1e,
-	0x1e, 0xd5, 0x13, 0x22, 0x4e, 0x0c, 0xf4, 0x0f, 0x4f, 0x69, 0x20, 0xa3, 0xff, 0xa0, 0xc0, 0xcd,
-	0x31, 0xfb, 0xf2, 0xfd, 0x1c, 0x9f, 0xa3, 0xcd, 0xf4, 0x47, 0x53, 0x99, 0x49, 0x40, 0x2f, 0x15,
-	0x58, 0x1c, 0xb5, 0x16, 0xf3, 0x7b, 0xe7, 0x98, 0x8d, 0xbe, 0x7a, 0x7a, 0x1b, 0x89, 0xa3, 0x03,
-	0x0b, 0x99, 0x35, 0x78, 0x3f, 0xc7, 0x57, 0x5a, 0x59, 0x5f, 0x39, 0x85, 0xb2, 0x8c, 0xf8, 0xbd,
-	0x02, 0x37, 0x46, 0x2f, 0xa5, 0x87, 0x79, 0x25, 0x1d, 0x65, 0xa5, 0x7f, 0x34, 0x8d, 0x55, 0x7a,
-	0xee, 0x86, 0xf7, 0x4b, 0xde, 0xdc, 0x0d, 0xe9, 0xe7, 0xce, 0xdd, 0x98, 0xfd, 0xa1, 0xbe, 0x52,
-	0x40, 0x1b, 0xbb, 0x3c, 0xf2, 0x3b, 0x7d, 0xb4, 0xa1, 0xfe, 0xf1, 0x94, 0x86, 0x12, 0xd6, 0x2f,
-	0x0a, 0xdc, 0x9e, 0xbc, 0x0b, 0xf2, 0x2a, 0x3e, 0xd1, 0x5a, 0xdf, 0x38, 0x8b, 0x75, 0xba, 0x6f,
-	0x33, 0x3f, 0x9a, 0xef, 0xe7, 0x8e, 0xe3, 0x40, 0x39, 0xb7, 0x6f, 0x47, 0xfd, 0x28, 0x5e, 0x7b,
-	0xfc, 0xc7, 0x41, 0x49, 0x79, 0x73, 0x50, 0x52, 0xfe, 0x3e, 0x28, 0x29, 0x3f, 0x1e, 0x96, 0x66,
-	0xde, 0x1c, 0x96, 0x66, 0xfe, 0x3a, 0x2c, 0xcd, 0x3c, 0xad, 0xb8, 0x5e, 0xd8, 0xea, 0x5a, 0x15,
-	0x9b, 0xfa, 0xd5, 0xc8, 0xf1, 0x83, 0x4d, 0x64, 0xb1, 0x6a, 0xe4, 0xb9, 0xda, 0xab, 0x0e, 0xfe,
-	0x15, 0xe9, 0x77, 0x30, 0xb3, 0x2e, 0x88, 0xdf, 0x6e, 0x2b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0xb9, 0xbd, 0xf1, 0xce, 0x2e, 0x11, 0x00, 0x00,
+	// 1339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6b, 0x1b, 0x47,
+	0x14, 0xf7, 0xc6, 0x4e, 0xe2, 0x3c, 0xbb, 0x4e, 0xbc, 0xb6, 0xd3, 0xf5, 0x36, 0x91, 0xdd, 0x0d,
+	0x6d, 0xd3, 0x84, 0x48, 0x44, 0x4e, 0x53, 0x08, 0x0d, 0x45, 0x76, 0x5a, 0x22, 0x88, 0x13, 0x58,
+	0x27, 0x2d, 0xe4, 0x22, 0x66, 0x77, 0xc7, 0xab, 0x21, 0xda, 0x19, 0x65, 0x67, 0x95, 0x58, 0x50,
+	0x4a, 0xa1, 0x14, 0x0a, 0x2d, 0xa5, 0x87, 0xd0, 0x53, 0xa1, 0x81, 0x42, 0x6f, 0xbd, 0xf5, 0x3b,
+	0xb4, 0xc7, 0xd0, 0x53, 0x4f, 0xa6, 0x24, 0x97, 0x9e, 0xfd, 0x09, 0xca, 0xce, 0xee, 0x8e, 0x66,
+	0x15, 0x49, 0x6b, 0x29, 0x14, 0x7a, 0xdb, 0x37, 0xef, 0xdf, 0xef, 0xbd, 0x79, 0x7f, 0x46, 0x82,
+	0x45, 0x1e, 0xa1, 0x07, 0x98, 0x38, 0x6e, 0x25, 0xda, 0x2b, 0xb7, 0x43, 0x16, 0x31, 0xdd, 0xdc,
+	0x89, 0x42, 0xe2, 0xe1, 0x16, 0x72, 0x78, 0x99, 0x8b, 0xcf, 0x72, 0x26, 0x64, 0x9e, 0x91, 0xe2,
+	0xb8, 0xcd, 0xdc, 0x66, 0x23, 0x0a, 0x91, 0xfb, 0x00, 0x87, 0x89, 0xa6, 0x69, 0x4a, 0x2e, 0x71,
+	0x51, 0x03, 0xb9, 0x2e, 0xeb, 0xd0, 0x28, 0xe5, 0x2d, 0xfb, 0xcc, 0x67, 0xe2, 0xb3, 0x12, 0x7f,
+	0xa5, 0xa7, 0xab, 0x3e, 0x63, 0x7e, 0x0b, 0x57, 0x04, 0xe5, 0x74, 0x76, 0x2b, 0x88, 0x76, 0x33,
+	0x96, 0xcb, 0x78, 0xc0, 0x78, 0x23, 0xd1, 0x49, 0x88, 0x84, 0x65, 0x7d, 0xab, 0xc1, 0xc2, 0x36,
+	0xf7, 0x6f, 0x91, 0x87, 0x1d, 0xe2, 0xed, 0xc4, 0x3e, 0x75, 0x03, 0x8e, 0xbb, 0x21, 0x46, 0x11,
+	0x0b, 0x0d, 0x6d, 0x5d, 0x3b, 0x7f, 0xc2, 0xce, 0x48, 0xfd, 0x34, 0x1c, 0x43, 0x41, 0x0c, 0xc4,
+	0x38, 0xb2, 0xae, 0x9d, 0x9f, 0xb1, 0x53, 0x4a, 0x3f, 0x0b, 0xd0, 0x64, 0x3c, 0x6a, 0x78, 0x98,
+	0xb2, 0xc0, 0x98, 0x16, 0x4a, 0x27, 0xe2, 0x93, 0x1b, 0xf1, 0x81, 0xfe, 0x2e, 0x2c, 0x06, 0x84,
+	0x36, 0x78, 0xd4, 0x48, 0xe4, 0x1b, 0xac, 0x13, 0x19, 0x33, 0xc2, 0xc2, 0x42, 0x40, 0xe8, 0x4e,
+	0x54, 0x13, 0xc7, 0x77, 0x3a, 0x91, 0x65, 0xc0, 0xe9, 0x3c, 0x1a, 0x1b, 0xf3, 0x36, 0xa3, 0x1c,
+	0x5b, 0x7b, 0x70, 0x72, 0x9b, 0xfb, 0x5b, 0x2d, 0x8c, 0xc2, 0x4d, 0xd4, 0x42, 0xd4, 0x1d, 0x05,
+	0x74, 0x15, 0x66, 0xdd, 0x26, 0x22, 0xb4, 0x41, 0x3c, 0xe3, 0x48, 0xca, 0x8a, 0xe9, 0xba, 0xa7,
+	0xc4, 0x30, 0x9d, 0x8b, 0x21, 0x36, 0xd6, 0x44, 0x94, 0xe2, 0x96, 0x31, 0x23, 0x35, 0x62, 0xd2,
+	0x5a, 0x85, 0xd7, 0xfb, 0x3c, 0x4b, 0x50, 0xbf, 0x26, 0xd9, 0xb3, 0xb1, 0x87, 0x71, 0x30, 0x69,
+	0xf6, 0x4c, 0x98, 0x8d, 0x73, 0x75, 0x9f, 0x51, 0x9c, 0xe6, 0x4e, 0xd2, 0x31, 0x2f, 0xc4, 0x2e,
+	0x26, 0x8f, 0x70, 0x98, 0xc2, 0x92, 0xb4, 0x7e, 0x19, 0x56, 0xe2, 0xb4, 0x52, 0x14, 0x91, 0x47,
+	0x58, 0x4d, 0xed, 0x51, 0x61, 0x5e, 0x0f, 0x08, 0xbd, 0x2d, 0x78, 0xfd, 0xe9, 0x55, 0xe0, 0xca,
+	0x48, 0x38, 0xe8, 0x82, 0xe3, 0x13, 0x1e, 0xe1, 0xb0, 0x96, 0xd4, 0x9b, 0xbe, 0x0c, 0x47, 0xd9,
+	0x63, 0x8a, 0xb3, 0x50, 0x12, 0x42, 0xbf, 0x0e, 0xaf, 0xb9, 0x8c, 0x52, 0xec, 0x46, 0x84, 0xf5,
+	0x52, 0xbc, 0x69, 0x1c, 0xec, 0xaf, 0x2d, 0x77, 0x51, 0xd0, 0xba, 0x66, 0xe5, 0xd8, 0x96, 0x3d,
+	0xdf, 0xa3, 0xeb, 0xde, 0xb5, 0xd9, 0xaf, 0x9f, 0xae, 0x4d, 0xfd, 0xf3, 0x74, 0x6d, 0xca, 0x3a,
+	0x03, 0xe6, 0xcb, 0x4e, 0x25, 0xa4, 0x27, 0x1a, 0xcc, 0x6d, 0x73, 0x7f, 0xa7, 0xe3, 0x04, 0x24,
+	0xba, 0xbb, 0xf7, 0x9f, 0x80, 0xd1, 0xdf, 0x86, 0xe9, 0x80, 0xfb, 0x22, 0xef, 0x73, 0xd5, 0xe5,
+	0x72, 0xd2, 0x43, 0xe5, 0xac, 0x87, 0xca, 0x35, 0xda, 0xb5, 0x63, 0x01, 0x05, 0xf4, 0x0a, 0x2c,
+	0x29, 0xa8, 0x24, 0xda, 0x5f, 0xa6, 0x61, 0x49, 0x09, 0xe6, 0x66, 0x76, 0x83, 0xaf, 0x88, 0xcf,
+	0x82, 0x79, 0x07, 0xbb, 0xcd, 0x8d, 0x6a, 0x3b, 0xc4, 0xbb, 0x64, 0xcf, 0x98, 0x17, 0xb1, 0xe7,
+	0xce, 0xf4, 0x2b, 0xb9, 0xf6, 0x13, 0x65, 0xb2, 0xb9, 0x72, 0xb0, 0xbf, 0xb6, 0x98, 0xd8, 0xef,
+	0xf1, 0x2c, 0xb5, 0x2b, 0x2f, 0xc3, 0x09, 0xe2, 0xb8, 0xa9, 0xd2, 0x51, 0xa1, 0xb4, 0x7c, 0xb0,
+	0xbf, 0x76, 0x2a, 0x51, 0x92, 0x2c, 0xcb, 0x9e, 0x25, 0x8e, 0x9b, 0xa8, 0x28, 0xb5, 0x7d, 0x2c,
+	0x5f, 0xdb, 0xb7, 0x61, 0x29, 0x0a, 0x11, 0xe5, 0xbb, 0x38, 0x6c, 0xa4, 0x7d, 0x13, 0xc7, 0x0a,
+	0xc2, 0x6c, 0xe9, 0x60, 0x7f, 0xcd, 0x4c, 0xcc, 0x0e, 0x10, 0xb2, 0xec, 0xc5, 0xec, 0x74, 0x2b,
+	0x39, 0xac, 0x7b, 0xfa, 0x1d, 0x58, 0xea, 0x50, 0x87, 0x51, 0x8f, 0x50, 0xbf, 0xb1, 0x1b, 0xe2,
+	0x87, 0x1d, 0x4c, 0xdd, 0xae, 0x31, 0x17, 0x57, 0xb6, 0x6a, 0x6f, 0x80, 0x90, 0x65, 0xeb, 0xf2,
+	0xf4, 0xe3, 0xec, 0x50, 0xb9, 0xbf, 0xb3, 0xf0, 0xc6, 0x80, 0x7b, 0x92, 0xf7, 0xf8, 0xa5, 0x06,
+	0xab, 0xa2, 0xdd, 0x11, 0x09, 0xee, 0x51, 0x0f, 0xb7, 0xb0, 0x8f, 0x22, 0xec, 0xdd, 0x65, 0x0f,
+	0x30, 0xe5, 0x23, 0xba, 0xbb, 0x94, 0x5c, 0x42, 0x6c, 0xab, 0x9e, 0x0d, 0x1d, 0xe5, 0x24, 0xae,
+	0x5e, 0x31, 0xe7, 0xd3, 0xb1, 0x93, 0x10, 0xf1, 0x4c, 0xe0, 0x98, 0x7a, 0xb2, 0xbb, 0x53, 0xca,
+	0x3a, 0x07, 0x6f, 0x0e, 0x05, 0x21, 0xa1, 0x86, 0x69, 0x37, 0x3b, 0xc9, 0x54, 0xfa, 0x04, 0xb5,
+	0x88, 0x17, 0x63, 0x19, 0x05, 0x53, 0x1d, 0x36, 0x47, 0xfa, 0x86, 0x8d, 0x05, 0xf3, 0xb4, 0x13,
+	0x48, 0x7b, 0x29, 0xd2, 0xdc, 0x99, 0xb5, 0x0e, 0xa5, 0xc1, 0x3e, 0x25, 0xaa, 0xdf, 0x35, 0x31,
+	0xa9, 0x6b, 0x9e, 0x27, 0x99, 0x13, 0xe2, 0xd1, 0x61, 0x86, 0xa2, 0x20, 0x1b, 0x8a, 0xe2, 0x5b,
+	0xaf, 0xc2, 0x71, 0xe4, 0x79, 0x21, 0xe6, 0x3c, 0x2d, 0x74, 0xe3, 0xcf, 0xdf, 0x2e, 0x2d, 0xa7,
+	0x2b, 0xad, 0x96, 0x70, 0xe2, 0xa5, 0x4b, 0x7d, 0x3b, 0x13, 0x8c, 0xaf, 0xc6, 0x65, 0x41, 0x40,
+	0x38, 0x27, 0x8c, 0xa6, 0xd3, 0x51, 0x39, 0x89, 0x2f, 0xe1, 0x31, 0x26, 0x7e, 0x33, 0x12, 0x55,
+	0x3d, 0x63, 0xa7, 0x54, 0x3a, 0xf8, 0xd5, 0x40, 0x64, 0x90, 0x3f, 0x6a, 0x60, 0xc4, 0x17, 0xd4,
+	0x44, 0xd4, 0xef, 0x25, 0xe1, 0x53, 0xa1, 0x37, 0x61, 0xb4, 0x55, 0x38, 0xfe, 0x08, 0xb5, 0xe2,
+	0x10, 0x8c, 0xe9, 0xa2, 0xc8, 0x52, 0x41, 0x05, 0xf9, 0x4c, 0x0e, 0xb9, 0x05, 0xeb, 0xc3, 0xd0,
+	0xc9, 0x10, 0x3e, 0x17, 0x13, 0xff, 0x06, 0x6e, 0xe1, 0x08, 0xbf, 0xea, 0x4d, 0x4d, 0x80, 0x3d,
+	0x1d, 0xfe, 0x7d, 0xfe, 0x25, 0xba, 0x9f, 0xb4, 0xb4, 0x4d, 0x79, 0xc4, 0x42, 0x5c, 0xa7, 0x11,
+	0x0e, 0xc5, 0x06, 0xcf, 0x36, 0xd3, 0x70, 0x9c, 0x06, 0x64, 0xbb, 0xbe, 0x7f, 0xf5, 0xdf, 0x82,
+	0xb9, 0xf4, 0x21, 0x75, 0xb7, 0xdb, 0x4e, 0xca, 0x6a, 0xa1, 0x7a, 0xa1, 0x3c, 0xfc, 0x8d, 0x56,
+	0xae, 0x6f, 0xd5, 0x6a, 0x3d, 0x0d, 0x5b, 0x55, 0xb7, 0xde, 0x82, 0x73, 0x23, 0x00, 0xca, 0x40,
+	0xda, 0xe2, 0x2a, 0xee, 0xb5, 0x3d, 0xa4, 0x84, 0xb9, 0xd3, 0x44, 0x21, 0xe6, 0x1f, 0xed, 0xb9,
+	0x4d, 0x1b, 0x45, 0x78, 0xa2, 0x60, 0x0c, 0x91, 0x72, 0xd6, 0xc6, 0x69, 0xca, 0xed, 0x8c, 0xb4,
+	0x2e, 0xc0, 0xf9, 0x22, 0x8f, 0x12, 0xdd, 0x0f, 0x9a, 0xa8, 0x82, 0xad, 0x16, 0xe3, 0xb8, 0xee,
+	0xa2, 0x74, 0x00, 0xff, 0x0f, 0xb2, 0x9b, 0x54, 0x47, 0x1f, 0xae, 0x0c, 0x76, 0xf5, 0x8b, 0x05,
+	0x98, 0xde, 0xe6, 0xbe, 0x1e, 0xc0, 0x9c, 0xfa, 0x72, 0x1d, 0xe9, 0x2d, 0xff, 0xae, 0x34, 0xab,
+	0x87, 0x97, 0xcd, 0xdc, 0xc6, 0xee, 0xd4, 0xa7, 0x5e, 0x91, 0x3b, 0x45, 0xd6, 0xac, 0x1e, 0x5e,
+	0x56, 0xba, 0xeb, 0xc2, 0xc9, 0xfe, 0x07, 0x59, 0xb9, 0xd0, 0x4c, 0x4e, 0xde, 0xbc, 0x3a, 0x9e,
+	0xbc, 0x74, 0xed, 0xc1, 0xac, 0x7c, 0x77, 0xbd, 0x53, 0x60, 0x23, 0x13, 0x34, 0x2b, 0x87, 0x14,
+	0x94, 0x5e, 0x3e, 0x83, 0x53, 0x2f, 0xbd, 0x97, 0x2a, 0x87, 0x44, 0x9c, 0x29, 0x98, 0xef, 0x8f,
+	0xa9, 0x20, 0xbd, 0x7f, 0xa7, 0xc1, 0xe9, 0x21, 0x6b, 0xfe, 0xbd, 0x02, 0x9b, 0x83, 0xd5, 0xcc,
+	0xeb, 0x13, 0xa9, 0x49, 0x40, 0x5f, 0x69, 0xb0, 0x34, 0x68, 0x9b, 0x17, 0xd7, 0xce, 0x4b, 0x3a,
+	0xe6, 0xb5, 0xf1, 0x75, 0x24, 0x8e, 0x36, 0xcc, 0xe7, 0xb6, 0xf7, 0xc5, 0x02, 0x5b, 0xaa, 0xb0,
+	0xb9, 0x31, 0x86, 0xb0, 0xf4, 0xf8, 0x8d, 0x06, 0x2b, 0x83, 0x77, 0xe9, 0x95, 0xa2, 0x94, 0x0e,
+	0xd2, 0x32, 0x3f, 0x98, 0x44, 0x4b, 0xed, 0xbb, 0xfe, 0xb5, 0x58, 0xd4, 0x77, 0x7d, 0xf2, 0xe6,
+	0xd5, 0xf1, 0xe4, 0xa5, 0xeb, 0x27, 0x1a, 0x18, 0x43, 0x77, 0x5e, 0x71, 0xa5, 0x0f, 0x56, 0x34,
+	0x3f, 0x9c, 0x50, 0x51, 0xc2, 0xfa, 0x59, 0x83, 0xb3, 0xa3, 0x57, 0x58, 0x51, 0xc6, 0x47, 0x6a,
+	0x9b, 0x37, 0x5e, 0x45, 0x5b, 0xad, 0xdb, 0xdc, 0xff, 0x03, 0x17, 0x0b, 0xdb, 0xb1, 0x27, 0x6c,
+	0x6e, 0x8c, 0x21, 0xac, 0x56, 0x4a, 0xff, 0xea, 0x2c, 0x17, 0xda, 0xc9, 0xc9, 0x9b, 0x57, 0xc7,
+	0x93, 0xcf, 0x5c, 0x6f, 0xde, 0xfc, 0xe3, 0x79, 0x49, 0x7b, 0xf6, 0xbc, 0xa4, 0xfd, 0xfd, 0xbc,
+	0xa4, 0x7d, 0xff, 0xa2, 0x34, 0xf5, 0xec, 0x45, 0x69, 0xea, 0xaf, 0x17, 0xa5, 0xa9, 0xfb, 0x65,
+	0x9f, 0x44, 0xcd, 0x8e, 0x53, 0x76, 0x59, 0x50, 0x49, 0x6c, 0x5f, 0xba, 0x85, 0x1c, 0x5e, 0x49,
+	0x8c, 0x57, 0xf6, 0x2a, 0xbd, 0xff, 0xa9, 0xba, 0x6d, 0xcc, 0x9d, 0x63, 0xe2, 0xd7, 0xee, 0xc6,
+	0xbf, 0x03, 0x00, 0x0a, 0x4e, 0xb4, 0x7c, 0xc0, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1431,6 +1553,7 @@ type MsgClient interface {
 	RestoreInterchainAccount(ctx context.Context, in *MsgRestoreInterchainAccount, opts ...grpc.CallOption) (*MsgRestoreInterchainAccountResponse, error)
 	UpdateValidatorSharesExchRate(ctx context.Context, in *MsgUpdateValidatorSharesExchRate, opts ...grpc.CallOption) (*MsgUpdateValidatorSharesExchRateResponse, error)
 	ClearBalance(ctx context.Context, in *MsgClearBalance, opts ...grpc.CallOption) (*MsgClearBalanceResponse, error)
+	CloseIcaChannel(ctx context.Context, in *MsgCloseIcaChannel, opts ...grpc.CallOption) (*MsgCloseIcaChannelResponse, error)
 }
 
 type msgClient struct {
@@ -1558,6 +1681,15 @@ func (c *msgClient) ClearBalance(ctx context.Context, in *MsgClearBalance, opts
 	return out, nil
 }
 
+func (c *msgClient) CloseIcaChannel(ctx context.Context, in *MsgCloseIcaChannel, opts ...grpc.CallOption) (*MsgCloseIcaChannelResponse, error) {
+	out := new(MsgCloseIcaChannelResponse)
+	err := c.cc.Invoke(ctx, "/Stridelabs.stride.stakeibc.Msg/CloseIcaChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
@@ -1577,6 +1709,7 @@ type MsgServer interface {
 	RestoreInterchainAccount(context.Context, *MsgRestoreInterchainAccount) (*MsgRestoreInterchainAccountResponse, error)
 	UpdateValidatorSharesExchRate(context.Context, *MsgUpdateValidatorSharesExchRate) (*MsgUpdateValidatorSharesExchRateResponse, error)
 	ClearBalance(context.Context, *MsgClearBalance) (*MsgClearBalanceResponse, error)
+	CloseIcaChannel(context.Context, *MsgCloseIcaChannel) (*MsgCloseIcaChannelResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1622,6 +1755,9 @@ func (*UnimplementedMsgServer) UpdateValidatorSharesExchRate(ctx context.Context
 func (*UnimplementedMsgServer) ClearBalance(ctx context.Context, req *MsgClearBalance) (*MsgClearBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearBalance not implemented")
 }
+func (*UnimplementedMsgServer) CloseIcaChannel(ctx context.Context, req *MsgCloseIcaChannel) (*MsgCloseIcaChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseIcaChannel not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1861,6 +1997,24 @@ func _Msg_ClearBalance_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CloseIcaChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseIcaChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseIcaChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Stridelabs.stride.stakeibc.Msg/CloseIcaChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseIcaChannel(ctx, req.(*MsgCloseIcaChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "Stridelabs.stride.stakeibc.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1917,6 +2071,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClearBalance",
 			Handler:    _Msg_ClearBalance_Handler,
 		},
+		{
+			MethodName: "CloseIcaChannel",
+			Handler:    _Msg_CloseIcaChannel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stakeibc/tx.proto",
@@ -1942,6 +2100,11 @@ func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinStAmountOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinStAmountOut))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.HostDenom) > 0 {
 		i -= len(m.HostDenom)
 		copy(dAtA[i:], m.HostDenom)
@@ -2079,6 +2242,11 @@ func (m *MsgRedeemStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinNativeAmountOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinNativeAmountOut))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -2848,6 +3016,71 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCloseIcaChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseIcaChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseIcaChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseIcaChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseIcaChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseIcaChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2876,6 +3109,9 @@ func (m *MsgLiquidStake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MinStAmountOut != 0 {
+		n += 1 + sovTx(uint64(m.MinStAmountOut))
+	}
 	return n
 }
 
@@ -2942,6 +3178,9 @@ func (m *MsgRedeemStake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MinNativeAmountOut != 0 {
+		n += 1 + sovTx(uint64(m.MinNativeAmountOut))
+	}
 	return n
 }
 
@@ -3279,6 +3518,35 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCloseIcaChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovTx(uint64(m.AccountType))
+	}
+	return n
+}
+
+func (m *MsgCloseIcaChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3397,6 +3665,25 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			}
 			m.HostDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStAmountOut", wireType)
+			}
+			m.MinStAmountOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStAmountOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3827,6 +4114,25 @@ func (m *MsgRedeemStake) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNativeAmountOut", wireType)
+			}
+			m.MinNativeAmountOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinNativeAmountOut |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6027,6 +6333,189 @@ func (m *MsgUpdateValidatorSharesExchRateResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgCloseIcaChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseIcaChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseIcaChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseIcaChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseIcaChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseIcaChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0